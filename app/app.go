@@ -135,7 +135,11 @@ import (
 	"github.com/sedaprotocol/seda-chain/x/staking"
 	stakingkeeper "github.com/sedaprotocol/seda-chain/x/staking/keeper"
 	"github.com/sedaprotocol/seda-chain/x/vesting"
+	vestingkeeper "github.com/sedaprotocol/seda-chain/x/vesting/keeper"
 	vestingtypes "github.com/sedaprotocol/seda-chain/x/vesting/types"
+	wasmstorage "github.com/sedaprotocol/seda-chain/x/wasm-storage"
+	wasmstoragekeeper "github.com/sedaprotocol/seda-chain/x/wasm-storage/keeper"
+	wasmstoragetypes "github.com/sedaprotocol/seda-chain/x/wasm-storage/types"
 )
 
 const (
@@ -175,6 +179,7 @@ var (
 		ica.AppModuleBasic{},
 		crisis.AppModuleBasic{},
 		packetforward.AppModuleBasic{},
+		wasmstorage.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -189,6 +194,7 @@ var (
 		ibcfeetypes.ModuleName:         nil,
 		icatypes.ModuleName:            nil,
 		wasmtypes.ModuleName:           {authtypes.Burner},
+		vestingtypes.ModuleName:        nil,
 	}
 )
 
@@ -291,7 +297,7 @@ func NewApp(
 		feegrant.StoreKey, evidencetypes.StoreKey, circuittypes.StoreKey, authzkeeper.StoreKey, group.StoreKey,
 		capabilitytypes.StoreKey, ibcexported.StoreKey, ibctransfertypes.StoreKey, ibcfeetypes.StoreKey,
 		wasmtypes.StoreKey, icahosttypes.StoreKey, icacontrollertypes.StoreKey, packetforwardtypes.StoreKey,
-		crisistypes.StoreKey,
+		crisistypes.StoreKey, wasmstoragetypes.StoreKey, vestingtypes.StoreKey,
 	)
 
 	memKeys := storetypes.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -547,7 +553,13 @@ func NewApp(
 		panic(fmt.Sprintf("error while reading wasm config: %s", err))
 	}
 
-	var wasmOpts []wasmkeeper.Option
+	// The wasm-storage keeper is created after the wasm keeper, which it
+	// depends on, so its custom querier refers to it by reference. The
+	// randomness module is not part of the app, so its queries are rejected.
+	wasmOpts := []wasmkeeper.Option{
+		NewCustomQuerierOption(wasmstoragekeeper.NewCustomQuerier(&app.WasmStorageKeeper), nil),
+		wasmstoragekeeper.NewMessageEncoderOption(),
+	}
 
 	app.WasmKeeper = wasmkeeper.NewKeeper(
 		appCodec,
@@ -570,6 +582,23 @@ func NewApp(
 		wasmOpts...,
 	)
 
+	app.WasmStorageKeeper = *wasmstoragekeeper.NewKeeper(
+		appCodec,
+		keys[wasmstoragetypes.StoreKey],
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		wasmkeeper.NewDefaultPermissionKeeper(app.WasmKeeper),
+		app.WasmKeeper,
+	)
+
+	app.VestingKeeper = *vestingkeeper.NewKeeper(
+		keys[vestingtypes.StoreKey],
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.StakingKeeper,
+		app.DistrKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	govConfig := govtypes.DefaultConfig()
 	govKeeper := govkeeper.NewKeeper(
 		appCodec,
@@ -691,7 +720,7 @@ func NewApp(
 		genutil.NewAppModule(app.AccountKeeper, app.StakingKeeper, app, txConfig),
 		auth.NewAppModule(appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts, nil),
 		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
-		vesting.NewAppModule(app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
+		vesting.NewAppModule(app.VestingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper, nil),
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
 		groupmodule.NewAppModule(appCodec, app.GroupKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
@@ -712,6 +741,7 @@ func NewApp(
 		ica.NewAppModule(&icaControllerKeeper, &app.ICAHostKeeper),
 		ibctm.AppModule{},
 		packetforward.NewAppModule(app.PacketForwardKeeper, nil),
+		wasmstorage.NewAppModule(appCodec, app.WasmStorageKeeper, app.AccountKeeper, app.BankKeeper),
 		crisis.NewAppModule(app.CrisisKeeper, skipGenesisInvariants, nil), // always be last to make sure that it checks for all invariants and not only part of them
 	)
 
@@ -765,6 +795,7 @@ func NewApp(
 		ibcfeetypes.ModuleName,
 		wasmtypes.ModuleName,
 		packetforwardtypes.ModuleName,
+		wasmstoragetypes.ModuleName,
 	)
 
 	app.mm.SetOrderEndBlockers(
@@ -825,6 +856,7 @@ func NewApp(
 		ibcfeetypes.ModuleName,
 		wasmtypes.ModuleName, // wasm after ibc transfer
 		packetforwardtypes.ModuleName,
+		wasmstoragetypes.ModuleName,
 	}
 	app.mm.SetOrderInitGenesis(genesisModuleOrder...)
 	app.mm.SetOrderExportGenesis(genesisModuleOrder...)
@@ -29,6 +29,8 @@ import (
 	icahostkeeper "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/keeper"
 
 	stakingkeeper "github.com/sedaprotocol/seda-chain/x/staking/keeper"
+	vestingkeeper "github.com/sedaprotocol/seda-chain/x/vesting/keeper"
+	wasmstoragekeeper "github.com/sedaprotocol/seda-chain/x/wasm-storage/keeper"
 )
 
 type AppKeepers struct {
@@ -48,6 +50,8 @@ type AppKeepers struct {
 	GroupKeeper           groupkeeper.Keeper
 	ConsensusParamsKeeper consensusparamkeeper.Keeper
 	CircuitKeeper         circuitkeeper.Keeper
+	WasmStorageKeeper     wasmstoragekeeper.Keeper
+	VestingKeeper         vestingkeeper.Keeper
 
 	// ibc
 	IBCKeeper           *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
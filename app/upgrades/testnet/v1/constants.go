@@ -10,6 +10,8 @@ import (
 
 	"github.com/sedaprotocol/seda-chain/app/keepers"
 	"github.com/sedaprotocol/seda-chain/app/upgrades"
+	vestingtypes "github.com/sedaprotocol/seda-chain/x/vesting/types"
+	wasmstoragetypes "github.com/sedaprotocol/seda-chain/x/wasm-storage/types"
 )
 
 const (
@@ -21,7 +23,10 @@ var Upgrade = upgrades.Upgrade{
 	CreateUpgradeHandler: Createv1UpgradeHandler,
 	StoreUpgrades: storetypes.StoreUpgrades{
 		// double check these
-		Added:   []string{},
+		Added: []string{
+			wasmstoragetypes.StoreKey,
+			vestingtypes.StoreKey,
+		},
 		Deleted: []string{},
 	},
 }
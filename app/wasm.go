@@ -1,6 +1,13 @@
 package app
 
-import "strings"
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // The last arguments can contain custom message handlers, and custom query handlers,
 // if we want to allow any custom callbacks
@@ -13,8 +20,58 @@ var wasmCapabilities = []string{
 	"cosmwasm_1_2",
 	"cosmwasm_1_3",
 	"cosmwasm_1_4",
+	"wasm_storage", // custom queries and messages of x/wasm-storage
 }
 
 func GetWasmCapabilities() string {
 	return strings.Join(wasmCapabilities, ",")
 }
+
+// CustomQuery is the custom query that CosmWasm contracts can send to the
+// chain. Exactly one of its fields must be set, which selects the module
+// that answers the query contained in the field.
+type CustomQuery struct {
+	WasmStorage json.RawMessage `json:"wasm_storage,omitempty"`
+	Randomness  json.RawMessage `json:"randomness,omitempty"`
+}
+
+// NewCustomQuerierOption returns the wasm keeper option that registers
+// the custom querier of the chain. Since the wasm keeper only has room
+// for one custom querier, it routes each query to the querier of the
+// module it is meant for. A nil querier rejects the queries of its module.
+func NewCustomQuerierOption(wasmStorage, randomness wasmkeeper.CustomQuerier) wasmkeeper.Option {
+	return wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
+		Custom: NewCustomQuerier(wasmStorage, randomness),
+	})
+}
+
+// NewCustomQuerier returns a CosmWasm custom querier that routes custom
+// queries to the given module queriers.
+func NewCustomQuerier(wasmStorage, randomness wasmkeeper.CustomQuerier) wasmkeeper.CustomQuerier {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var query CustomQuery
+		if err := json.Unmarshal(request, &query); err != nil {
+			return nil, fmt.Errorf("invalid custom query: %s", err)
+		}
+
+		var module string
+		var querier wasmkeeper.CustomQuerier
+		var moduleQuery json.RawMessage
+		set := 0
+		if query.WasmStorage != nil {
+			module, querier, moduleQuery = "wasm_storage", wasmStorage, query.WasmStorage
+			set++
+		}
+		if query.Randomness != nil {
+			module, querier, moduleQuery = "randomness", randomness, query.Randomness
+			set++
+		}
+		if set != 1 {
+			return nil, fmt.Errorf("custom query must set exactly one module, got %d", set)
+		}
+		if querier == nil {
+			return nil, fmt.Errorf("%s custom queries are not supported", module)
+		}
+		return querier(ctx, moduleQuery)
+	}
+}
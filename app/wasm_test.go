@@ -0,0 +1,122 @@
+package app_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/sedaprotocol/seda-chain/app"
+	appparams "github.com/sedaprotocol/seda-chain/app/params"
+	randomnesskeeper "github.com/sedaprotocol/seda-chain/x/randomness/keeper"
+	randomnesstypes "github.com/sedaprotocol/seda-chain/x/randomness/types"
+	wasmstoragekeeper "github.com/sedaprotocol/seda-chain/x/wasm-storage/keeper"
+	wasmstoragetypes "github.com/sedaprotocol/seda-chain/x/wasm-storage/types"
+)
+
+func TestCustomQuerier(t *testing.T) {
+	// The wasm test keepers expect the default address prefixes.
+	sdk.GetConfig().SetBech32PrefixForAccount(sdk.Bech32MainPrefix, sdk.Bech32PrefixAccPub)
+	t.Cleanup(appparams.SetAddressPrefixes)
+
+	keys := storetypes.NewKVStoreKeys(wasmstoragetypes.StoreKey, randomnesstypes.StoreKey)
+	ctx := testutil.DefaultContextWithKeys(keys, nil, nil).WithBlockHeight(7).WithBlockTime(time.Unix(1700000000, 0))
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+
+	wasmStorageKeeper := wasmstoragekeeper.NewKeeper(cdc, keys[wasmstoragetypes.StoreKey], authtypes.NewModuleAddress("gov").String(), nil, nil)
+	randomnessKeeper := randomnesskeeper.NewKeeper(cdc, keys[randomnesstypes.StoreKey])
+	randomnessQuerier := randomnesskeeper.NewQuerierImpl(*randomnessKeeper)
+
+	wasm := wasmstoragetypes.NewWasm([]byte("bytecode"), wasmstoragetypes.WasmTypeTally, ctx.BlockTime())
+	wasmStorageKeeper.SetDataRequestWasm(ctx, wasm)
+	randomnessKeeper.SetSeed(ctx, "seed")
+
+	// Capture the query handler of the wasm keeper, which contracts use
+	// for all their queries.
+	var handler wasmkeeper.WasmVMQueryHandler
+	_, _ = wasmkeeper.CreateTestInput(t, false, app.GetWasmCapabilities(),
+		app.NewCustomQuerierOption(
+			wasmstoragekeeper.NewCustomQuerier(wasmStorageKeeper),
+			randomnesskeeper.SeedQueryPlugin(randomnessQuerier),
+		),
+		wasmkeeper.WithQueryHandlerDecorator(func(h wasmkeeper.WasmVMQueryHandler) wasmkeeper.WasmVMQueryHandler {
+			handler = h
+			return h
+		}),
+	)
+	require.NotNil(t, handler)
+
+	hash := hex.EncodeToString(wasm.Hash)
+	cases := []struct {
+		name      string
+		request   string
+		expected  interface{}
+		expErrMsg string
+	}{
+		{
+			name:    "wasm-storage query",
+			request: `{"wasm_storage":{"data_request_wasm_exists":{"hash":"` + hash + `"}}}`,
+			expected: wasmstoragetypes.DataRequestWasmExistsResponse{
+				Exists:   true,
+				WasmType: "WASM_TYPE_TALLY",
+			},
+		},
+		{
+			name:      "invalid wasm-storage query",
+			request:   `{"wasm_storage":{}}`,
+			expErrMsg: "wasm-storage query must set exactly one variant, got 0",
+		},
+		{
+			name:    "randomness query",
+			request: `{"randomness":{}}`,
+			expected: randomnesstypes.QuerySeedResponse{
+				Seed:        "seed",
+				BlockHeight: 7,
+			},
+		},
+		{
+			name:      "no module",
+			request:   `{"data_request_wasm_exists":{"hash":"` + hash + `"}}`,
+			expErrMsg: "custom query must set exactly one module, got 0",
+		},
+		{
+			name:      "multiple modules",
+			request:   `{"wasm_storage":{"proxy_contract":{}},"randomness":{}}`,
+			expErrMsg: "custom query must set exactly one module, got 2",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := handler.HandleQuery(ctx, sdk.AccAddress("caller"), wasmvmtypes.QueryRequest{
+				Custom: json.RawMessage(tc.request),
+			})
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			expected, err := json.Marshal(tc.expected)
+			require.NoError(t, err)
+			require.JSONEq(t, string(expected), string(res))
+		})
+	}
+}
+
+func TestCustomQuerierUnsupportedModule(t *testing.T) {
+	querier := app.NewCustomQuerier(nil, nil)
+	_, err := querier(sdk.Context{}, json.RawMessage(`{"randomness":{}}`))
+	require.EqualError(t, err, "randomness custom queries are not supported")
+}
@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	vestingtypes "github.com/sedaprotocol/seda-chain/x/vesting/types"
+)
+
+// validateGenesisCmd wraps the genutil validate command to also check the
+// clawback vesting accounts of the auth genesis against the balances of
+// the bank genesis at the genesis time.
+func validateGenesisCmd(mbm module.BasicManager) *cobra.Command {
+	cmd := genutilcli.ValidateGenesisCmd(mbm)
+	validateModules := cmd.RunE
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if err := validateModules(cmd, args); err != nil {
+			return err
+		}
+
+		serverCtx := server.GetServerContextFromCmd(cmd)
+		clientCtx := client.GetClientContextFromCmd(cmd)
+
+		genesis := serverCtx.Config.GenesisFile()
+		if len(args) == 1 {
+			genesis = args[0]
+		}
+
+		appState, appGenesis, err := genutiltypes.GenesisStateFromGenFile(genesis)
+		if err != nil {
+			return err
+		}
+		if err := vestingtypes.ValidateGenesisAccounts(clientCtx.Codec, appState, appGenesis.GenesisTime); err != nil {
+			return fmt.Errorf("error validating genesis file %s: %w", genesis, err)
+		}
+		return nil
+	}
+	return cmd
+}
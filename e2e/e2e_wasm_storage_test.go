@@ -15,9 +15,9 @@ import (
 )
 
 const (
-	drWasm      = "burner.wasm"
-	tallyWasm   = "reflect.wasm"
-	overlayWasm = "staking.wasm"
+	drWasm      = "hello_world.wasm"
+	tallyWasm   = "tally.wasm"
+	overlayWasm = "hello_world.wasm"
 	proxyWasm   = "proxy_contract.wasm"
 )
 
@@ -0,0 +1,120 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/sedaprotocol/seda-chain/x/vesting/types"
+)
+
+// GetQueryCmd returns the CLI query commands for this module
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetCmdQueryVestingAccount(),
+		GetCmdQueryBalances(),
+		GetCmdQueryAccountsByFunder(),
+	)
+	return cmd
+}
+
+// GetCmdQueryVestingAccount returns the command for querying a vesting account.
+func GetCmdQueryVestingAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account <address>",
+		Short: "Get a vesting account and its schedule given its address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.VestingAccount(cmd.Context(), &types.QueryVestingAccountRequest{
+				Address: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryBalances returns the command for querying the balances of a
+// vesting account.
+func GetCmdQueryBalances() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "balances <address>",
+		Short: "Get the vested, unvested, locked, spendable, and delegated balances of a vesting account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Balances(cmd.Context(), &types.QueryBalancesRequest{
+				Address: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryAccountsByFunder returns the command for querying the clawback
+// vesting accounts of a funder.
+func GetCmdQueryAccountsByFunder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accounts-by-funder <funder>",
+		Short: "List the clawback vesting accounts funded by a given address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AccountsByFunder(cmd.Context(), &types.QueryAccountsByFunderRequest{
+				Funder:     args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "accounts-by-funder")
+	return cmd
+}
@@ -1,8 +1,10 @@
 package cli
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
 	"strconv"
 
 	"github.com/spf13/cobra"
@@ -13,11 +15,16 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 
 	"github.com/sedaprotocol/seda-chain/x/vesting/types"
 )
 
-const FlagDisableClawback = "disable-clawback"
+const (
+	FlagDisableClawback = "disable-clawback"
+	FlagCliffTime       = "cliff-time"
+	FlagStartTime       = "start-time"
+)
 
 // GetTxCmd returns vesting module's transaction commands.
 func GetTxCmd(ac address.Codec) *cobra.Command {
@@ -31,7 +38,10 @@ func GetTxCmd(ac address.Codec) *cobra.Command {
 
 	txCmd.AddCommand(
 		NewMsgCreateVestingAccountCmd(ac),
+		NewMsgCreatePeriodicVestingAccountCmd(ac),
 		NewMsgClawbackCmd(),
+		NewMsgUpdateVestingFunderCmd(),
+		SubmitProposalCmd(),
 	)
 	return txCmd
 }
@@ -44,9 +54,14 @@ func NewMsgCreateVestingAccountCmd(ac address.Codec) *cobra.Command {
 		Short: "Create a new vesting account funded with an allocation of tokens.",
 		Long: `Create a new clawback continuous vesting account funded with 
 an allocation of tokens. The from address will be registered as the funder of
-the vesting account that can be used for clawing back vesting funds. All vesting 
-accounts created will have their start time set by the committed block's time. 
-The end_time must be provided as a UNIX epoch timestamp.`,
+the vesting account that can be used for clawing back vesting funds. Unless
+--start-time is given, the vesting account will have its start time set by the
+committed block's time. The end_time must be provided as a UNIX epoch timestamp.
+
+If the to_address is already a clawback continuous vesting account funded by
+the from address, the amount is added to it instead. The coins still vesting
+and the added amount then vest linearly from the committed block's time until
+the later of the two end times.`,
 		Args: cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -72,13 +87,100 @@ The end_time must be provided as a UNIX epoch timestamp.`,
 				return err
 			}
 
+			startTime, _ := cmd.Flags().GetInt64(FlagStartTime)
+			disableClawback, _ := cmd.Flags().GetBool(FlagDisableClawback)
+
+			msg := types.NewMsgCreateVestingAccount(clientCtx.GetFromAddress(), toAddr, amount, startTime, endTime, disableClawback)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Int64(FlagStartTime, 0, "Start of vesting as a UNIX epoch timestamp (defaults to block time)")
+	cmd.Flags().Bool(FlagDisableClawback, false, "Disable clawback")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// VestingData is the content of the periods JSON file used for creating
+// a periodic vesting account.
+type VestingData struct {
+	StartTime int64         `json:"start_time"`
+	Periods   []InputPeriod `json:"periods"`
+}
+
+// InputPeriod is a vesting period in the periods JSON file.
+type InputPeriod struct {
+	Coins  string `json:"coins"`
+	Length int64  `json:"length_seconds"`
+}
+
+// NewMsgCreatePeriodicVestingAccountCmd returns a CLI command handler for
+// creating a MsgCreatePeriodicVestingAccount transaction.
+func NewMsgCreatePeriodicVestingAccountCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-periodic-vesting-account [to_address] [periods_json_file]",
+		Short: "Create a new periodic vesting account funded with an allocation of tokens.",
+		Long: `Create a new clawback periodic vesting account funded with an 
+allocation of tokens. The from address will be registered as the funder of
+the vesting account that can be used for clawing back vesting funds. Periods
+are sequential, so a period starts at the end of the previous one, and the
+first period starts at start_time. If start_time is zero or omitted, the 
+committed block's time is used. The following periods.json file vests 10aseda 
+every 30 days:
+
+{
+  "start_time": 1704067200,
+  "periods": [
+    { "coins": "10aseda", "length_seconds": 2592000 },
+    { "coins": "10aseda", "length_seconds": 2592000 }
+  ]
+}
+
+The optional --cliff-time UNIX epoch timestamp holds back the periods ending
+before it until the cliff.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			toAddr, err := ac.StringToBytes(args[0])
+			if err != nil {
+				return err
+			}
+
+			contents, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			var vestingData VestingData
+			if err := json.Unmarshal(contents, &vestingData); err != nil {
+				return err
+			}
+
+			periods := make(vestingtypes.Periods, len(vestingData.Periods))
+			for i, p := range vestingData.Periods {
+				amount, err := sdk.ParseCoinsNormalized(p.Coins)
+				if err != nil {
+					return err
+				}
+				if p.Length < 1 {
+					return fmt.Errorf("invalid period length of %d in period %d, length must be greater than 0", p.Length, i)
+				}
+				periods[i] = vestingtypes.Period{Length: p.Length, Amount: amount}
+			}
+
+			cliffTime, _ := cmd.Flags().GetInt64(FlagCliffTime)
 			disableClawback, _ := cmd.Flags().GetBool(FlagDisableClawback)
 
-			msg := types.NewMsgCreateVestingAccount(clientCtx.GetFromAddress(), toAddr, amount, endTime, disableClawback)
+			msg := types.NewMsgCreatePeriodicVestingAccount(clientCtx.GetFromAddress(), toAddr, vestingData.StartTime, periods, cliffTime, disableClawback)
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().Int64(FlagCliffTime, 0, "Cliff as a UNIX epoch timestamp")
 	cmd.Flags().Bool(FlagDisableClawback, false, "Disable clawback")
 	flags.AddTxFlagsToCmd(cmd)
 
@@ -113,3 +215,49 @@ func NewMsgClawbackCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewMsgUpdateVestingFunderCmd returns a CLI command handler for reassigning
+// the funder of a clawback vesting account.
+func NewMsgUpdateVestingFunderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-funder [address] [new_funder_address]",
+		Short: "Reassign the funder of a clawback vesting account or disable its clawback.",
+		Long: `Must be requested by the current funder of the vesting account (--from).
+The new funder takes over the right to claw back vesting funds. With
+--disable-clawback, the new funder address must be omitted and clawback is
+disabled permanently.`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			disableClawback, _ := cmd.Flags().GetBool(FlagDisableClawback)
+			var newFunder sdk.AccAddress
+			switch {
+			case disableClawback && len(args) == 2:
+				return errors.New("new funder address cannot be given when disabling clawback")
+			case !disableClawback && len(args) != 2:
+				return errors.New("new funder address is required unless disabling clawback")
+			case !disableClawback:
+				newFunder, err = sdk.AccAddressFromBech32(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgUpdateVestingFunder(clientCtx.GetFromAddress(), addr, newFunder, disableClawback)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagDisableClawback, false, "Disable clawback permanently")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
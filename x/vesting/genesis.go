@@ -0,0 +1,29 @@
+package vesting
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sedaprotocol/seda-chain/x/vesting/keeper"
+	"github.com/sedaprotocol/seda-chain/x/vesting/types"
+)
+
+// InitGenesis builds the module's indexes from the clawback vesting
+// accounts of the auth genesis. The auth and bank genesis must have been
+// initialized beforehand. Accounts whose balances do not cover their
+// locked coins are only logged, since they are rejected by the genesis
+// validate command rather than at chain start.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, _ types.GenesisState) {
+	if err := k.ValidateClawbackAccounts(ctx); err != nil {
+		k.Logger(ctx).Error("invalid clawback vesting account in genesis", "error", err)
+	}
+	if err := k.RebuildFunderGrantees(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// ExportGenesis extracts all data from store to genesis state. The
+// module's indexes are not exported since they are rebuilt from the auth
+// genesis.
+func ExportGenesis(_ sdk.Context, _ keeper.Keeper) types.GenesisState {
+	return *types.DefaultGenesisState()
+}
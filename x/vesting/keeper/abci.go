@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/sedaprotocol/seda-chain/x/vesting/types"
+)
+
+// EndBlocker sends the coins held by the module account to the community
+// pool. These are the coins released from the delegations and unbonding
+// delegations clawed back by the authority.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	moduleAddr := k.ak.GetModuleAddress(types.ModuleName)
+	balance := k.bk.GetAllBalances(ctx, moduleAddr)
+	if balance.IsZero() {
+		return nil
+	}
+	return k.dk.FundCommunityPool(ctx, balance, moduleAddr)
+}
@@ -2,12 +2,14 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	sdkstakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/sedaprotocol/seda-chain/x/vesting/types"
@@ -281,3 +283,349 @@ func TestClawback(t *testing.T) {
 		})
 	}
 }
+
+func TestClawbackByAuthority(t *testing.T) {
+	f := initFixture(t)
+	f.bankKeeper.SetSendEnabled(f.Context(), bondDenom, true)
+	err := banktestutil.FundAccount(f.Context(), f.bankKeeper, funderAddr, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 500000)))
+	require.NoError(t, err)
+
+	_, valAddrs, _ := createValidators(t, f, []int64{5, 5, 5})
+
+	unbondingTime, err := f.stakingKeeper.UnbondingTime(f.Context())
+	require.NoError(t, err)
+	moduleAddr := f.accountKeeper.GetModuleAddress(types.ModuleName)
+
+	testCases := []struct {
+		testName           string
+		sender             sdk.AccAddress
+		recipient          sdk.AccAddress
+		disableClawback    bool
+		delegation         sdk.Coin
+		undelegation       sdk.Coin
+		expClawedUnbonded  sdk.Coins
+		expClawedUnbonding sdk.Coins
+		expClawedBonded    sdk.Coins
+		expCommunityPool   sdk.Coins
+		expErr             string
+	}{
+		{
+			testName:           "unbonded and bonded",
+			sender:             f.authority,
+			recipient:          testAddrs[0],
+			delegation:         sdk.NewInt64Coin(bondDenom, 5000),
+			expClawedUnbonded:  sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 5000)),
+			expClawedUnbonding: zeroCoins,
+			expClawedBonded:    sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 2000)),
+			expCommunityPool:   sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 5000)),
+		},
+		{
+			testName:           "unbonded only",
+			sender:             f.authority,
+			recipient:          testAddrs[1],
+			expClawedUnbonded:  sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 7000)),
+			expClawedUnbonding: zeroCoins,
+			expClawedBonded:    zeroCoins,
+			expCommunityPool:   sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 14000)),
+		},
+		{
+			testName:           "unbonded and unbonding",
+			sender:             f.authority,
+			recipient:          testAddrs[4],
+			delegation:         sdk.NewInt64Coin(bondDenom, 5000),
+			undelegation:       sdk.NewInt64Coin(bondDenom, 5000),
+			expClawedUnbonded:  sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 5000)),
+			expClawedUnbonding: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 2000)),
+			expClawedBonded:    zeroCoins,
+			expCommunityPool:   sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 19000)),
+		},
+		{
+			testName:        "clawback disabled",
+			sender:          f.authority,
+			recipient:       testAddrs[2],
+			disableClawback: true,
+			expErr:          "clawback disabled",
+		},
+		{
+			testName:  "neither funder nor authority",
+			sender:    testAddrs[9],
+			recipient: testAddrs[3],
+			expErr:    "clawback can only be requested by original funder",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			f.AddBlock()
+
+			createVestingMsg := &types.MsgCreateVestingAccount{
+				FromAddress:     funderAddr.String(),
+				ToAddress:       tc.recipient.String(),
+				Amount:          sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10000)),
+				EndTime:         f.Context().BlockTime().Unix() + 100,
+				DisableClawback: tc.disableClawback,
+			}
+			_, err = f.RunMsg(createVestingMsg)
+			require.NoError(t, err)
+
+			if tc.delegation.IsValid() && tc.delegation.IsPositive() {
+				delegateMsg := &sdkstakingtypes.MsgDelegate{
+					DelegatorAddress: tc.recipient.String(),
+					ValidatorAddress: valAddrs[0].String(),
+					Amount:           tc.delegation,
+				}
+				_, err = f.RunMsg(delegateMsg)
+				require.NoError(t, err)
+			}
+			if tc.undelegation.IsValid() && tc.undelegation.IsPositive() {
+				undelegateMsg := &sdkstakingtypes.MsgUndelegate{
+					DelegatorAddress: tc.recipient.String(),
+					ValidatorAddress: valAddrs[0].String(),
+					Amount:           tc.undelegation,
+				}
+				_, err = f.RunMsg(undelegateMsg)
+				require.NoError(t, err)
+			}
+
+			f.AddTime(30)
+
+			funderBalance := f.bankKeeper.GetAllBalances(f.Context(), funderAddr)
+			res, err := f.RunMsg(types.NewMsgClawback(tc.sender, tc.recipient))
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			result := types.MsgClawbackResponse{}
+			err = f.cdc.Unmarshal(res.Value, &result)
+			require.NoError(t, err)
+			require.Equal(t, tc.expClawedUnbonded, result.ClawedUnbonded)
+			require.Equal(t, tc.expClawedUnbonding, result.ClawedUnbonding)
+			require.Equal(t, tc.expClawedBonded, result.ClawedBonded)
+
+			communityPool := func() sdk.Coins {
+				feePool, err := f.distrKeeper.FeePool.Get(f.Context())
+				require.NoError(t, err)
+				communityPool, _ := feePool.CommunityPool.TruncateDecimal()
+				return communityPool
+			}
+			require.Equal(t, tc.expCommunityPool, communityPool())
+
+			// funder does not receive anything
+			require.Equal(t, funderBalance, f.bankKeeper.GetAllBalances(f.Context(), funderAddr))
+
+			// neither the authority nor the module account keep any stake
+			delegations, err := f.stakingKeeper.GetAllDelegatorDelegations(f.Context(), f.authority)
+			require.NoError(t, err)
+			require.Empty(t, delegations)
+			delegations, err = f.stakingKeeper.GetAllDelegatorDelegations(f.Context(), moduleAddr)
+			require.NoError(t, err)
+			require.Empty(t, delegations)
+			unbonding, err := f.stakingKeeper.GetDelegatorUnbonding(f.Context(), moduleAddr)
+			require.NoError(t, err)
+			require.Equal(t, tc.expClawedUnbonding.Add(tc.expClawedBonded...).AmountOf(bondDenom), unbonding)
+
+			// the staked coins go to the community pool once released
+			f.AddTime(int64(unbondingTime.Seconds()))
+			_, err = f.stakingKeeper.EndBlocker(f.Context())
+			require.NoError(t, err)
+			require.NoError(t, f.vestingKeeper.EndBlocker(f.Context()))
+			require.True(t, f.bankKeeper.GetAllBalances(f.Context(), moduleAddr).IsZero())
+			require.Equal(t, tc.expCommunityPool.Add(tc.expClawedUnbonding...).Add(tc.expClawedBonded...), communityPool())
+		})
+	}
+}
+
+func TestClawbackShortfall(t *testing.T) {
+	f := initFixture(t)
+	f.bankKeeper.SetSendEnabled(f.Context(), bondDenom, true)
+	err := banktestutil.FundAccount(f.Context(), f.bankKeeper, funderAddr, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 500000)))
+	require.NoError(t, err)
+
+	_, valAddrs, valPks := createValidators(t, f, []int64{5, 5, 5})
+
+	// Lower the entry limit and have the funder reach it for redelegations
+	// from val0 to val1 and unbonding delegations from val0.
+	stakingParams, err := f.stakingKeeper.GetParams(f.Context())
+	require.NoError(t, err)
+	stakingParams.MaxEntries = 2
+	require.NoError(t, f.stakingKeeper.SetParams(f.Context(), stakingParams))
+
+	funderMsgs := []sdk.Msg{
+		&sdkstakingtypes.MsgDelegate{
+			DelegatorAddress: funderAddr.String(),
+			ValidatorAddress: valAddrs[0].String(),
+			Amount:           sdk.NewInt64Coin(bondDenom, 10000),
+		},
+		&sdkstakingtypes.MsgBeginRedelegate{
+			DelegatorAddress:    funderAddr.String(),
+			ValidatorSrcAddress: valAddrs[0].String(),
+			ValidatorDstAddress: valAddrs[1].String(),
+			Amount:              sdk.NewInt64Coin(bondDenom, 1000),
+		},
+	}
+	for i := 0; i < 2; i++ {
+		funderMsgs = append(funderMsgs, &sdkstakingtypes.MsgUndelegate{
+			DelegatorAddress: funderAddr.String(),
+			ValidatorAddress: valAddrs[0].String(),
+			Amount:           sdk.NewInt64Coin(bondDenom, 1000),
+		})
+	}
+	for _, msg := range funderMsgs {
+		f.AddBlock()
+		_, err = f.RunMsg(msg)
+		require.NoError(t, err)
+	}
+
+	coins := func(amt int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(bondDenom, amt))
+	}
+
+	testCases := []struct {
+		testName           string
+		recipient          sdk.AccAddress
+		msgs               func(recipient sdk.AccAddress) []sdk.Msg
+		jailAndSlash       math.LegacyDec // on val2
+		tombstone          bool
+		expClawedUnbonded  sdk.Coins
+		expClawedUnbonding sdk.Coins
+		expClawedBonded    sdk.Coins
+		expShortfall       sdk.Coins
+	}{
+		{
+			testName:  "slashed and jailed validator",
+			recipient: testAddrs[0],
+			msgs: func(recipient sdk.AccAddress) []sdk.Msg {
+				return []sdk.Msg{
+					&sdkstakingtypes.MsgDelegate{
+						DelegatorAddress: recipient.String(),
+						ValidatorAddress: valAddrs[2].String(),
+						Amount:           sdk.NewInt64Coin(bondDenom, 5000),
+					},
+				}
+			},
+			jailAndSlash:       math.LegacyNewDecWithPrec(5, 2),
+			expClawedUnbonded:  coins(5000),
+			expClawedUnbonding: zeroCoins,
+			expClawedBonded:    coins(2000),
+			expShortfall:       zeroCoins,
+		},
+		{
+			testName:  "tombstoned validator without tokens",
+			recipient: testAddrs[1],
+			msgs: func(recipient sdk.AccAddress) []sdk.Msg {
+				return []sdk.Msg{
+					&sdkstakingtypes.MsgDelegate{
+						DelegatorAddress: recipient.String(),
+						ValidatorAddress: valAddrs[2].String(),
+						Amount:           sdk.NewInt64Coin(bondDenom, 5000),
+					},
+				}
+			},
+			jailAndSlash:       math.LegacyOneDec(),
+			tombstone:          true,
+			expClawedUnbonded:  coins(5000),
+			expClawedUnbonding: zeroCoins,
+			expClawedBonded:    zeroCoins,
+			expShortfall:       zeroCoins,
+		},
+		{
+			testName:  "max redelegation entries",
+			recipient: testAddrs[2],
+			msgs: func(recipient sdk.AccAddress) []sdk.Msg {
+				return []sdk.Msg{
+					&sdkstakingtypes.MsgDelegate{
+						DelegatorAddress: recipient.String(),
+						ValidatorAddress: valAddrs[0].String(),
+						Amount:           sdk.NewInt64Coin(bondDenom, 8000),
+					},
+					&sdkstakingtypes.MsgBeginRedelegate{
+						DelegatorAddress:    recipient.String(),
+						ValidatorSrcAddress: valAddrs[0].String(),
+						ValidatorDstAddress: valAddrs[1].String(),
+						Amount:              sdk.NewInt64Coin(bondDenom, 8000),
+					},
+				}
+			},
+			expClawedUnbonded:  coins(2000),
+			expClawedUnbonding: zeroCoins,
+			expClawedBonded:    zeroCoins,
+			expShortfall:       coins(5000),
+		},
+		{
+			testName:  "max unbonding entries",
+			recipient: testAddrs[3],
+			msgs: func(recipient sdk.AccAddress) []sdk.Msg {
+				return []sdk.Msg{
+					&sdkstakingtypes.MsgDelegate{
+						DelegatorAddress: recipient.String(),
+						ValidatorAddress: valAddrs[0].String(),
+						Amount:           sdk.NewInt64Coin(bondDenom, 8000),
+					},
+					&sdkstakingtypes.MsgUndelegate{
+						DelegatorAddress: recipient.String(),
+						ValidatorAddress: valAddrs[0].String(),
+						Amount:           sdk.NewInt64Coin(bondDenom, 8000),
+					},
+				}
+			},
+			expClawedUnbonded:  coins(2000),
+			expClawedUnbonding: zeroCoins,
+			expClawedBonded:    zeroCoins,
+			expShortfall:       coins(5000),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			f.AddBlock()
+
+			// 10000 vesting over 100 seconds
+			_, err := f.RunMsg(types.NewMsgCreateVestingAccount(funderAddr, tc.recipient, coins(10000), 0, f.Context().BlockTime().Unix()+100, false))
+			require.NoError(t, err)
+
+			for _, msg := range tc.msgs(tc.recipient) {
+				_, err = f.RunMsg(msg)
+				require.NoError(t, err)
+			}
+
+			if !tc.jailAndSlash.IsNil() {
+				consAddr := sdk.ConsAddress(valPks[2].Address())
+				if !f.slashingKeeper.HasValidatorSigningInfo(f.Context(), consAddr) {
+					err = f.slashingKeeper.SetValidatorSigningInfo(f.Context(), consAddr, slashingtypes.NewValidatorSigningInfo(consAddr, 0, 0, time.Unix(0, 0), false, 0))
+					require.NoError(t, err)
+				}
+				require.NoError(t, f.slashingKeeper.Slash(f.Context(), consAddr, tc.jailAndSlash, 5, f.Context().BlockHeight()-1))
+				validator, err := f.stakingKeeper.GetValidator(f.Context(), valAddrs[2])
+				require.NoError(t, err)
+				if !validator.IsJailed() {
+					require.NoError(t, f.slashingKeeper.Jail(f.Context(), consAddr))
+				}
+				if tc.tombstone {
+					require.NoError(t, f.slashingKeeper.Tombstone(f.Context(), consAddr))
+					require.True(t, f.slashingKeeper.IsTombstoned(f.Context(), consAddr))
+				}
+			}
+
+			_, err = f.stakingKeeper.EndBlocker(f.Context())
+			require.NoError(t, err)
+			f.AddBlock()
+			f.AddTime(30)
+
+			res, err := f.RunMsg(types.NewMsgClawback(funderAddr, tc.recipient))
+			require.NoError(t, err)
+
+			result := types.MsgClawbackResponse{}
+			err = f.cdc.Unmarshal(res.Value, &result)
+			require.NoError(t, err)
+			require.Equal(t, tc.expClawedUnbonded, result.ClawedUnbonded)
+			require.Equal(t, tc.expClawedUnbonding, result.ClawedUnbonding)
+			require.Equal(t, tc.expClawedBonded, result.ClawedBonded)
+			require.Equal(t, tc.expShortfall, result.Shortfall)
+
+			// the shortfall is left in the account, which is fully vested
+			acc, ok := f.accountKeeper.GetAccount(f.Context(), tc.recipient).(*types.ClawbackContinuousVestingAccount)
+			require.True(t, ok)
+			require.True(t, acc.GetVestingCoins(f.Context().BlockTime()).IsZero())
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	"github.com/sedaprotocol/seda-chain/x/vesting/keeper"
+	"github.com/sedaprotocol/seda-chain/x/vesting/types"
+)
+
+func TestVestingEvents(t *testing.T) {
+	f := initFixture(t)
+	f.bankKeeper.SetSendEnabled(f.Context(), bondDenom, true)
+	err := banktestutil.FundAccount(f.Context(), f.bankKeeper, funderAddr, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 500000)))
+	require.NoError(t, err)
+
+	msgServer := keeper.NewMsgServerImpl(f.vestingKeeper)
+	coins := func(amt int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(bondDenom, amt))
+	}
+
+	// lastTypedEvent returns the last typed event emitted in the context.
+	lastTypedEvent := func(ctx sdk.Context) proto.Message {
+		events := ctx.EventManager().ABCIEvents()
+		require.NotEmpty(t, events)
+		msg, err := sdk.ParseTypedEvent(events[len(events)-1])
+		require.NoError(t, err)
+		return msg
+	}
+
+	blockTime := f.Context().BlockTime().Unix()
+	ctx := f.Context().WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.CreateVestingAccount(ctx, types.NewMsgCreateVestingAccount(funderAddr, testAddrs[0], coins(1000), 0, blockTime+100, false))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventCreateVestingAccount{
+		Funder:          funderAddr.String(),
+		Recipient:       testAddrs[0].String(),
+		Amount:          coins(1000),
+		Start:           blockTime,
+		End:             blockTime + 100,
+		ClawbackEnabled: true,
+	}, lastTypedEvent(ctx))
+
+	ctx = f.Context().WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.CreatePeriodicVestingAccount(ctx, types.NewMsgCreatePeriodicVestingAccount(funderAddr, testAddrs[1], blockTime-10, periods(4, 50, 1000), 0, true))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventCreateVestingAccount{
+		Funder:          funderAddr.String(),
+		Recipient:       testAddrs[1].String(),
+		Amount:          coins(4000),
+		Start:           blockTime - 10,
+		End:             blockTime + 190,
+		ClawbackEnabled: false,
+	}, lastTypedEvent(ctx))
+
+	f.AddTime(50)
+
+	// adding a grant reports the schedule of the grant
+	ctx = f.Context().WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.CreateVestingAccount(ctx, types.NewMsgCreateVestingAccount(funderAddr, testAddrs[0], coins(500), 0, blockTime+200, false))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventCreateVestingAccount{
+		Funder:          funderAddr.String(),
+		Recipient:       testAddrs[0].String(),
+		Amount:          coins(500),
+		Start:           blockTime + 50,
+		End:             blockTime + 200,
+		ClawbackEnabled: true,
+	}, lastTypedEvent(ctx))
+
+	ctx = f.Context().WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.Clawback(ctx, types.NewMsgClawback(funderAddr, testAddrs[0]))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventClawback{
+		Funder:          funderAddr.String(),
+		Account:         testAddrs[0].String(),
+		ClawedUnbonded:  coins(1000),
+		ClawedUnbonding: sdk.NewCoins(),
+		ClawedBonded:    sdk.NewCoins(),
+		Shortfall:       sdk.NewCoins(),
+	}, lastTypedEvent(ctx))
+}
@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	"github.com/sedaprotocol/seda-chain/x/vesting/types"
+)
+
+func TestUpdateVestingFunder(t *testing.T) {
+	f := initFixture(t)
+	f.bankKeeper.SetSendEnabled(f.Context(), bondDenom, true)
+	err := banktestutil.FundAccount(f.Context(), f.bankKeeper, funderAddr, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 500000)))
+	require.NoError(t, err)
+
+	newFunderAddr := testAddrs[9]
+
+	testCases := []struct {
+		testName         string
+		recipient        sdk.AccAddress
+		msg              func(recipient sdk.AccAddress) *types.MsgUpdateVestingFunder
+		expFunder        string
+		expClawbackError string // error of clawback by new funder, if any
+		expErr           string
+	}{
+		{
+			testName:  "reassign funder",
+			recipient: testAddrs[0],
+			msg: func(recipient sdk.AccAddress) *types.MsgUpdateVestingFunder {
+				return types.NewMsgUpdateVestingFunder(funderAddr, recipient, newFunderAddr, false)
+			},
+			expFunder: newFunderAddr.String(),
+		},
+		{
+			testName:  "disable clawback",
+			recipient: testAddrs[1],
+			msg: func(recipient sdk.AccAddress) *types.MsgUpdateVestingFunder {
+				return types.NewMsgUpdateVestingFunder(funderAddr, recipient, nil, true)
+			},
+			expFunder:        "",
+			expClawbackError: "clawback disabled",
+		},
+		{
+			testName:  "not the current funder",
+			recipient: testAddrs[2],
+			msg: func(recipient sdk.AccAddress) *types.MsgUpdateVestingFunder {
+				return types.NewMsgUpdateVestingFunder(newFunderAddr, recipient, testAddrs[8], false)
+			},
+			expErr: "funder can only be updated by current funder",
+		},
+		{
+			testName:  "same funder",
+			recipient: testAddrs[3],
+			msg: func(recipient sdk.AccAddress) *types.MsgUpdateVestingFunder {
+				return types.NewMsgUpdateVestingFunder(funderAddr, recipient, funderAddr, false)
+			},
+			expErr: "same as the current funder",
+		},
+		{
+			testName:  "new funder given when disabling clawback",
+			recipient: testAddrs[4],
+			msg: func(recipient sdk.AccAddress) *types.MsgUpdateVestingFunder {
+				msg := types.NewMsgUpdateVestingFunder(funderAddr, recipient, nil, true)
+				msg.NewFunderAddress = newFunderAddr.String()
+				return msg
+			},
+			expErr: "new funder must be empty",
+		},
+		{
+			testName:  "invalid new funder",
+			recipient: testAddrs[5],
+			msg: func(recipient sdk.AccAddress) *types.MsgUpdateVestingFunder {
+				msg := types.NewMsgUpdateVestingFunder(funderAddr, recipient, nil, false)
+				msg.NewFunderAddress = ""
+				return msg
+			},
+			expErr: "invalid new funder address",
+		},
+		{
+			testName:  "clawback already disabled",
+			recipient: testAddrs[1],
+			msg: func(recipient sdk.AccAddress) *types.MsgUpdateVestingFunder {
+				return types.NewMsgUpdateVestingFunder(funderAddr, recipient, newFunderAddr, false)
+			},
+			expErr: "clawback disabled",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			if f.accountKeeper.GetAccount(f.Context(), tc.recipient) == nil {
+				createVestingMsg := types.NewMsgCreateVestingAccount(
+					funderAddr, tc.recipient, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10000)),
+					0, f.Context().BlockTime().Unix()+100, false,
+				)
+				_, err = f.RunMsg(createVestingMsg)
+				require.NoError(t, err)
+			}
+
+			_, err := f.RunMsg(tc.msg(tc.recipient))
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			acc, ok := f.accountKeeper.GetAccount(f.Context(), tc.recipient).(types.ClawbackVestingAccount)
+			require.True(t, ok)
+			require.Equal(t, tc.expFunder, acc.GetFunderAddress())
+
+			// the previous funder lost its clawback rights
+			_, err = f.RunMsg(types.NewMsgClawback(funderAddr, tc.recipient))
+			require.Error(t, err)
+
+			_, err = f.RunMsg(types.NewMsgClawback(newFunderAddr, tc.recipient))
+			if tc.expClawbackError != "" {
+				require.ErrorContains(t, err, tc.expClawbackError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
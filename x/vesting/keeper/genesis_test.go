@@ -0,0 +1,268 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	sdkvestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	sdkstakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/sedaprotocol/seda-chain/x/vesting"
+	"github.com/sedaprotocol/seda-chain/x/vesting/types"
+)
+
+func TestValidateClawbackVestingAccount(t *testing.T) {
+	coins := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))
+	newBaseVestingAccount := func(endTime int64) *sdkvestingtypes.BaseVestingAccount {
+		bva, err := sdkvestingtypes.NewBaseVestingAccount(authtypes.NewBaseAccountWithAddress(testAddrs[0]), coins, endTime)
+		require.NoError(t, err)
+		return bva
+	}
+
+	testCases := []struct {
+		testName string
+		account  authtypes.GenesisAccount
+		expErr   string
+	}{
+		{
+			testName: "valid continuous",
+			account:  types.NewClawbackContinuousVestingAccountRaw(newBaseVestingAccount(200), 100, funderAddr.String()),
+		},
+		{
+			testName: "valid continuous with clawback disabled",
+			account:  types.NewClawbackContinuousVestingAccountRaw(newBaseVestingAccount(200), 100, ""),
+		},
+		{
+			testName: "continuous with invalid funder",
+			account:  types.NewClawbackContinuousVestingAccountRaw(newBaseVestingAccount(200), 100, "seda1invalid"),
+			expErr:   "invalid funder address",
+		},
+		{
+			testName: "continuous with start equal to end",
+			account:  types.NewClawbackContinuousVestingAccountRaw(newBaseVestingAccount(100), 100, funderAddr.String()),
+			expErr:   "start-time cannot be before end-time",
+		},
+		{
+			testName: "valid periodic",
+			account:  types.NewClawbackPeriodicVestingAccountRaw(newBaseVestingAccount(200), 100, periods(1, 100, 1000), funderAddr.String()),
+		},
+		{
+			testName: "periodic with invalid funder",
+			account:  types.NewClawbackPeriodicVestingAccountRaw(newBaseVestingAccount(200), 100, periods(1, 100, 1000), "seda1invalid"),
+			expErr:   "invalid funder address",
+		},
+		{
+			testName: "periodic with start after end",
+			account:  types.NewClawbackPeriodicVestingAccountRaw(newBaseVestingAccount(50), 100, periods(1, 100, 1000), funderAddr.String()),
+			expErr:   "start-time cannot be before end-time",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			err := tc.account.Validate()
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateGenesisAccounts(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, vesting.AppModuleBasic{}).Codec
+
+	testCases := []struct {
+		testName         string
+		genesisTime      int64
+		balance          int64
+		delegatedVesting int64
+		expErr           string
+	}{
+		{
+			testName:    "before vesting starts",
+			genesisTime: 100,
+			balance:     1000,
+		},
+		{
+			testName:    "vested coins spent",
+			genesisTime: 150,
+			balance:     500,
+		},
+		{
+			testName:         "vesting coins delegated",
+			genesisTime:      100,
+			balance:          400,
+			delegatedVesting: 600,
+		},
+		{
+			testName:    "all coins vested and spent",
+			genesisTime: 250,
+		},
+		{
+			testName:    "locked coins spent",
+			genesisTime: 150,
+			balance:     400,
+			expErr:      "does not cover its locked coins 500aseda",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			bva, err := sdkvestingtypes.NewBaseVestingAccount(authtypes.NewBaseAccountWithAddress(testAddrs[0]), sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000)), 200)
+			require.NoError(t, err)
+			bva.DelegatedVesting = sdk.NewCoins(sdk.NewInt64Coin(bondDenom, tc.delegatedVesting))
+			account := types.NewClawbackContinuousVestingAccountRaw(bva, 100, funderAddr.String())
+
+			genAccounts, err := authtypes.PackAccounts(authtypes.GenesisAccounts{account})
+			require.NoError(t, err)
+			authGenesis := authtypes.NewGenesisState(authtypes.DefaultParams(), nil)
+			authGenesis.Accounts = genAccounts
+
+			bankGenesis := banktypes.DefaultGenesisState()
+			bankGenesis.Balances = []banktypes.Balance{
+				{Address: testAddrs[0].String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, tc.balance))},
+			}
+
+			appState := map[string]json.RawMessage{
+				authtypes.ModuleName: cdc.MustMarshalJSON(authGenesis),
+				banktypes.ModuleName: cdc.MustMarshalJSON(bankGenesis),
+			}
+			err = types.ValidateGenesisAccounts(cdc, appState, time.Unix(tc.genesisTime, 0))
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestExportImportGenesis(t *testing.T) {
+	coins := func(amt int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(bondDenom, amt))
+	}
+
+	testCases := []struct {
+		testName string
+		run      func(t *testing.T, f *fixture, valAddrs []sdk.ValAddress, valPks []cryptotypes.PubKey)
+	}{
+		{
+			testName: "after spending vested coins",
+			run: func(t *testing.T, f *fixture, _ []sdk.ValAddress, _ []cryptotypes.PubKey) {
+				f.AddTime(50)
+				require.NoError(t, f.bankKeeper.SendCoins(f.Context(), testAddrs[0], testAddrs[9], coins(5000)))
+			},
+		},
+		{
+			testName: "after a clawback",
+			run: func(t *testing.T, f *fixture, _ []sdk.ValAddress, _ []cryptotypes.PubKey) {
+				f.AddTime(30)
+				_, err := f.RunMsg(types.NewMsgClawback(funderAddr, testAddrs[0]))
+				require.NoError(t, err)
+			},
+		},
+		{
+			testName: "after a slash",
+			run: func(t *testing.T, f *fixture, valAddrs []sdk.ValAddress, valPks []cryptotypes.PubKey) {
+				_, err := f.RunMsg(&sdkstakingtypes.MsgDelegate{
+					DelegatorAddress: testAddrs[0].String(),
+					ValidatorAddress: valAddrs[0].String(),
+					Amount:           sdk.NewInt64Coin(bondDenom, 10000),
+				})
+				require.NoError(t, err)
+
+				consAddr := sdk.ConsAddress(valPks[0].Address())
+				err = f.slashingKeeper.SetValidatorSigningInfo(f.Context(), consAddr, slashingtypes.NewValidatorSigningInfo(consAddr, 0, 0, time.Unix(0, 0), false, 0))
+				require.NoError(t, err)
+				require.NoError(t, f.slashingKeeper.Slash(f.Context(), consAddr, math.LegacyNewDecWithPrec(5, 1), 5, f.Context().BlockHeight()))
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			f := initFixture(t)
+			f.bankKeeper.SetSendEnabled(f.Context(), bondDenom, true)
+			require.NoError(t, banktestutil.FundAccount(f.Context(), f.bankKeeper, funderAddr, coins(500000)))
+			_, valAddrs, valPks := createValidators(t, f, []int64{5, 5, 5})
+
+			// 10000 vesting over 100 seconds
+			_, err := f.RunMsg(types.NewMsgCreateVestingAccount(funderAddr, testAddrs[0], coins(10000), 0, f.Context().BlockTime().Unix()+100, false))
+			require.NoError(t, err)
+			tc.run(t, f, valAddrs, valPks)
+
+			// The balance no longer covers the original vesting coins.
+			acc, ok := f.accountKeeper.GetAccount(f.Context(), testAddrs[0]).(types.ClawbackVestingAccount)
+			require.True(t, ok)
+			require.False(t, acc.GetOriginalVesting().IsAllLTE(f.bankKeeper.GetAllBalances(f.Context(), testAddrs[0])))
+
+			authGenesis := f.accountKeeper.ExportGenesis(f.Context())
+			bankGenesis := f.bankKeeper.ExportGenesis(f.Context())
+			appState := map[string]json.RawMessage{
+				authtypes.ModuleName: f.cdc.MustMarshalJSON(authGenesis),
+				banktypes.ModuleName: f.cdc.MustMarshalJSON(bankGenesis),
+			}
+			require.NoError(t, types.ValidateGenesisAccounts(f.cdc, appState, f.Context().BlockTime()))
+
+			imported := initFixture(t)
+			ctx := imported.Context().WithBlockTime(f.Context().BlockTime())
+			imported.accountKeeper.InitGenesis(ctx, *authGenesis)
+			imported.bankKeeper.InitGenesis(ctx, bankGenesis)
+			vesting.InitGenesis(ctx, imported.vestingKeeper, vesting.ExportGenesis(f.Context(), f.vestingKeeper))
+			require.NoError(t, imported.vestingKeeper.ValidateClawbackAccounts(ctx))
+		})
+	}
+}
+
+func TestInitGenesis(t *testing.T) {
+	f := initFixture(t)
+	f.bankKeeper.SetSendEnabled(f.Context(), bondDenom, true)
+	err := banktestutil.FundAccount(f.Context(), f.bankKeeper, funderAddr, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 500000)))
+	require.NoError(t, err)
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))
+	endTime := f.Context().BlockTime().Unix() + 100
+	for i := 0; i < 3; i++ {
+		_, err := f.RunMsg(types.NewMsgCreateVestingAccount(funderAddr, testAddrs[i], amount, 0, endTime, false))
+		require.NoError(t, err)
+	}
+
+	// The exported genesis state is valid and the index is rebuilt on
+	// import.
+	gs := vesting.ExportGenesis(f.Context(), f.vestingKeeper)
+	require.NoError(t, types.ValidateGenesis(gs))
+	for i := 0; i < 3; i++ {
+		f.vestingKeeper.RemoveFunderGrantee(f.Context(), funderAddr, testAddrs[i])
+	}
+	vesting.InitGenesis(f.Context(), f.vestingKeeper, gs)
+	require.Equal(t, testAddrs[:3], f.vestingKeeper.GetFunderGrantees(f.Context(), funderAddr))
+
+	// A clawback vesting account whose balance does not cover its locked
+	// coins is reported but does not stop the chain from starting.
+	bva, err := sdkvestingtypes.NewBaseVestingAccount(
+		f.accountKeeper.NewAccountWithAddress(f.Context(), testAddrs[3]).(*authtypes.BaseAccount), amount, endTime,
+	)
+	require.NoError(t, err)
+	f.accountKeeper.SetAccount(f.Context(), types.NewClawbackContinuousVestingAccountRaw(bva, f.Context().BlockTime().Unix(), funderAddr.String()))
+	require.EqualError(t, f.vestingKeeper.ValidateClawbackAccounts(f.Context()), "balance  of clawback vesting account "+testAddrs[3].String()+" does not cover its locked coins 1000aseda")
+	require.NotPanics(t, func() {
+		vesting.InitGenesis(f.Context(), f.vestingKeeper, gs)
+	})
+	require.Equal(t, testAddrs[:4], f.vestingKeeper.GetFunderGrantees(f.Context(), funderAddr))
+
+	err = banktestutil.FundAccount(f.Context(), f.bankKeeper, testAddrs[3], amount)
+	require.NoError(t, err)
+	require.NoError(t, f.vestingKeeper.ValidateClawbackAccounts(f.Context()))
+}
@@ -0,0 +1,206 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	sdkstakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/sedaprotocol/seda-chain/x/vesting/types"
+)
+
+func TestAddGrant(t *testing.T) {
+	f := initFixture(t)
+	f.bankKeeper.SetSendEnabled(f.Context(), bondDenom, true)
+	err := banktestutil.FundAccount(f.Context(), f.bankKeeper, funderAddr, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 500000)))
+	require.NoError(t, err)
+
+	_, valAddrs, _ := createValidators(t, f, []int64{5, 5, 5})
+
+	coins := func(amt int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(bondDenom, amt))
+	}
+
+	testCases := []struct {
+		testName            string
+		recipient           sdk.AccAddress
+		delegation          int64
+		grantEndDelta       int64 // relative to top-up time
+		grant               sdk.Coins
+		expOriginalVesting  sdk.Coins
+		expDelegatedVesting sdk.Coins
+		expDelegatedFree    sdk.Coins
+		expEndDelta         int64 // relative to top-up time
+		expSpendable        sdk.Coins
+		expVestedLater      []sdk.Coins // per grant, after another 25 seconds
+		expSpendableLater   sdk.Coins
+		expClawedBackLater  sdk.Coins
+	}{
+		{
+			testName:           "later end time",
+			recipient:          testAddrs[0],
+			grantEndDelta:      100,
+			grant:              coins(10000),
+			expOriginalVesting: coins(20000),
+			expEndDelta:        100,
+			expSpendable:       coins(5000),
+			expVestedLater:     []sdk.Coins{coins(7500), coins(2500)},
+			expSpendableLater:  coins(10000),
+			expClawedBackLater: coins(10000),
+		},
+		{
+			testName:           "earlier end time",
+			recipient:          testAddrs[1],
+			grantEndDelta:      10,
+			grant:              coins(5000),
+			expOriginalVesting: coins(15000),
+			expEndDelta:        50,
+			expSpendable:       coins(5000),
+			expVestedLater:     []sdk.Coins{coins(7500), coins(5000)},
+			expSpendableLater:  coins(12500),
+			expClawedBackLater: coins(2500),
+		},
+		{
+			testName:            "with delegation",
+			recipient:           testAddrs[2],
+			delegation:          8000,
+			grantEndDelta:       50,
+			grant:               coins(1000),
+			expOriginalVesting:  coins(11000),
+			expDelegatedVesting: coins(8000),
+			expEndDelta:         50,
+			expSpendable:        coins(3000),
+			expVestedLater:      []sdk.Coins{coins(7500), coins(500)},
+			expSpendableLater:   coins(3000),
+			expClawedBackLater:  coins(3000),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			f.AddBlock()
+
+			// 10000 vesting over 100 seconds
+			startTime := f.Context().BlockTime().Unix()
+			_, err := f.RunMsg(types.NewMsgCreateVestingAccount(funderAddr, tc.recipient, coins(10000), 0, startTime+100, false))
+			require.NoError(t, err)
+
+			if tc.delegation > 0 {
+				_, err = f.RunMsg(&sdkstakingtypes.MsgDelegate{
+					DelegatorAddress: tc.recipient.String(),
+					ValidatorAddress: valAddrs[0].String(),
+					Amount:           sdk.NewInt64Coin(bondDenom, tc.delegation),
+				})
+				require.NoError(t, err)
+			}
+
+			// top up half way through
+			f.AddTime(50)
+			topUpTime := f.Context().BlockTime().Unix()
+			_, err = f.RunMsg(types.NewMsgCreateVestingAccount(funderAddr, tc.recipient, tc.grant, 0, topUpTime+tc.grantEndDelta, false))
+			require.NoError(t, err)
+
+			acc, ok := f.accountKeeper.GetAccount(f.Context(), tc.recipient).(*types.ClawbackContinuousVestingAccount)
+			require.True(t, ok)
+			require.NoError(t, acc.Validate())
+			require.Equal(t, tc.expOriginalVesting, acc.OriginalVesting)
+			require.Equal(t, tc.expDelegatedVesting, acc.DelegatedVesting)
+			require.Equal(t, tc.expDelegatedFree, acc.DelegatedFree)
+			require.Equal(t, startTime, acc.StartTime)
+			require.Equal(t, topUpTime+tc.expEndDelta, acc.EndTime)
+			require.Equal(t, []types.ContinuousGrant{
+				{StartTime: startTime, EndTime: startTime + 100, Amount: coins(10000)},
+				{StartTime: topUpTime, EndTime: topUpTime + tc.grantEndDelta, Amount: tc.grant},
+			}, acc.Grants)
+			require.Equal(t, tc.expSpendable, f.bankKeeper.SpendableCoins(f.Context(), tc.recipient))
+
+			f.AddTime(25)
+			vested := sdk.NewCoins()
+			for i, grant := range acc.Grants {
+				require.Equal(t, tc.expVestedLater[i], grant.GetVestedCoins(f.Context().BlockTime()))
+				vested = vested.Add(tc.expVestedLater[i]...)
+			}
+			require.Equal(t, vested, acc.GetVestedCoins(f.Context().BlockTime()))
+			require.Equal(t, tc.expSpendableLater, f.bankKeeper.SpendableCoins(f.Context(), tc.recipient))
+
+			res, err := f.RunMsg(types.NewMsgClawback(funderAddr, tc.recipient))
+			require.NoError(t, err)
+
+			result := types.MsgClawbackResponse{}
+			err = f.cdc.Unmarshal(res.Value, &result)
+			require.NoError(t, err)
+			require.Equal(t, tc.expClawedBackLater, result.ClawedUnbonded.Add(result.ClawedBonded...).Add(result.ClawedUnbonding...))
+
+			acc, ok = f.accountKeeper.GetAccount(f.Context(), tc.recipient).(*types.ClawbackContinuousVestingAccount)
+			require.True(t, ok)
+			require.Empty(t, acc.Grants)
+			require.Equal(t, vested, acc.OriginalVesting.Sub(tc.expClawedBackLater...))
+		})
+	}
+}
+
+func TestAddGrantErrors(t *testing.T) {
+	f := initFixture(t)
+	f.bankKeeper.SetSendEnabled(f.Context(), bondDenom, true)
+	otherFunderAddr := testAddrs[9]
+	for _, addr := range []sdk.AccAddress{funderAddr, otherFunderAddr} {
+		err := banktestutil.FundAccount(f.Context(), f.bankKeeper, addr, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 500000)))
+		require.NoError(t, err)
+	}
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))
+	endTime := f.Context().BlockTime().Unix() + 100
+	for _, msg := range []sdk.Msg{
+		types.NewMsgCreateVestingAccount(funderAddr, testAddrs[0], amount, 0, endTime, false),
+		types.NewMsgCreateVestingAccount(funderAddr, testAddrs[1], amount, 0, endTime, true),
+		types.NewMsgCreatePeriodicVestingAccount(funderAddr, testAddrs[2], 0, periods(1, 100, 1000), 0, false),
+	} {
+		_, err := f.RunMsg(msg)
+		require.NoError(t, err)
+	}
+
+	testCases := []struct {
+		testName string
+		msg      *types.MsgCreateVestingAccount
+		expErr   string
+	}{
+		{
+			testName: "different funder",
+			msg:      types.NewMsgCreateVestingAccount(otherFunderAddr, testAddrs[0], amount, 0, endTime, false),
+			expErr:   "grants can only be added by original funder",
+		},
+		{
+			testName: "start time set",
+			msg:      types.NewMsgCreateVestingAccount(funderAddr, testAddrs[0], amount, endTime-50, endTime, false),
+			expErr:   "start time cannot be set",
+		},
+		{
+			testName: "clawback disabled in msg",
+			msg:      types.NewMsgCreateVestingAccount(funderAddr, testAddrs[0], amount, 0, endTime, true),
+			expErr:   "clawback cannot be disabled",
+		},
+		{
+			testName: "clawback disabled on account",
+			msg:      types.NewMsgCreateVestingAccount(funderAddr, testAddrs[1], amount, 0, endTime, false),
+			expErr:   "clawback disabled",
+		},
+		{
+			testName: "not a continuous vesting account",
+			msg:      types.NewMsgCreateVestingAccount(funderAddr, testAddrs[2], amount, 0, endTime, false),
+			expErr:   "already exists",
+		},
+		{
+			testName: "not a vesting account",
+			msg:      types.NewMsgCreateVestingAccount(funderAddr, otherFunderAddr, amount, 0, endTime, false),
+			expErr:   "already exists",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			_, err := f.RunMsg(tc.msg)
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+}
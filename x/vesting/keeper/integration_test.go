@@ -26,8 +26,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	sdkstakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	sdkstakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
@@ -65,18 +69,24 @@ var (
 
 type fixture struct {
 	*integration.IntegationApp
-	cdc           codec.Codec
-	accountKeeper authkeeper.AccountKeeper
-	bankKeeper    bankkeeper.Keeper
-	stakingKeeper stakingkeeper.Keeper
+	cdc            codec.Codec
+	accountKeeper  authkeeper.AccountKeeper
+	bankKeeper     bankkeeper.Keeper
+	stakingKeeper  stakingkeeper.Keeper
+	distrKeeper    distrkeeper.Keeper
+	slashingKeeper slashingkeeper.Keeper
+	vestingKeeper  keeper.Keeper
+	authority      sdk.AccAddress
+	querier        types.QueryServer
 }
 
 func initFixture(tb testing.TB) *fixture {
 	tb.Helper()
 	keys := storetypes.NewKVStoreKeys(
-		authtypes.StoreKey, banktypes.StoreKey, sdkstakingtypes.StoreKey, types.StoreKey,
+		authtypes.StoreKey, banktypes.StoreKey, sdkstakingtypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey, types.StoreKey,
 	)
-	cdc := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, bank.AppModuleBasic{}, vesting.AppModuleBasic{}).Codec
+	encCfg := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, bank.AppModuleBasic{}, vesting.AppModuleBasic{})
+	cdc := encCfg.Codec
 
 	logger := log.NewTestLogger(tb)
 	cms := sdkintegration.CreateMultiStore(keys, logger)
@@ -87,9 +97,10 @@ func initFixture(tb testing.TB) *fixture {
 
 	maccPerms := map[string][]string{
 		minttypes.ModuleName:              {authtypes.Minter},
+		distrtypes.ModuleName:             nil,
 		sdkstakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 		sdkstakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
-		types.ModuleName:                  {authtypes.Burner},
+		types.ModuleName:                  nil,
 	}
 
 	accountKeeper := authkeeper.NewAccountKeeper(
@@ -122,10 +133,18 @@ func initFixture(tb testing.TB) *fixture {
 	err := stakingKeeper.SetParams(newCtx, stakingParams)
 	require.NoError(tb, err)
 
+	distrKeeper := distrkeeper.NewKeeper(cdc, runtime.NewKVStoreService(keys[distrtypes.StoreKey]), accountKeeper, bankKeeper, sdkstakingKeeper, authtypes.FeeCollectorName, authority.String())
+	err = distrKeeper.FeePool.Set(newCtx, distrtypes.InitialFeePool())
+	require.NoError(tb, err)
+
+	slashingKeeper := slashingkeeper.NewKeeper(cdc, encCfg.Amino, runtime.NewKVStoreService(keys[slashingtypes.StoreKey]), stakingKeeper, authority.String())
+
+	vestingKeeper := keeper.NewKeeper(keys[types.StoreKey], accountKeeper, bankKeeper, stakingKeeper, distrKeeper, authority.String())
+
 	authModule := auth.NewAppModule(cdc, accountKeeper, app.RandomGenesisAccounts, nil)
 	bankModule := bank.NewAppModule(cdc, bankKeeper, accountKeeper, nil)
 	stakingModule := staking.NewAppModule(cdc, stakingKeeper, accountKeeper, bankKeeper, nil)
-	vestingModule := vesting.NewAppModule(accountKeeper, bankKeeper, stakingKeeper)
+	vestingModule := vesting.NewAppModule(*vestingKeeper, accountKeeper, bankKeeper, stakingKeeper)
 
 	integrationApp := integration.NewIntegrationApp(newCtx, logger, keys, cdc, map[string]appmodule.AppModule{
 		authtypes.ModuleName:       authModule,
@@ -134,15 +153,20 @@ func initFixture(tb testing.TB) *fixture {
 		types.ModuleName:           vestingModule,
 	})
 
-	types.RegisterMsgServer(integrationApp.MsgServiceRouter(), keeper.NewMsgServerImpl(accountKeeper, bankKeeper, stakingKeeper))
+	types.RegisterMsgServer(integrationApp.MsgServiceRouter(), keeper.NewMsgServerImpl(*vestingKeeper))
 	sdkstakingtypes.RegisterMsgServer(integrationApp.MsgServiceRouter(), sdkstakingkeeper.NewMsgServerImpl(sdkstakingKeeper))
 
 	return &fixture{
-		IntegationApp: integrationApp,
-		cdc:           cdc,
-		accountKeeper: accountKeeper,
-		bankKeeper:    bankKeeper,
-		stakingKeeper: *stakingKeeper,
+		IntegationApp:  integrationApp,
+		cdc:            cdc,
+		accountKeeper:  accountKeeper,
+		bankKeeper:     bankKeeper,
+		stakingKeeper:  *stakingKeeper,
+		distrKeeper:    distrKeeper,
+		slashingKeeper: slashingKeeper,
+		authority:      authority,
+		vestingKeeper:  *vestingKeeper,
+		querier:        keeper.NewQuerierImpl(*vestingKeeper),
 	}
 }
 
@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sedaprotocol/seda-chain/x/vesting/types"
+)
+
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	ak       types.AccountKeeper
+	bk       types.BankKeeper
+	sk       types.StakingKeeper
+	dk       types.DistributionKeeper
+
+	// authority is the address that can claw back any clawback-enabled
+	// vesting account to the community pool.
+	authority string
+}
+
+func NewKeeper(storeKey storetypes.StoreKey, ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper, dk types.DistributionKeeper, authority string) *Keeper {
+	return &Keeper{
+		storeKey:  storeKey,
+		ak:        ak,
+		bk:        bk,
+		sk:        sk,
+		dk:        dk,
+		authority: authority,
+	}
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// SetFunderGrantee adds a grantee to the index of a given funder.
+func (k Keeper) SetFunderGrantee(ctx sdk.Context, funder, grantee sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetFunderGranteeKey(funder, grantee), []byte{})
+}
+
+// HasFunderGrantee checks if a grantee is in the index of a given funder.
+func (k Keeper) HasFunderGrantee(ctx sdk.Context, funder, grantee sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetFunderGranteeKey(funder, grantee))
+}
+
+// RemoveFunderGrantee removes a grantee from the index of a given funder.
+func (k Keeper) RemoveFunderGrantee(ctx sdk.Context, funder, grantee sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetFunderGranteeKey(funder, grantee))
+}
+
+// GetFunderGrantees returns the grantees of a given funder in address
+// order.
+func (k Keeper) GetFunderGrantees(ctx sdk.Context, funder sdk.AccAddress) []sdk.AccAddress {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetFunderGranteesPrefix(funder)
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	var grantees []sdk.AccAddress
+	for ; iterator.Valid(); iterator.Next() {
+		grantees = append(grantees, sdk.AccAddress(iterator.Key()[len(prefix):]))
+	}
+	return grantees
+}
+
+// setFunderGranteeOfAccount indexes a clawback vesting account under its
+// funder, if it has one.
+func (k Keeper) setFunderGranteeOfAccount(ctx sdk.Context, acc types.ClawbackVestingAccount) error {
+	if acc.GetFunderAddress() == "" {
+		return nil
+	}
+	funder, err := k.ak.AddressCodec().StringToBytes(acc.GetFunderAddress())
+	if err != nil {
+		return err
+	}
+	k.SetFunderGrantee(ctx, funder, acc.GetAddress())
+	return nil
+}
+
+// RebuildFunderGrantees clears the funder-to-grantees index and rebuilds
+// it from the clawback vesting accounts in the account store.
+func (k Keeper) RebuildFunderGrantees(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixFunderGrantee)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	var err error
+	k.ak.IterateAccounts(ctx, func(acc sdk.AccountI) bool {
+		vestingAccount, ok := acc.(types.ClawbackVestingAccount)
+		if !ok {
+			return false
+		}
+		err = k.setFunderGranteeOfAccount(ctx, vestingAccount)
+		return err != nil
+	})
+	return err
+}
+
+// ValidateClawbackAccounts checks that each clawback vesting account in
+// the account store is valid and that its balance covers its coins that
+// are locked at the current block time.
+func (k Keeper) ValidateClawbackAccounts(ctx sdk.Context) error {
+	var err error
+	k.ak.IterateAccounts(ctx, func(acc sdk.AccountI) bool {
+		vestingAccount, ok := acc.(types.ClawbackVestingAccount)
+		if !ok {
+			return false
+		}
+		balance := k.bk.GetAllBalances(ctx, acc.GetAddress())
+		err = types.ValidateClawbackAccount(vestingAccount, balance, ctx.BlockTime())
+		return err != nil
+	})
+	return err
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
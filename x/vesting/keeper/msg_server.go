@@ -19,15 +19,13 @@ import (
 )
 
 type msgServer struct {
-	ak types.AccountKeeper
-	bk types.BankKeeper
-	sk types.StakingKeeper
+	Keeper
 }
 
-// NewMsgServerImpl returns an implementation of the vesting MsgServer interface,
-// wrapping the corresponding AccountKeeper and BankKeeper.
-func NewMsgServerImpl(ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper) types.MsgServer {
-	return &msgServer{ak: ak, bk: bk, sk: sk}
+// NewMsgServerImpl returns an implementation of the vesting MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
 }
 
 var _ types.MsgServer = msgServer{}
@@ -52,6 +50,17 @@ func (m msgServer) CreateVestingAccount(goCtx context.Context, msg *types.MsgCre
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	startTime := msg.StartTime
+	if startTime == 0 {
+		startTime = ctx.BlockTime().Unix()
+	}
+	if err := validateStartTime(ctx, startTime); err != nil {
+		return nil, err
+	}
+	if startTime >= msg.EndTime {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "start time %d must be before end time %d", startTime, msg.EndTime)
+	}
+
 	if err := m.bk.IsSendEnabledCoins(ctx, msg.Amount...); err != nil {
 		return nil, err
 	}
@@ -61,7 +70,10 @@ func (m msgServer) CreateVestingAccount(goCtx context.Context, msg *types.MsgCre
 	}
 
 	if acc := m.ak.GetAccount(ctx, to); acc != nil {
-		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
+		if err := m.addGrant(ctx, from, acc, msg); err != nil {
+			return nil, err
+		}
+		return &types.MsgCreateVestingAccountResponse{}, nil
 	}
 
 	baseAccount := authtypes.NewBaseAccountWithAddress(to)
@@ -71,12 +83,15 @@ func (m msgServer) CreateVestingAccount(goCtx context.Context, msg *types.MsgCre
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
-	vestingAccount := types.NewClawbackContinuousVestingAccountRaw(baseVestingAccount, ctx.BlockTime().Unix(), msg.FromAddress)
+	vestingAccount := types.NewClawbackContinuousVestingAccountRaw(baseVestingAccount, startTime, msg.FromAddress)
 	if msg.DisableClawback {
 		vestingAccount.FunderAddress = ""
 	}
 
 	m.ak.SetAccount(ctx, vestingAccount)
+	if !msg.DisableClawback {
+		m.SetFunderGrantee(ctx, from, to)
+	}
 
 	defer func() {
 		telemetry.IncrCounter(1, "new", "account")
@@ -96,9 +111,178 @@ func (m msgServer) CreateVestingAccount(goCtx context.Context, msg *types.MsgCre
 		return nil, err
 	}
 
+	err = ctx.EventManager().EmitTypedEvent(
+		&types.EventCreateVestingAccount{
+			Funder:          msg.FromAddress,
+			Recipient:       msg.ToAddress,
+			Amount:          msg.Amount,
+			Start:           vestingAccount.StartTime,
+			End:             vestingAccount.EndTime,
+			ClawbackEnabled: !msg.DisableClawback,
+		})
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.MsgCreateVestingAccountResponse{}, nil
 }
 
+// addGrant adds the amount of a MsgCreateVestingAccount to the existing
+// clawback continuous vesting account of the same funder as a new grant
+// vesting from the block time until the end time of the message.
+func (m msgServer) addGrant(ctx sdk.Context, from sdk.AccAddress, acc sdk.AccountI, msg *types.MsgCreateVestingAccount) error {
+	vestingAccount, isClawback := acc.(*types.ClawbackContinuousVestingAccount)
+	if !isClawback {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
+	}
+	if vestingAccount.FunderAddress == "" {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "vesting account has no funder registered (clawback disabled): %s", msg.ToAddress)
+	}
+	if vestingAccount.FunderAddress != msg.FromAddress {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "grants can only be added by original funder: %s", vestingAccount.FunderAddress)
+	}
+	if msg.StartTime != 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "start time cannot be set when adding a grant to an existing vesting account")
+	}
+	if msg.DisableClawback {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "clawback cannot be disabled when adding a grant to an existing vesting account")
+	}
+
+	vestingAccount.AddGrant(ctx.BlockTime(), msg.Amount, msg.EndTime)
+	m.ak.SetAccount(ctx, vestingAccount)
+
+	defer func() {
+		for _, a := range msg.Amount {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "create_vesting_account"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	if err := m.bk.SendCoins(ctx, from, vestingAccount.GetAddress(), msg.Amount); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventCreateVestingAccount{
+			Funder:          msg.FromAddress,
+			Recipient:       msg.ToAddress,
+			Amount:          msg.Amount,
+			Start:           ctx.BlockTime().Unix(),
+			End:             msg.EndTime,
+			ClawbackEnabled: true,
+		})
+}
+
+func (m msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *types.MsgCreatePeriodicVestingAccount) (*types.MsgCreatePeriodicVestingAccountResponse, error) {
+	from, err := m.ak.AddressCodec().StringToBytes(msg.FromAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid 'from' address: %s", err)
+	}
+
+	to, err := m.ak.AddressCodec().StringToBytes(msg.ToAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid 'to' address: %s", err)
+	}
+
+	if len(msg.VestingPeriods) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "vesting periods cannot be empty")
+	}
+
+	for i, period := range msg.VestingPeriods {
+		if period.Length < 1 {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid period length of %d in period %d, length must be greater than 0", period.Length, i)
+		}
+		if err := validateAmount(period.Amount); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	startTime := msg.StartTime
+	if startTime == 0 {
+		startTime = ctx.BlockTime().Unix()
+	}
+	if err := validateStartTime(ctx, startTime); err != nil {
+		return nil, err
+	}
+
+	periods := sdkvestingtypes.Periods(msg.VestingPeriods)
+	if msg.CliffTime != 0 {
+		if msg.CliffTime > startTime+periods.TotalLength() {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "cliff time cannot be after end of vesting")
+		}
+		periods = types.ApplyCliff(startTime, msg.CliffTime, periods)
+	}
+	totalCoins := periods.TotalAmount()
+
+	if err := m.bk.IsSendEnabledCoins(ctx, totalCoins...); err != nil {
+		return nil, err
+	}
+
+	if m.bk.BlockedAddr(to) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+	}
+
+	if acc := m.ak.GetAccount(ctx, to); acc != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
+	}
+
+	baseAccount := authtypes.NewBaseAccountWithAddress(to)
+	baseAccount = m.ak.NewAccount(ctx, baseAccount).(*authtypes.BaseAccount)
+	baseVestingAccount, err := sdkvestingtypes.NewBaseVestingAccount(baseAccount, totalCoins.Sort(), startTime+periods.TotalLength())
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	vestingAccount := types.NewClawbackPeriodicVestingAccountRaw(baseVestingAccount, startTime, periods, msg.FromAddress)
+	if msg.DisableClawback {
+		vestingAccount.FunderAddress = ""
+	}
+
+	m.ak.SetAccount(ctx, vestingAccount)
+	if !msg.DisableClawback {
+		m.SetFunderGrantee(ctx, from, to)
+	}
+
+	defer func() {
+		telemetry.IncrCounter(1, "new", "account")
+
+		for _, a := range totalCoins {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "create_periodic_vesting_account"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	if err = m.bk.SendCoins(ctx, from, to, totalCoins); err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(
+		&types.EventCreateVestingAccount{
+			Funder:          msg.FromAddress,
+			Recipient:       msg.ToAddress,
+			Amount:          totalCoins,
+			Start:           vestingAccount.StartTime,
+			End:             vestingAccount.EndTime,
+			ClawbackEnabled: !msg.DisableClawback,
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreatePeriodicVestingAccountResponse{}, nil
+}
+
 // Clawback returns the vesting amount from a ClawbackVestingAccount to the funder.
 // The funds are transferred from the following sources
 // 1. vesting funds that have not been used towards delegation
@@ -106,18 +290,34 @@ func (m msgServer) CreateVestingAccount(goCtx context.Context, msg *types.MsgCre
 // 3. unbonding delegations
 // in this order, as much as possible, until the vesting amount is met. Note that
 // due to slashing the funds from all three of these sources may still fail to meet
-// the vesting amount according to the vesting schedule.
+// the vesting amount according to the vesting schedule. Any amount that cannot
+// be transferred from staking is reported as shortfall and remains in the
+// account, which is fully vested after the clawback.
+//
+// If the request is sent by the authority instead of the funder, the vesting
+// funds that have not been used towards delegation are sent to the community
+// pool, while delegations and unbonding delegations are transferred to the
+// authority account.
 func (m msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// NOTE: errors checked during msg validation
 	vestingAccAddr := sdk.MustAccAddressFromBech32(msg.AccountAddress)
 	funderAddr := sdk.MustAccAddressFromBech32(msg.FunderAddress)
+	isAuthority := msg.FunderAddress == m.authority
+
+	// Coins clawed back from staking are transferred to the funder or, in
+	// case of the authority, to the module account, where they are undelegated
+	// and then sent to the community pool by the end blocker once released.
+	stakingDestAddr := funderAddr
+	if isAuthority {
+		stakingDestAddr = m.ak.GetModuleAccount(ctx, types.ModuleName).GetAddress()
+	}
 
 	// NOTE: we check the destination address only for the case where it's not sent from the
 	// authority account, because in that case the destination address is hardcored to the
 	// community pool address anyway (see further below).
-	if m.bk.BlockedAddr(funderAddr) {
+	if !isAuthority && m.bk.BlockedAddr(funderAddr) {
 		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized,
 			"%s is a blocked address and not allowed to receive funds", msg.FunderAddress,
 		)
@@ -128,18 +328,18 @@ func (m msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*typ
 	if acc == nil {
 		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "account at address '%s' does not exist", vestingAccAddr.String())
 	}
-	vestingAccount, isClawback := acc.(*types.ClawbackContinuousVestingAccount)
+	vestingAccount, isClawback := acc.(types.ClawbackVestingAccount)
 	if !isClawback {
-		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not of type ClawbackContinuousVestingAccount", vestingAccAddr.String())
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not a clawback vesting account", vestingAccAddr.String())
 	}
 	if vestingAccount.GetVestingCoins(ctx.BlockTime()).IsZero() {
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s does not have currently vesting coins", msg.AccountAddress)
 	}
-	if vestingAccount.FunderAddress == "" {
+	if vestingAccount.GetFunderAddress() == "" {
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "vesting account has no funder registered (clawback disabled): %s", msg.AccountAddress)
 	}
-	if vestingAccount.FunderAddress != msg.FunderAddress {
-		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "clawback can only be requested by original funder: %s", vestingAccount.FunderAddress)
+	if !isAuthority && vestingAccount.GetFunderAddress() != msg.FunderAddress {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "clawback can only be requested by original funder: %s", vestingAccount.GetFunderAddress())
 	}
 
 	// Compute the clawback based on bank balance and delegation, and update account
@@ -164,9 +364,9 @@ func (m msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*typ
 	toClawBack := coinsMin(vestingAccount.GetVestingCoins(ctx.BlockTime()), total) // might have been slashed
 
 	// Write now now so that the bank module sees unvested tokens are unlocked.
-	// Note that all store writes are aborted if there is a panic, so there is
-	// no danger in writing incomplete results.
-	vestingAccount.EndTime = ctx.BlockTime().Unix() // so that all of original vesting is vested now
+	// Note that all store writes are aborted if an error is returned, so there
+	// is no danger in writing incomplete results.
+	vestingAccount.EndVesting(ctx.BlockTime().Unix()) // so that all of original vesting is vested now
 	m.ak.SetAccount(ctx, vestingAccount)
 
 	// Now that future vesting events (and associated lockup) are removed,
@@ -174,7 +374,11 @@ func (m msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*typ
 	spendable := m.bk.SpendableCoins(ctx, vestingAccAddr)
 	toXfer := coinsMin(toClawBack, spendable)
 	if toXfer.IsAllPositive() {
-		err = m.bk.SendCoins(ctx, vestingAccAddr, funderAddr, toXfer)
+		if isAuthority {
+			err = m.dk.FundCommunityPool(ctx, toXfer, vestingAccAddr)
+		} else {
+			err = m.bk.SendCoins(ctx, vestingAccAddr, funderAddr, toXfer)
+		}
 		if err != nil {
 			return nil, err // shouldn't happen, given spendable check
 		}
@@ -183,6 +387,7 @@ func (m msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*typ
 	clawedBackUnbonded := toXfer
 	clawedBackUnbonding := sdk.NewCoins()
 	clawedBackBonded := sdk.NewCoins()
+	shortfall := sdk.NewCoins()
 	toClawBack = toClawBack.Sub(toXfer...)
 	if !toClawBack.IsZero() {
 		// claw back from staking (unbonding delegations then bonded delegations)
@@ -199,7 +404,7 @@ func (m msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*typ
 				return nil, err
 			}
 
-			transferred, err := m.sk.TransferUnbonding(ctx, vestingAccAddr, funderAddr, valAddr, toClawBackStaking)
+			transferred, err := m.sk.TransferUnbonding(ctx, vestingAccAddr, stakingDestAddr, valAddr, toClawBackStaking)
 			if err != nil {
 				return nil, err
 			}
@@ -236,11 +441,26 @@ func (m msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*typ
 					// validator has no tokens
 					continue
 				}
+				if isAuthority {
+					hasMaxEntries, err := m.sk.HasMaxUnbondingDelegationEntries(ctx, stakingDestAddr, validatorAddr)
+					if err != nil {
+						return nil, err
+					}
+					if hasMaxEntries {
+						// the transferred shares could not be undelegated
+						continue
+					}
+				}
 
-				transferredShares, err := m.sk.TransferDelegation(ctx, vestingAccAddr, funderAddr, validatorAddr, wantShares)
+				transferredShares, err := m.sk.TransferDelegation(ctx, vestingAccAddr, stakingDestAddr, validatorAddr, wantShares)
 				if err != nil {
 					return nil, err
 				}
+				if isAuthority && transferredShares.IsPositive() {
+					if _, _, err := m.sk.Undelegate(ctx, stakingDestAddr, validatorAddr, transferredShares); err != nil {
+						return nil, err
+					}
+				}
 
 				// to be conservative in what we're clawing back, round transferred shares up
 				transferred := validator.TokensFromSharesRoundUp(transferredShares).RoundInt()
@@ -254,18 +474,122 @@ func (m msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*typ
 			}
 		}
 
-		if !toClawBackStaking.IsZero() {
-			panic("failed to claw back full amount")
+		// The staking transfers may fall short of the target due to rounding,
+		// validators without tokens, or the entry limits of the destination.
+		// The clawback is not reverted in that case, since it could not be
+		// retried before the staking entries mature. The shortfall is left
+		// in the account, which is fully vested by now, and reported.
+		for _, coin := range toClawBack {
+			if coin.Denom != bondDenom {
+				shortfall = shortfall.Add(coin)
+			}
 		}
+		if toClawBackStaking.IsPositive() {
+			shortfall = shortfall.Add(sdk.NewCoin(bondDenom, toClawBackStaking))
+		}
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(
+		&types.EventClawback{
+			Funder:          msg.FunderAddress,
+			Account:         msg.AccountAddress,
+			ClawedUnbonded:  clawedBackUnbonded,
+			ClawedUnbonding: clawedBackUnbonding,
+			ClawedBonded:    clawedBackBonded,
+			Shortfall:       shortfall,
+		})
+	if err != nil {
+		return nil, err
 	}
 
 	return &types.MsgClawbackResponse{
 		ClawedUnbonded:  clawedBackUnbonded,
 		ClawedUnbonding: clawedBackUnbonding,
 		ClawedBonded:    clawedBackBonded,
+		Shortfall:       shortfall,
 	}, nil
 }
 
+// UpdateVestingFunder reassigns the funder of a clawback vesting account
+// or disables its clawback permanently.
+func (m msgServer) UpdateVestingFunder(goCtx context.Context, msg *types.MsgUpdateVestingFunder) (*types.MsgUpdateVestingFunderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vestingAccAddr, err := m.ak.AddressCodec().StringToBytes(msg.AccountAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid account address: %s", err)
+	}
+
+	newFunder := msg.NewFunderAddress
+	if msg.DisableClawback {
+		if newFunder != "" {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "new funder must be empty when disabling clawback")
+		}
+	} else {
+		newFunderAddr, err := m.ak.AddressCodec().StringToBytes(newFunder)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid new funder address: %s", err)
+		}
+		if m.bk.BlockedAddr(newFunderAddr) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", newFunder)
+		}
+		if newFunder == msg.FunderAddress {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "new funder is the same as the current funder")
+		}
+	}
+
+	acc := m.ak.GetAccount(ctx, vestingAccAddr)
+	if acc == nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "account at address '%s' does not exist", msg.AccountAddress)
+	}
+	vestingAccount, isClawback := acc.(types.ClawbackVestingAccount)
+	if !isClawback {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not a clawback vesting account", msg.AccountAddress)
+	}
+	if vestingAccount.GetFunderAddress() == "" {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "vesting account has no funder registered (clawback disabled): %s", msg.AccountAddress)
+	}
+	if vestingAccount.GetFunderAddress() != msg.FunderAddress {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "funder can only be updated by current funder: %s", vestingAccount.GetFunderAddress())
+	}
+
+	vestingAccount.SetFunderAddress(newFunder)
+	m.ak.SetAccount(ctx, vestingAccount)
+
+	// NOTE: the funder address is known to be valid as it matches the
+	// funder of the account.
+	m.RemoveFunderGrantee(ctx, sdk.MustAccAddressFromBech32(msg.FunderAddress), vestingAccAddr)
+	if err := m.setFunderGranteeOfAccount(ctx, vestingAccount); err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(
+		&types.EventUpdateVestingFunder{
+			AccountAddress:   msg.AccountAddress,
+			OldFunderAddress: msg.FunderAddress,
+			NewFunderAddress: newFunder,
+			ClawbackDisabled: msg.DisableClawback,
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateVestingFunderResponse{}, nil
+}
+
+// validateStartTime checks that the given start time of a vesting
+// account is within the allowed offsets from the block time.
+func validateStartTime(ctx sdk.Context, startTime int64) error {
+	blockTime := ctx.BlockTime().Unix()
+	if startTime < blockTime-types.MaxStartTimePastOffset {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "start time %d is more than %d seconds in the past", startTime, types.MaxStartTimePastOffset)
+	}
+	if startTime > blockTime+types.MaxStartTimeFutureOffset {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "start time %d is more than %d seconds in the future", startTime, types.MaxStartTimeFutureOffset)
+	}
+	return nil
+}
+
 func validateAmount(amount sdk.Coins) error {
 	if !amount.IsValid() {
 		return sdkerrors.ErrInvalidCoins.Wrap(amount.String())
@@ -0,0 +1,199 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkvestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	"github.com/sedaprotocol/seda-chain/x/vesting/types"
+)
+
+func periods(n int, length, amount int64) []sdkvestingtypes.Period {
+	res := make([]sdkvestingtypes.Period, n)
+	for i := range res {
+		res[i] = sdkvestingtypes.Period{Length: length, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, amount))}
+	}
+	return res
+}
+
+func TestCreatePeriodicVestingAccount(t *testing.T) {
+	f := initFixture(t)
+	f.bankKeeper.SetSendEnabled(f.Context(), bondDenom, true)
+	err := banktestutil.FundAccount(f.Context(), f.bankKeeper, funderAddr, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 500000)))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		testName    string
+		recipient   sdk.AccAddress
+		periods     []sdkvestingtypes.Period
+		cliff       int64 // relative to block time
+		expPeriods  []sdkvestingtypes.Period
+		expEndDelta int64
+		expErr      string
+	}{
+		{
+			testName:    "no cliff",
+			recipient:   testAddrs[0],
+			periods:     periods(4, 100, 1000),
+			expPeriods:  periods(4, 100, 1000),
+			expEndDelta: 400,
+		},
+		{
+			testName:  "cliff spanning a period",
+			recipient: testAddrs[1],
+			periods:   periods(4, 100, 1000),
+			cliff:     250,
+			expPeriods: []sdkvestingtypes.Period{
+				{Length: 250, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 2000))},
+				{Length: 50, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))},
+				{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))},
+			},
+			expEndDelta: 400,
+		},
+		{
+			testName:  "cliff at end of a period",
+			recipient: testAddrs[2],
+			periods:   periods(4, 100, 1000),
+			cliff:     200,
+			expPeriods: []sdkvestingtypes.Period{
+				{Length: 200, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 2000))},
+				{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))},
+				{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))},
+			},
+			expEndDelta: 400,
+		},
+		{
+			testName:  "cliff after end of vesting",
+			recipient: testAddrs[3],
+			periods:   periods(4, 100, 1000),
+			cliff:     500,
+			expErr:    "cliff time cannot be after end of vesting",
+		},
+		{
+			testName:  "empty periods",
+			recipient: testAddrs[3],
+			expErr:    "vesting periods cannot be empty",
+		},
+		{
+			testName:  "invalid period length",
+			recipient: testAddrs[3],
+			periods:   periods(2, 0, 1000),
+			expErr:    "invalid period length",
+		},
+		{
+			testName:  "account already exists",
+			recipient: testAddrs[0],
+			periods:   periods(4, 100, 1000),
+			expErr:    "already exists",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			blockTime := f.Context().BlockTime().Unix()
+			var cliffTime int64
+			if tc.cliff != 0 {
+				cliffTime = blockTime + tc.cliff
+			}
+
+			msg := types.NewMsgCreatePeriodicVestingAccount(funderAddr, tc.recipient, 0, tc.periods, cliffTime, false)
+			_, err := f.RunMsg(msg)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			acc, ok := f.accountKeeper.GetAccount(f.Context(), tc.recipient).(*types.ClawbackPeriodicVestingAccount)
+			require.True(t, ok)
+			require.NoError(t, acc.Validate())
+			require.Equal(t, funderAddr.String(), acc.FunderAddress)
+			require.Equal(t, blockTime, acc.StartTime)
+			require.Equal(t, blockTime+tc.expEndDelta, acc.EndTime)
+			require.Equal(t, tc.expPeriods, acc.VestingPeriods)
+		})
+	}
+}
+
+func TestClawbackPeriodic(t *testing.T) {
+	f := initFixture(t)
+	f.bankKeeper.SetSendEnabled(f.Context(), bondDenom, true)
+	err := banktestutil.FundAccount(f.Context(), f.bankKeeper, funderAddr, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 500000)))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		testName                string
+		recipient               sdk.AccAddress
+		cliff                   int64 // relative to block time
+		timeUntilClawback       int64
+		expClawedUnbonded       sdk.Coins
+		recipientFinalSpendable sdk.Coins
+	}{
+		{
+			testName:                "no cliff",
+			recipient:               testAddrs[0],
+			timeUntilClawback:       150,
+			expClawedUnbonded:       sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 3000)),
+			recipientFinalSpendable: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000)),
+		},
+		{
+			testName:                "before cliff",
+			recipient:               testAddrs[1],
+			cliff:                   250,
+			timeUntilClawback:       150,
+			expClawedUnbonded:       sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 4000)),
+			recipientFinalSpendable: sdk.NewCoins(),
+		},
+		{
+			testName:                "after cliff",
+			recipient:               testAddrs[2],
+			cliff:                   250,
+			timeUntilClawback:       260,
+			expClawedUnbonded:       sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 2000)),
+			recipientFinalSpendable: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 2000)),
+		},
+		{
+			testName:                "after cliff and next period",
+			recipient:               testAddrs[3],
+			cliff:                   250,
+			timeUntilClawback:       320,
+			expClawedUnbonded:       sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000)),
+			recipientFinalSpendable: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 3000)),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			f.AddBlock()
+
+			blockTime := f.Context().BlockTime().Unix()
+			var cliffTime int64
+			if tc.cliff != 0 {
+				cliffTime = blockTime + tc.cliff
+			}
+
+			msg := types.NewMsgCreatePeriodicVestingAccount(funderAddr, tc.recipient, 0, periods(4, 100, 1000), cliffTime, false)
+			_, err := f.RunMsg(msg)
+			require.NoError(t, err)
+
+			f.AddTime(tc.timeUntilClawback)
+
+			res, err := f.RunMsg(types.NewMsgClawback(funderAddr, tc.recipient))
+			require.NoError(t, err)
+
+			result := types.MsgClawbackResponse{}
+			err = f.cdc.Unmarshal(res.Value, &result)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.expClawedUnbonded, result.ClawedUnbonded)
+			require.Equal(t, tc.recipientFinalSpendable, f.bankKeeper.SpendableCoins(f.Context(), tc.recipient))
+
+			acc, ok := f.accountKeeper.GetAccount(f.Context(), tc.recipient).(*types.ClawbackPeriodicVestingAccount)
+			require.True(t, ok)
+			require.NoError(t, acc.Validate())
+			require.True(t, acc.GetVestingCoins(f.Context().BlockTime()).IsZero())
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+
+	"github.com/sedaprotocol/seda-chain/x/vesting/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+type Querier struct {
+	Keeper
+}
+
+// NewQuerierImpl returns an implementation of the vesting QueryServer
+// interface for the provided Keeper.
+func NewQuerierImpl(keeper Keeper) types.QueryServer {
+	return &Querier{Keeper: keeper}
+}
+
+func (q Querier) VestingAccount(c context.Context, req *types.QueryVestingAccountRequest) (*types.QueryVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	vestingAccount, err := q.getVestingAccount(ctx, req.Address)
+	if err != nil {
+		return nil, err
+	}
+	accAny, err := codectypes.NewAnyWithValue(vestingAccount)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryVestingAccountResponse{
+		Account: accAny,
+	}, nil
+}
+
+func (q Querier) Balances(c context.Context, req *types.QueryBalancesRequest) (*types.QueryBalancesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	vestingAccount, err := q.getVestingAccount(ctx, req.Address)
+	if err != nil {
+		return nil, err
+	}
+	blockTime := ctx.BlockTime()
+	return &types.QueryBalancesResponse{
+		Vested:           vestingAccount.GetVestedCoins(blockTime),
+		Unvested:         vestingAccount.GetVestingCoins(blockTime),
+		Locked:           vestingAccount.LockedCoins(blockTime),
+		Spendable:        q.bk.SpendableCoins(ctx, vestingAccount.GetAddress()),
+		DelegatedVesting: vestingAccount.GetDelegatedVesting(),
+		DelegatedFree:    vestingAccount.GetDelegatedFree(),
+	}, nil
+}
+
+// AccountsByFunder returns the clawback vesting accounts of the given
+// funder in address order using the funder-to-grantees index.
+func (q Querier) AccountsByFunder(c context.Context, req *types.QueryAccountsByFunderRequest) (*types.QueryAccountsByFunderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	funder, err := q.ak.AddressCodec().StringToBytes(req.Funder)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid funder address: %s", err)
+	}
+
+	var accounts []*codectypes.Any
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.GetFunderGranteesPrefix(funder))
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		acc := q.ak.GetAccount(ctx, key)
+		if acc == nil {
+			return errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "account at address '%s' does not exist", sdk.AccAddress(key))
+		}
+		accAny, err := codectypes.NewAnyWithValue(acc)
+		if err != nil {
+			return err
+		}
+		accounts = append(accounts, accAny)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAccountsByFunderResponse{
+		Accounts:   accounts,
+		Pagination: pageRes,
+	}, nil
+}
+
+// getVestingAccount returns the vesting account at the given address.
+func (q Querier) getVestingAccount(ctx sdk.Context, address string) (exported.VestingAccount, error) {
+	addr, err := q.ak.AddressCodec().StringToBytes(address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+	acc := q.ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "account at address '%s' does not exist", address)
+	}
+	vestingAccount, ok := acc.(exported.VestingAccount)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not a vesting account", address)
+	}
+	return vestingAccount, nil
+}
@@ -0,0 +1,207 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	sdkstakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/sedaprotocol/seda-chain/x/vesting/types"
+)
+
+func TestQueryVestingAccountAndBalances(t *testing.T) {
+	f := initFixture(t)
+	f.bankKeeper.SetSendEnabled(f.Context(), bondDenom, true)
+	err := banktestutil.FundAccount(f.Context(), f.bankKeeper, funderAddr, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 500000)))
+	require.NoError(t, err)
+
+	_, valAddrs, _ := createValidators(t, f, []int64{5, 5, 5})
+
+	coins := func(amt int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(bondDenom, amt))
+	}
+
+	recipient := testAddrs[0]
+	_, err = f.RunMsg(types.NewMsgCreateVestingAccount(funderAddr, recipient, coins(10000), 0, f.Context().BlockTime().Unix()+100, false))
+	require.NoError(t, err)
+	_, err = f.RunMsg(&sdkstakingtypes.MsgDelegate{
+		DelegatorAddress: recipient.String(),
+		ValidatorAddress: valAddrs[0].String(),
+		Amount:           sdk.NewInt64Coin(bondDenom, 2000),
+	})
+	require.NoError(t, err)
+	f.AddTime(30)
+
+	accRes, err := f.querier.VestingAccount(f.Context(), &types.QueryVestingAccountRequest{Address: recipient.String()})
+	require.NoError(t, err)
+	var acc sdk.AccountI
+	err = f.cdc.UnpackAny(accRes.Account, &acc)
+	require.NoError(t, err)
+	vestingAccount, ok := acc.(*types.ClawbackContinuousVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, funderAddr.String(), vestingAccount.FunderAddress)
+
+	balRes, err := f.querier.Balances(f.Context(), &types.QueryBalancesRequest{Address: recipient.String()})
+	require.NoError(t, err)
+	require.Equal(t, coins(3000), balRes.Vested)
+	require.Equal(t, coins(7000), balRes.Unvested)
+	require.Equal(t, coins(5000), balRes.Locked)
+	require.Equal(t, coins(3000), balRes.Spendable)
+	require.Equal(t, coins(2000), balRes.DelegatedVesting)
+	require.Equal(t, sdk.Coins(nil), balRes.DelegatedFree)
+
+	_, err = f.querier.Balances(f.Context(), &types.QueryBalancesRequest{Address: funderAddr.String()})
+	require.ErrorContains(t, err, "not a vesting account")
+	_, err = f.querier.VestingAccount(f.Context(), &types.QueryVestingAccountRequest{Address: testAddrs[1].String()})
+	require.ErrorContains(t, err, "does not exist")
+}
+
+func TestQueryAccountsByFunder(t *testing.T) {
+	f := initFixture(t)
+	f.bankKeeper.SetSendEnabled(f.Context(), bondDenom, true)
+	otherFunderAddr := testAddrs[9]
+	for _, addr := range []sdk.AccAddress{funderAddr, otherFunderAddr} {
+		err := banktestutil.FundAccount(f.Context(), f.bankKeeper, addr, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 500000)))
+		require.NoError(t, err)
+	}
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))
+	endTime := f.Context().BlockTime().Unix() + 100
+	for i := 0; i < 5; i++ {
+		_, err := f.RunMsg(types.NewMsgCreateVestingAccount(funderAddr, testAddrs[i], amount, 0, endTime, false))
+		require.NoError(t, err)
+	}
+	_, err := f.RunMsg(types.NewMsgCreatePeriodicVestingAccount(funderAddr, testAddrs[5], 0, periods(1, 100, 1000), 0, false))
+	require.NoError(t, err)
+	_, err = f.RunMsg(types.NewMsgCreateVestingAccount(otherFunderAddr, testAddrs[6], amount, 0, endTime, false))
+	require.NoError(t, err)
+	_, err = f.RunMsg(types.NewMsgCreateVestingAccount(funderAddr, testAddrs[7], amount, 0, endTime, true))
+	require.NoError(t, err)
+
+	addresses := func(res *types.QueryAccountsByFunderResponse) []string {
+		var addrs []string
+		for _, accAny := range res.Accounts {
+			var acc sdk.AccountI
+			require.NoError(t, f.cdc.UnpackAny(accAny, &acc))
+			addrs = append(addrs, acc.GetAddress().String())
+		}
+		return addrs
+	}
+	expected := []string{
+		testAddrs[0].String(), testAddrs[1].String(), testAddrs[2].String(),
+		testAddrs[3].String(), testAddrs[4].String(), testAddrs[5].String(),
+	}
+
+	res, err := f.querier.AccountsByFunder(f.Context(), &types.QueryAccountsByFunderRequest{
+		Funder:     funderAddr.String(),
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.ElementsMatch(t, expected, addresses(res))
+	require.Equal(t, uint64(6), res.Pagination.Total)
+	require.Nil(t, res.Pagination.NextKey)
+
+	// page through with keys
+	var paged []string
+	pageReq := &query.PageRequest{Limit: 4}
+	for {
+		res, err = f.querier.AccountsByFunder(f.Context(), &types.QueryAccountsByFunderRequest{
+			Funder:     funderAddr.String(),
+			Pagination: pageReq,
+		})
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(res.Accounts), 4)
+		paged = append(paged, addresses(res)...)
+		if res.Pagination.NextKey == nil {
+			break
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 4}
+	}
+	require.ElementsMatch(t, expected, paged)
+	require.Len(t, paged, len(expected))
+
+	// page with offset
+	res, err = f.querier.AccountsByFunder(f.Context(), &types.QueryAccountsByFunderRequest{
+		Funder:     funderAddr.String(),
+		Pagination: &query.PageRequest{Offset: 4, Limit: 4},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Accounts, 2)
+
+	res, err = f.querier.AccountsByFunder(f.Context(), &types.QueryAccountsByFunderRequest{
+		Funder: otherFunderAddr.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{testAddrs[6].String()}, addresses(res))
+}
+
+func TestFunderGranteeIndex(t *testing.T) {
+	f := initFixture(t)
+	f.bankKeeper.SetSendEnabled(f.Context(), bondDenom, true)
+	err := banktestutil.FundAccount(f.Context(), f.bankKeeper, funderAddr, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 500000)))
+	require.NoError(t, err)
+	newFunderAddr := testAddrs[9]
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))
+	endTime := f.Context().BlockTime().Unix() + 100
+	for i := 0; i < 3; i++ {
+		_, err := f.RunMsg(types.NewMsgCreateVestingAccount(funderAddr, testAddrs[i], amount, 0, endTime, false))
+		require.NoError(t, err)
+	}
+	_, err = f.RunMsg(types.NewMsgCreatePeriodicVestingAccount(funderAddr, testAddrs[3], 0, periods(1, 100, 1000), 0, false))
+	require.NoError(t, err)
+	_, err = f.RunMsg(types.NewMsgCreateVestingAccount(funderAddr, testAddrs[4], amount, 0, endTime, true))
+	require.NoError(t, err)
+
+	grantees := func(funder sdk.AccAddress) []string {
+		res, err := f.querier.AccountsByFunder(f.Context(), &types.QueryAccountsByFunderRequest{Funder: funder.String()})
+		require.NoError(t, err)
+		var addrs []string
+		for _, accAny := range res.Accounts {
+			var acc sdk.AccountI
+			require.NoError(t, f.cdc.UnpackAny(accAny, &acc))
+			addrs = append(addrs, acc.GetAddress().String())
+		}
+		return addrs
+	}
+	require.ElementsMatch(t, []string{
+		testAddrs[0].String(), testAddrs[1].String(), testAddrs[2].String(), testAddrs[3].String(),
+	}, grantees(funderAddr))
+
+	// a top-up does not duplicate the grantee
+	_, err = f.RunMsg(types.NewMsgCreateVestingAccount(funderAddr, testAddrs[0], amount, 0, endTime, false))
+	require.NoError(t, err)
+	require.Len(t, grantees(funderAddr), 4)
+
+	_, err = f.RunMsg(types.NewMsgUpdateVestingFunder(funderAddr, testAddrs[0], newFunderAddr, false))
+	require.NoError(t, err)
+	_, err = f.RunMsg(types.NewMsgUpdateVestingFunder(funderAddr, testAddrs[1], nil, true))
+	require.NoError(t, err)
+
+	expected := []string{testAddrs[2].String(), testAddrs[3].String()}
+	require.ElementsMatch(t, expected, grantees(funderAddr))
+	require.Equal(t, []string{testAddrs[0].String()}, grantees(newFunderAddr))
+
+	res, err := f.querier.AccountsByFunder(f.Context(), &types.QueryAccountsByFunderRequest{
+		Funder:     funderAddr.String(),
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Accounts, 1)
+	require.Equal(t, uint64(2), res.Pagination.Total)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	// the index rebuilt from the accounts, as in genesis, is the same
+	f.vestingKeeper.RemoveFunderGrantee(f.Context(), funderAddr, testAddrs[2])
+	f.vestingKeeper.SetFunderGrantee(f.Context(), funderAddr, testAddrs[1])
+	require.NoError(t, f.vestingKeeper.RebuildFunderGrantees(f.Context()))
+	require.ElementsMatch(t, expected, grantees(funderAddr))
+	require.Equal(t, []string{testAddrs[0].String()}, grantees(newFunderAddr))
+
+	_, err = f.querier.AccountsByFunder(f.Context(), &types.QueryAccountsByFunderRequest{Funder: "invalid"})
+	require.ErrorContains(t, err, "invalid funder address")
+}
@@ -0,0 +1,137 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	"github.com/sedaprotocol/seda-chain/x/vesting/types"
+)
+
+func TestCreateVestingAccountStartTime(t *testing.T) {
+	f := initFixture(t)
+	f.bankKeeper.SetSendEnabled(f.Context(), bondDenom, true)
+	err := banktestutil.FundAccount(f.Context(), f.bankKeeper, funderAddr, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 500000)))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		testName     string
+		recipient    sdk.AccAddress
+		startDelta   int64 // relative to block time, zero to use block time
+		endDelta     int64 // relative to block time
+		expVestedNow sdk.Coins
+		expErr       string
+	}{
+		{
+			testName:     "block time",
+			recipient:    testAddrs[0],
+			endDelta:     100,
+			expVestedNow: nil,
+		},
+		{
+			testName:     "backdated",
+			recipient:    testAddrs[1],
+			startDelta:   -100,
+			endDelta:     100,
+			expVestedNow: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 500)),
+		},
+		{
+			testName:     "future",
+			recipient:    testAddrs[2],
+			startDelta:   100,
+			endDelta:     200,
+			expVestedNow: nil,
+		},
+		{
+			testName:   "start after end",
+			recipient:  testAddrs[3],
+			startDelta: 200,
+			endDelta:   100,
+			expErr:     "must be before end time",
+		},
+		{
+			testName:   "start equal to end",
+			recipient:  testAddrs[3],
+			startDelta: 100,
+			endDelta:   100,
+			expErr:     "must be before end time",
+		},
+		{
+			testName:   "too far in the past",
+			recipient:  testAddrs[3],
+			startDelta: -types.MaxStartTimePastOffset - 1,
+			endDelta:   100,
+			expErr:     "in the past",
+		},
+		{
+			testName:   "too far in the future",
+			recipient:  testAddrs[3],
+			startDelta: types.MaxStartTimeFutureOffset + 1,
+			endDelta:   types.MaxStartTimeFutureOffset + 100,
+			expErr:     "in the future",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			blockTime := f.Context().BlockTime().Unix()
+			var startTime int64
+			if tc.startDelta != 0 {
+				startTime = blockTime + tc.startDelta
+			}
+
+			amount := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))
+			msg := types.NewMsgCreateVestingAccount(funderAddr, tc.recipient, amount, startTime, blockTime+tc.endDelta, false)
+			_, err := f.RunMsg(msg)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			acc, ok := f.accountKeeper.GetAccount(f.Context(), tc.recipient).(*types.ClawbackContinuousVestingAccount)
+			require.True(t, ok)
+			require.NoError(t, acc.Validate())
+			require.Equal(t, blockTime+tc.startDelta, acc.StartTime)
+			require.Equal(t, tc.expVestedNow, acc.GetVestedCoins(f.Context().BlockTime()))
+		})
+	}
+}
+
+func TestClawbackBeforeStart(t *testing.T) {
+	f := initFixture(t)
+	f.bankKeeper.SetSendEnabled(f.Context(), bondDenom, true)
+	err := banktestutil.FundAccount(f.Context(), f.bankKeeper, funderAddr, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 500000)))
+	require.NoError(t, err)
+
+	blockTime := f.Context().BlockTime().Unix()
+	amount := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 4000))
+	msgs := []sdk.Msg{
+		types.NewMsgCreateVestingAccount(funderAddr, testAddrs[0], amount, blockTime+1000, blockTime+2000, false),
+		types.NewMsgCreatePeriodicVestingAccount(funderAddr, testAddrs[1], blockTime+1000, periods(4, 100, 1000), 0, false),
+	}
+	for _, msg := range msgs {
+		_, err := f.RunMsg(msg)
+		require.NoError(t, err)
+	}
+
+	f.AddTime(10)
+
+	for _, recipient := range testAddrs[:2] {
+		res, err := f.RunMsg(types.NewMsgClawback(funderAddr, recipient))
+		require.NoError(t, err)
+
+		result := types.MsgClawbackResponse{}
+		err = f.cdc.Unmarshal(res.Value, &result)
+		require.NoError(t, err)
+		require.Equal(t, amount, result.ClawedUnbonded)
+		require.True(t, f.bankKeeper.GetAllBalances(f.Context(), recipient).IsZero())
+
+		acc := f.accountKeeper.GetAccount(f.Context(), recipient)
+		require.NoError(t, acc.(authtypes.GenesisAccount).Validate())
+		require.True(t, acc.(types.ClawbackVestingAccount).GetVestingCoins(f.Context().BlockTime()).IsZero())
+	}
+}
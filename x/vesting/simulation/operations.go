@@ -100,6 +100,7 @@ func SimulateMsgCreateVestingAccount(
 			funderAcc.GetAddress(),
 			recipient.Address,
 			sendCoins,
+			0,
 			ctx.BlockTime().Unix()+1000,
 			false,
 		)
@@ -160,7 +161,7 @@ func SimulateMsgClawback(
 
 		recipient, _ := simtypes.RandomAcc(r, accs)
 		recipientAcc := ak.GetAccount(ctx, recipient.Address)
-		vestingAcc, isClawback := recipientAcc.(*types.ClawbackContinuousVestingAccount)
+		vestingAcc, isClawback := recipientAcc.(types.ClawbackVestingAccount)
 		if !isClawback {
 			return simtypes.NoOpMsg(types.ModuleName, msgType, "not a vesting account"), nil, nil
 		}
@@ -168,7 +169,7 @@ func SimulateMsgClawback(
 			return simtypes.NoOpMsg(types.ModuleName, msgType, "vesting account not vesting anymore"), nil, nil
 		}
 
-		funder, found := simtypes.FindAccount(accs, sdk.MustAccAddressFromBech32(vestingAcc.FunderAddress))
+		funder, found := simtypes.FindAccount(accs, sdk.MustAccAddressFromBech32(vestingAcc.GetFunderAddress()))
 		if !found {
 			return simtypes.NoOpMsg(types.ModuleName, msgType, "failed to find funder account"), nil, nil
 		}
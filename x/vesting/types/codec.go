@@ -17,8 +17,11 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterInterface((*exported.VestingAccount)(nil), nil)
 	cdc.RegisterConcrete(&authvestingtypes.BaseVestingAccount{}, "cosmos-sdk/BaseVestingAccount", nil)
 	cdc.RegisterConcrete(&authvestingtypes.ContinuousVestingAccount{}, "cosmos-sdk/ContinuousVestingAccount", nil)
+	cdc.RegisterConcrete(&authvestingtypes.PeriodicVestingAccount{}, "cosmos-sdk/PeriodicVestingAccount", nil)
 	cdc.RegisterConcrete(&ClawbackContinuousVestingAccount{}, "sedachain/ClawbackContinuousVestingAccount", nil)
+	cdc.RegisterConcrete(&ClawbackPeriodicVestingAccount{}, "sedachain/ClawbackPeriodicVestingAccount", nil)
 	legacy.RegisterAminoMsg(cdc, &MsgCreateVestingAccount{}, "cosmos-sdk/MsgCreateVestingAccount")
+	legacy.RegisterAminoMsg(cdc, &MsgCreatePeriodicVestingAccount{}, "sedachain/MsgCreatePeriodVestAccount")
 }
 
 // RegisterInterface associates protoName with AccountI and VestingAccount
@@ -28,27 +31,35 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		"cosmos.vesting.v1beta1.VestingAccount",
 		(*exported.VestingAccount)(nil),
 		&authvestingtypes.ContinuousVestingAccount{},
+		&authvestingtypes.PeriodicVestingAccount{},
 		&ClawbackContinuousVestingAccount{},
+		&ClawbackPeriodicVestingAccount{},
 	)
 
 	registry.RegisterImplementations(
 		(*sdk.AccountI)(nil),
 		&authvestingtypes.BaseVestingAccount{},
 		&authvestingtypes.ContinuousVestingAccount{},
+		&authvestingtypes.PeriodicVestingAccount{},
 		&ClawbackContinuousVestingAccount{},
+		&ClawbackPeriodicVestingAccount{},
 	)
 
 	registry.RegisterImplementations(
 		(*authtypes.GenesisAccount)(nil),
 		&authvestingtypes.BaseVestingAccount{},
 		&authvestingtypes.ContinuousVestingAccount{},
+		&authvestingtypes.PeriodicVestingAccount{},
 		&ClawbackContinuousVestingAccount{},
+		&ClawbackPeriodicVestingAccount{},
 	)
 
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgCreateVestingAccount{},
+		&MsgCreatePeriodicVestingAccount{},
 		&MsgClawback{},
+		&MsgUpdateVestingFunder{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
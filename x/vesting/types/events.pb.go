@@ -0,0 +1,1355 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: sedachain/vesting/v1/events.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// The event for reassigning the funder of a clawback vesting account. The
+// new funder is empty if clawback has been disabled.
+type EventUpdateVestingFunder struct {
+	AccountAddress   string `protobuf:"bytes,1,opt,name=account_address,json=accountAddress,proto3" json:"account_address,omitempty"`
+	OldFunderAddress string `protobuf:"bytes,2,opt,name=old_funder_address,json=oldFunderAddress,proto3" json:"old_funder_address,omitempty"`
+	NewFunderAddress string `protobuf:"bytes,3,opt,name=new_funder_address,json=newFunderAddress,proto3" json:"new_funder_address,omitempty"`
+	ClawbackDisabled bool   `protobuf:"varint,4,opt,name=clawback_disabled,json=clawbackDisabled,proto3" json:"clawback_disabled,omitempty"`
+}
+
+func (m *EventUpdateVestingFunder) Reset()         { *m = EventUpdateVestingFunder{} }
+func (m *EventUpdateVestingFunder) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateVestingFunder) ProtoMessage()    {}
+func (*EventUpdateVestingFunder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46cbd82ca730d23d, []int{0}
+}
+func (m *EventUpdateVestingFunder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateVestingFunder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateVestingFunder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUpdateVestingFunder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateVestingFunder.Merge(m, src)
+}
+func (m *EventUpdateVestingFunder) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateVestingFunder) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateVestingFunder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUpdateVestingFunder proto.InternalMessageInfo
+
+func (m *EventUpdateVestingFunder) GetAccountAddress() string {
+	if m != nil {
+		return m.AccountAddress
+	}
+	return ""
+}
+
+func (m *EventUpdateVestingFunder) GetOldFunderAddress() string {
+	if m != nil {
+		return m.OldFunderAddress
+	}
+	return ""
+}
+
+func (m *EventUpdateVestingFunder) GetNewFunderAddress() string {
+	if m != nil {
+		return m.NewFunderAddress
+	}
+	return ""
+}
+
+func (m *EventUpdateVestingFunder) GetClawbackDisabled() bool {
+	if m != nil {
+		return m.ClawbackDisabled
+	}
+	return false
+}
+
+// The event for creating a vesting account or adding a grant to an existing
+// one. The amount is the amount of the grant, while the start and end times
+// are those of the resulting vesting schedule.
+type EventCreateVestingAccount struct {
+	Funder          string                                   `protobuf:"bytes,1,opt,name=funder,proto3" json:"funder,omitempty"`
+	Recipient       string                                   `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount          github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	Start           int64                                    `protobuf:"varint,4,opt,name=start,proto3" json:"start,omitempty"`
+	End             int64                                    `protobuf:"varint,5,opt,name=end,proto3" json:"end,omitempty"`
+	ClawbackEnabled bool                                     `protobuf:"varint,6,opt,name=clawback_enabled,json=clawbackEnabled,proto3" json:"clawback_enabled,omitempty"`
+}
+
+func (m *EventCreateVestingAccount) Reset()         { *m = EventCreateVestingAccount{} }
+func (m *EventCreateVestingAccount) String() string { return proto.CompactTextString(m) }
+func (*EventCreateVestingAccount) ProtoMessage()    {}
+func (*EventCreateVestingAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46cbd82ca730d23d, []int{1}
+}
+func (m *EventCreateVestingAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCreateVestingAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCreateVestingAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCreateVestingAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCreateVestingAccount.Merge(m, src)
+}
+func (m *EventCreateVestingAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCreateVestingAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCreateVestingAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCreateVestingAccount proto.InternalMessageInfo
+
+func (m *EventCreateVestingAccount) GetFunder() string {
+	if m != nil {
+		return m.Funder
+	}
+	return ""
+}
+
+func (m *EventCreateVestingAccount) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *EventCreateVestingAccount) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *EventCreateVestingAccount) GetStart() int64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *EventCreateVestingAccount) GetEnd() int64 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+func (m *EventCreateVestingAccount) GetClawbackEnabled() bool {
+	if m != nil {
+		return m.ClawbackEnabled
+	}
+	return false
+}
+
+// The event for clawing back the vesting coins of a clawback vesting
+// account. The funder is the authority in case of a clawback to the
+// community pool.
+type EventClawback struct {
+	Funder          string                                   `protobuf:"bytes,1,opt,name=funder,proto3" json:"funder,omitempty"`
+	Account         string                                   `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	ClawedUnbonded  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=clawed_unbonded,json=clawedUnbonded,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"clawed_unbonded"`
+	ClawedUnbonding github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=clawed_unbonding,json=clawedUnbonding,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"clawed_unbonding"`
+	ClawedBonded    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=clawed_bonded,json=clawedBonded,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"clawed_bonded"`
+	// shortfall is the amount that could not be clawed back.
+	Shortfall github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=shortfall,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"shortfall"`
+}
+
+func (m *EventClawback) Reset()         { *m = EventClawback{} }
+func (m *EventClawback) String() string { return proto.CompactTextString(m) }
+func (*EventClawback) ProtoMessage()    {}
+func (*EventClawback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46cbd82ca730d23d, []int{2}
+}
+func (m *EventClawback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventClawback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventClawback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventClawback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventClawback.Merge(m, src)
+}
+func (m *EventClawback) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventClawback) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventClawback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventClawback proto.InternalMessageInfo
+
+func (m *EventClawback) GetFunder() string {
+	if m != nil {
+		return m.Funder
+	}
+	return ""
+}
+
+func (m *EventClawback) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *EventClawback) GetClawedUnbonded() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ClawedUnbonded
+	}
+	return nil
+}
+
+func (m *EventClawback) GetClawedUnbonding() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ClawedUnbonding
+	}
+	return nil
+}
+
+func (m *EventClawback) GetClawedBonded() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ClawedBonded
+	}
+	return nil
+}
+
+func (m *EventClawback) GetShortfall() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Shortfall
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*EventUpdateVestingFunder)(nil), "sedachain.vesting.v1.EventUpdateVestingFunder")
+	proto.RegisterType((*EventCreateVestingAccount)(nil), "sedachain.vesting.v1.EventCreateVestingAccount")
+	proto.RegisterType((*EventClawback)(nil), "sedachain.vesting.v1.EventClawback")
+}
+
+func init() { proto.RegisterFile("sedachain/vesting/v1/events.proto", fileDescriptor_46cbd82ca730d23d) }
+
+var fileDescriptor_46cbd82ca730d23d = []byte{
+	// 517 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x18, 0x8c, 0x9b, 0x1f, 0xc8, 0x42, 0x9b, 0xb0, 0x8a, 0x90, 0x5b, 0x21, 0x37, 0xe4, 0x42, 0x10,
+	0xd4, 0x26, 0xe5, 0x09, 0x9a, 0x52, 0x4e, 0x9c, 0x22, 0x95, 0x03, 0x97, 0x68, 0xbd, 0xfb, 0xd5,
+	0x59, 0xd5, 0xd9, 0xb5, 0xbc, 0x1b, 0x07, 0x1e, 0x01, 0x89, 0x03, 0xcf, 0xc1, 0x93, 0xf4, 0xc0,
+	0xa1, 0x47, 0x4e, 0x80, 0x92, 0x17, 0x41, 0xde, 0xdd, 0xfc, 0x14, 0x89, 0x5b, 0x38, 0xd9, 0xdf,
+	0xec, 0xec, 0x8c, 0x67, 0x2c, 0x7d, 0xe8, 0xa9, 0x02, 0x46, 0xe8, 0x84, 0x70, 0x11, 0x15, 0xa0,
+	0x34, 0x17, 0x49, 0x54, 0x0c, 0x22, 0x28, 0x40, 0x68, 0x15, 0x66, 0xb9, 0xd4, 0x12, 0x77, 0xd6,
+	0x94, 0xd0, 0x51, 0xc2, 0x62, 0x70, 0xd4, 0x49, 0x64, 0x22, 0x0d, 0x21, 0x2a, 0xdf, 0x2c, 0xf7,
+	0x28, 0xa0, 0x52, 0x4d, 0xa5, 0x8a, 0x62, 0xa2, 0x20, 0x2a, 0x06, 0x31, 0x68, 0x32, 0x88, 0xa8,
+	0xe4, 0xc2, 0x9e, 0xf7, 0xbe, 0x7b, 0xc8, 0xbf, 0x28, 0xc5, 0x2f, 0x33, 0x46, 0x34, 0xbc, 0xb7,
+	0x7a, 0x6f, 0x67, 0x82, 0x41, 0x8e, 0x9f, 0xa1, 0x16, 0xa1, 0x54, 0xce, 0x84, 0x1e, 0x13, 0xc6,
+	0x72, 0x50, 0xca, 0xf7, 0xba, 0x5e, 0xbf, 0x39, 0x3a, 0x70, 0xf0, 0x99, 0x45, 0xf1, 0x4b, 0x84,
+	0x65, 0xca, 0xc6, 0x57, 0xe6, 0xda, 0x9a, 0xbb, 0x67, 0xb8, 0x6d, 0x99, 0x32, 0xab, 0xb7, 0xc5,
+	0x16, 0x30, 0xff, 0x9b, 0x5d, 0xb5, 0x6c, 0x01, 0xf3, 0xbb, 0xec, 0x17, 0xe8, 0x11, 0x4d, 0xc9,
+	0x3c, 0x26, 0xf4, 0x7a, 0xcc, 0xb8, 0x22, 0x71, 0x0a, 0xcc, 0xaf, 0x75, 0xbd, 0xfe, 0xfd, 0x51,
+	0x7b, 0x75, 0xf0, 0xc6, 0xe1, 0xbd, 0xcf, 0x7b, 0xe8, 0xd0, 0xc4, 0x39, 0xcf, 0x61, 0x13, 0xe7,
+	0xcc, 0x7e, 0x2d, 0x7e, 0x8c, 0x1a, 0xd6, 0xd4, 0xc5, 0x70, 0x13, 0x7e, 0x82, 0x9a, 0x39, 0x50,
+	0x9e, 0x71, 0x10, 0xda, 0x7d, 0xf5, 0x06, 0xc0, 0x14, 0x35, 0xc8, 0xb4, 0xbc, 0xef, 0x57, 0xbb,
+	0xd5, 0xfe, 0x83, 0xd3, 0xc3, 0xd0, 0x76, 0x1a, 0x96, 0x9d, 0x86, 0xae, 0xd3, 0xf0, 0x5c, 0x72,
+	0x31, 0x7c, 0x75, 0xf3, 0xf3, 0xb8, 0xf2, 0xed, 0xd7, 0x71, 0x3f, 0xe1, 0x7a, 0x32, 0x8b, 0x43,
+	0x2a, 0xa7, 0x91, 0xfb, 0x01, 0xf6, 0x71, 0xa2, 0xd8, 0x75, 0xa4, 0x3f, 0x65, 0xa0, 0xcc, 0x05,
+	0x35, 0x72, 0xd2, 0xb8, 0x83, 0xea, 0x4a, 0x93, 0x5c, 0x9b, 0x64, 0xd5, 0x91, 0x1d, 0x70, 0x1b,
+	0x55, 0x41, 0x30, 0xbf, 0x6e, 0xb0, 0xf2, 0x15, 0x3f, 0x47, 0xeb, 0xd0, 0x63, 0x10, 0xb6, 0x8c,
+	0x86, 0x29, 0xa3, 0xb5, 0xc2, 0x2f, 0x2c, 0xdc, 0xfb, 0x52, 0x43, 0xfb, 0xb6, 0x0b, 0x77, 0xf0,
+	0xcf, 0xfc, 0x3e, 0xba, 0xe7, 0x7e, 0xa8, 0x4b, 0xbf, 0x1a, 0xb1, 0x46, 0x46, 0x16, 0xd8, 0x78,
+	0x26, 0x62, 0x29, 0x18, 0xb0, 0xff, 0x51, 0xc2, 0x81, 0xf5, 0xb8, 0x74, 0x16, 0xb8, 0x40, 0xed,
+	0x3b, 0xae, 0x5c, 0x24, 0x7e, 0x6d, 0xf7, 0xb6, 0xad, 0x6d, 0x5b, 0x2e, 0x12, 0x9c, 0xa1, 0x7d,
+	0xe7, 0xeb, 0xb2, 0xd6, 0x77, 0x6f, 0xfa, 0xd0, 0x3a, 0x0c, 0x6d, 0x52, 0x8e, 0x9a, 0x6a, 0x22,
+	0x73, 0x7d, 0x45, 0xd2, 0xd4, 0x6f, 0xec, 0xde, 0x6d, 0xa3, 0x3e, 0x7c, 0x77, 0xb3, 0x08, 0xbc,
+	0xdb, 0x45, 0xe0, 0xfd, 0x5e, 0x04, 0xde, 0xd7, 0x65, 0x50, 0xb9, 0x5d, 0x06, 0x95, 0x1f, 0xcb,
+	0xa0, 0xf2, 0xe1, 0x74, 0x4b, 0xae, 0x5c, 0x2d, 0x66, 0x33, 0x50, 0x99, 0x9a, 0xe1, 0xc4, 0xee,
+	0xa2, 0x8f, 0xeb, 0x6d, 0x64, 0xe4, 0xe3, 0x86, 0x21, 0xbd, 0xfe, 0x33, 0x00, 0x1d, 0x10, 0x11,
+	0xb5, 0xaf, 0x04, 0x00, 0x00,
+}
+
+func (m *EventUpdateVestingFunder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateVestingFunder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateVestingFunder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClawbackDisabled {
+		i--
+		if m.ClawbackDisabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NewFunderAddress) > 0 {
+		i -= len(m.NewFunderAddress)
+		copy(dAtA[i:], m.NewFunderAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewFunderAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OldFunderAddress) > 0 {
+		i -= len(m.OldFunderAddress)
+		copy(dAtA[i:], m.OldFunderAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OldFunderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AccountAddress) > 0 {
+		i -= len(m.AccountAddress)
+		copy(dAtA[i:], m.AccountAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.AccountAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCreateVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCreateVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCreateVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClawbackEnabled {
+		i--
+		if m.ClawbackEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.End != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.End))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Start != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventClawback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventClawback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventClawback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shortfall) > 0 {
+		for iNdEx := len(m.Shortfall) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shortfall[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ClawedBonded) > 0 {
+		for iNdEx := len(m.ClawedBonded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClawedBonded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ClawedUnbonding) > 0 {
+		for iNdEx := len(m.ClawedUnbonding) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClawedUnbonding[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ClawedUnbonded) > 0 {
+		for iNdEx := len(m.ClawedUnbonded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClawedUnbonded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventUpdateVestingFunder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AccountAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.OldFunderAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewFunderAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ClawbackDisabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventCreateVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.Start != 0 {
+		n += 1 + sovEvents(uint64(m.Start))
+	}
+	if m.End != 0 {
+		n += 1 + sovEvents(uint64(m.End))
+	}
+	if m.ClawbackEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventClawback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.ClawedUnbonded) > 0 {
+		for _, e := range m.ClawedUnbonded {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.ClawedUnbonding) > 0 {
+		for _, e := range m.ClawedUnbonding {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.ClawedBonded) > 0 {
+		for _, e := range m.ClawedBonded {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.Shortfall) > 0 {
+		for _, e := range m.Shortfall {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventUpdateVestingFunder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateVestingFunder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateVestingFunder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldFunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldFunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewFunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawbackDisabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClawbackDisabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCreateVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreateVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreateVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			m.End = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.End |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawbackEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClawbackEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventClawback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventClawback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventClawback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawedUnbonded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClawedUnbonded = append(m.ClawedUnbonded, types.Coin{})
+			if err := m.ClawedUnbonded[len(m.ClawedUnbonded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawedUnbonding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClawedUnbonding = append(m.ClawedUnbonding, types.Coin{})
+			if err := m.ClawedUnbonding[len(m.ClawedUnbonding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawedBonded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClawedBonded = append(m.ClawedBonded, types.Coin{})
+			if err := m.ClawedBonded[len(m.ClawedBonded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shortfall", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shortfall = append(m.Shortfall, types.Coin{})
+			if err := m.Shortfall[len(m.Shortfall)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// DefaultGenesisState creates a default GenesisState object.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// ValidateGenesis validates vesting genesis data. The clawback vesting
+// accounts are part of the auth genesis and are validated by
+// ValidateGenesisAccounts instead.
+func ValidateGenesis(_ GenesisState) error {
+	return nil
+}
+
+// ValidateClawbackAccount checks that a given clawback vesting account is
+// valid and that its balance covers the coins that are still locked at a
+// given time, that is its vesting coins less its delegated vesting coins.
+// The original vesting coins are not checked, since they are left as is
+// when vested coins are spent, when the account is clawed back, and when
+// its delegations are slashed.
+func ValidateClawbackAccount(acc ClawbackVestingAccount, balance sdk.Coins, blockTime time.Time) error {
+	if genesisAccount, ok := acc.(authtypes.GenesisAccount); ok {
+		if err := genesisAccount.Validate(); err != nil {
+			return fmt.Errorf("invalid clawback vesting account %s: %w", acc.GetAddress(), err)
+		}
+	}
+	if locked := acc.LockedCoins(blockTime); !locked.IsAllLTE(balance) {
+		return fmt.Errorf(
+			"balance %s of clawback vesting account %s does not cover its locked coins %s",
+			balance, acc.GetAddress(), locked,
+		)
+	}
+	return nil
+}
+
+// ValidateGenesisAccounts validates the clawback vesting accounts of the
+// auth genesis in a given app genesis state against their balances in the
+// bank genesis at a given genesis time.
+func ValidateGenesisAccounts(cdc codec.JSONCodec, appState map[string]json.RawMessage, genesisTime time.Time) error {
+	var authGenesis authtypes.GenesisState
+	if err := cdc.UnmarshalJSON(appState[authtypes.ModuleName], &authGenesis); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", authtypes.ModuleName, err)
+	}
+	accounts, err := authtypes.UnpackAccounts(authGenesis.Accounts)
+	if err != nil {
+		return err
+	}
+
+	var bankGenesis banktypes.GenesisState
+	if err := cdc.UnmarshalJSON(appState[banktypes.ModuleName], &bankGenesis); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", banktypes.ModuleName, err)
+	}
+	balances := make(map[string]sdk.Coins)
+	for _, balance := range bankGenesis.Balances {
+		balances[balance.Address] = balances[balance.Address].Add(balance.Coins...)
+	}
+
+	for _, acc := range accounts {
+		vestingAccount, ok := acc.(ClawbackVestingAccount)
+		if !ok {
+			continue
+		}
+		addr := vestingAccount.GetAddress().String()
+		if err := ValidateClawbackAccount(vestingAccount, balances[addr], genesisTime); err != nil {
+			return err
+		}
+	}
+	return nil
+}
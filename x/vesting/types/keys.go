@@ -0,0 +1,21 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// KeyPrefixFunderGrantee defines prefix to store the index of grantees,
+// which are clawback vesting accounts, by their funder.
+var KeyPrefixFunderGrantee = []byte{0x00}
+
+// GetFunderGranteesPrefix gets the prefix for the grantees of a given
+// funder.
+func GetFunderGranteesPrefix(funder sdk.AccAddress) []byte {
+	return append(KeyPrefixFunderGrantee, address.MustLengthPrefix(funder)...)
+}
+
+// GetFunderGranteeKey gets the key for a grantee of a given funder.
+func GetFunderGranteeKey(funder, grantee sdk.AccAddress) []byte {
+	return append(GetFunderGranteesPrefix(funder), grantee...)
+}
@@ -2,27 +2,57 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 )
 
 var (
 	_ sdk.Msg = &MsgCreateVestingAccount{}
+	_ sdk.Msg = &MsgCreatePeriodicVestingAccount{}
 	_ sdk.Msg = &MsgClawback{}
+	_ sdk.Msg = &MsgUpdateVestingFunder{}
 )
 
 // NewMsgCreateVestingAccount returns a reference to a new MsgCreateVestingAccount.
-func NewMsgCreateVestingAccount(fromAddr, toAddr sdk.AccAddress, amount sdk.Coins, endTime int64, disableClawback bool) *MsgCreateVestingAccount {
+func NewMsgCreateVestingAccount(fromAddr, toAddr sdk.AccAddress, amount sdk.Coins, startTime, endTime int64, disableClawback bool) *MsgCreateVestingAccount {
 	return &MsgCreateVestingAccount{
 		FromAddress:     fromAddr.String(),
 		ToAddress:       toAddr.String(),
 		Amount:          amount,
+		StartTime:       startTime,
 		EndTime:         endTime,
 		DisableClawback: disableClawback,
 	}
 }
 
+// NewMsgCreatePeriodicVestingAccount returns a reference to a new MsgCreatePeriodicVestingAccount.
+func NewMsgCreatePeriodicVestingAccount(fromAddr, toAddr sdk.AccAddress, startTime int64, periods vestingtypes.Periods, cliffTime int64, disableClawback bool) *MsgCreatePeriodicVestingAccount {
+	return &MsgCreatePeriodicVestingAccount{
+		FromAddress:     fromAddr.String(),
+		ToAddress:       toAddr.String(),
+		StartTime:       startTime,
+		VestingPeriods:  periods,
+		CliffTime:       cliffTime,
+		DisableClawback: disableClawback,
+	}
+}
+
 func NewMsgClawback(funder, vestingAccount sdk.AccAddress) *MsgClawback {
 	return &MsgClawback{
 		FunderAddress:  funder.String(),
 		AccountAddress: vestingAccount.String(),
 	}
 }
+
+// NewMsgUpdateVestingFunder returns a reference to a new MsgUpdateVestingFunder.
+// If disableClawback is set, newFunder is ignored.
+func NewMsgUpdateVestingFunder(funder, vestingAccount, newFunder sdk.AccAddress, disableClawback bool) *MsgUpdateVestingFunder {
+	msg := &MsgUpdateVestingFunder{
+		FunderAddress:   funder.String(),
+		AccountAddress:  vestingAccount.String(),
+		DisableClawback: disableClawback,
+	}
+	if !disableClawback {
+		msg.NewFunderAddress = newFunder.String()
+	}
+	return msg
+}
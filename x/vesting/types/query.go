@@ -0,0 +1,28 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+)
+
+var (
+	_ codectypes.UnpackInterfacesMessage = QueryVestingAccountResponse{}
+	_ codectypes.UnpackInterfacesMessage = QueryAccountsByFunderResponse{}
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r QueryVestingAccountResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var account exported.VestingAccount
+	return unpacker.UnpackAny(r.Account, &account)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r QueryAccountsByFunderResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, accAny := range r.Accounts {
+		var account exported.VestingAccount
+		if err := unpacker.UnpackAny(accAny, &account); err != nil {
+			return err
+		}
+	}
+	return nil
+}
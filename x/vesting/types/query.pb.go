@@ -0,0 +1,1845 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: sedachain/vesting/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// The request message for QueryVestingAccount RPC.
+type QueryVestingAccountRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryVestingAccountRequest) Reset()         { *m = QueryVestingAccountRequest{} }
+func (m *QueryVestingAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingAccountRequest) ProtoMessage()    {}
+func (*QueryVestingAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de47ac8c9a08b76c, []int{0}
+}
+func (m *QueryVestingAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVestingAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingAccountRequest.Merge(m, src)
+}
+func (m *QueryVestingAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVestingAccountRequest proto.InternalMessageInfo
+
+func (m *QueryVestingAccountRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// The response message for QueryVestingAccount RPC.
+type QueryVestingAccountResponse struct {
+	Account *types.Any `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (m *QueryVestingAccountResponse) Reset()         { *m = QueryVestingAccountResponse{} }
+func (m *QueryVestingAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingAccountResponse) ProtoMessage()    {}
+func (*QueryVestingAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de47ac8c9a08b76c, []int{1}
+}
+func (m *QueryVestingAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVestingAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingAccountResponse.Merge(m, src)
+}
+func (m *QueryVestingAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVestingAccountResponse proto.InternalMessageInfo
+
+func (m *QueryVestingAccountResponse) GetAccount() *types.Any {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+// The request message for QueryBalances RPC.
+type QueryBalancesRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryBalancesRequest) Reset()         { *m = QueryBalancesRequest{} }
+func (m *QueryBalancesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBalancesRequest) ProtoMessage()    {}
+func (*QueryBalancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de47ac8c9a08b76c, []int{2}
+}
+func (m *QueryBalancesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBalancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBalancesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBalancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBalancesRequest.Merge(m, src)
+}
+func (m *QueryBalancesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBalancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBalancesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBalancesRequest proto.InternalMessageInfo
+
+func (m *QueryBalancesRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// The response message for QueryBalances RPC.
+type QueryBalancesResponse struct {
+	// vested is the amount of original vesting coins that have vested.
+	Vested github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=vested,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"vested"`
+	// unvested is the amount of original vesting coins that are still
+	// vesting.
+	Unvested github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=unvested,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unvested"`
+	// locked is the amount of unvested coins that are not delegated.
+	Locked github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=locked,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"locked"`
+	// spendable is the amount of coins in the account balance that can be
+	// transferred.
+	Spendable        github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=spendable,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spendable"`
+	DelegatedVesting github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=delegated_vesting,json=delegatedVesting,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"delegated_vesting"`
+	DelegatedFree    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=delegated_free,json=delegatedFree,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"delegated_free"`
+}
+
+func (m *QueryBalancesResponse) Reset()         { *m = QueryBalancesResponse{} }
+func (m *QueryBalancesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBalancesResponse) ProtoMessage()    {}
+func (*QueryBalancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de47ac8c9a08b76c, []int{3}
+}
+func (m *QueryBalancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBalancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBalancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBalancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBalancesResponse.Merge(m, src)
+}
+func (m *QueryBalancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBalancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBalancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBalancesResponse proto.InternalMessageInfo
+
+func (m *QueryBalancesResponse) GetVested() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Vested
+	}
+	return nil
+}
+
+func (m *QueryBalancesResponse) GetUnvested() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Unvested
+	}
+	return nil
+}
+
+func (m *QueryBalancesResponse) GetLocked() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Locked
+	}
+	return nil
+}
+
+func (m *QueryBalancesResponse) GetSpendable() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spendable
+	}
+	return nil
+}
+
+func (m *QueryBalancesResponse) GetDelegatedVesting() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DelegatedVesting
+	}
+	return nil
+}
+
+func (m *QueryBalancesResponse) GetDelegatedFree() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DelegatedFree
+	}
+	return nil
+}
+
+// The request message for QueryAccountsByFunder RPC.
+type QueryAccountsByFunderRequest struct {
+	Funder     string             `protobuf:"bytes,1,opt,name=funder,proto3" json:"funder,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAccountsByFunderRequest) Reset()         { *m = QueryAccountsByFunderRequest{} }
+func (m *QueryAccountsByFunderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountsByFunderRequest) ProtoMessage()    {}
+func (*QueryAccountsByFunderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de47ac8c9a08b76c, []int{4}
+}
+func (m *QueryAccountsByFunderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountsByFunderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountsByFunderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountsByFunderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountsByFunderRequest.Merge(m, src)
+}
+func (m *QueryAccountsByFunderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountsByFunderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountsByFunderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountsByFunderRequest proto.InternalMessageInfo
+
+func (m *QueryAccountsByFunderRequest) GetFunder() string {
+	if m != nil {
+		return m.Funder
+	}
+	return ""
+}
+
+func (m *QueryAccountsByFunderRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// The response message for QueryAccountsByFunder RPC.
+type QueryAccountsByFunderResponse struct {
+	Accounts   []*types.Any        `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAccountsByFunderResponse) Reset()         { *m = QueryAccountsByFunderResponse{} }
+func (m *QueryAccountsByFunderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountsByFunderResponse) ProtoMessage()    {}
+func (*QueryAccountsByFunderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de47ac8c9a08b76c, []int{5}
+}
+func (m *QueryAccountsByFunderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountsByFunderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountsByFunderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountsByFunderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountsByFunderResponse.Merge(m, src)
+}
+func (m *QueryAccountsByFunderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountsByFunderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountsByFunderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountsByFunderResponse proto.InternalMessageInfo
+
+func (m *QueryAccountsByFunderResponse) GetAccounts() []*types.Any {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *QueryAccountsByFunderResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryVestingAccountRequest)(nil), "sedachain.vesting.v1.QueryVestingAccountRequest")
+	proto.RegisterType((*QueryVestingAccountResponse)(nil), "sedachain.vesting.v1.QueryVestingAccountResponse")
+	proto.RegisterType((*QueryBalancesRequest)(nil), "sedachain.vesting.v1.QueryBalancesRequest")
+	proto.RegisterType((*QueryBalancesResponse)(nil), "sedachain.vesting.v1.QueryBalancesResponse")
+	proto.RegisterType((*QueryAccountsByFunderRequest)(nil), "sedachain.vesting.v1.QueryAccountsByFunderRequest")
+	proto.RegisterType((*QueryAccountsByFunderResponse)(nil), "sedachain.vesting.v1.QueryAccountsByFunderResponse")
+}
+
+func init() { proto.RegisterFile("sedachain/vesting/v1/query.proto", fileDescriptor_de47ac8c9a08b76c) }
+
+var fileDescriptor_de47ac8c9a08b76c = []byte{
+	// 690 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4f, 0x4f, 0x13, 0x41,
+	0x14, 0xef, 0x82, 0x14, 0x18, 0x22, 0xc1, 0x49, 0x35, 0x4b, 0xc5, 0x85, 0x34, 0x11, 0x2b, 0xa6,
+	0x3b, 0xb4, 0x44, 0xef, 0xd4, 0x04, 0x2f, 0x1e, 0xb4, 0x07, 0x13, 0xf5, 0x40, 0x66, 0x77, 0x1f,
+	0xcb, 0x86, 0x32, 0x53, 0x76, 0x76, 0x1b, 0x1a, 0x82, 0x07, 0x3f, 0x81, 0x09, 0x1f, 0xc1, 0x78,
+	0xf1, 0x68, 0xfc, 0x0a, 0x26, 0xc4, 0x13, 0x89, 0x17, 0x4f, 0x6a, 0xc0, 0x4f, 0xe1, 0xc9, 0xec,
+	0xcc, 0xec, 0xb6, 0x34, 0xe5, 0x5f, 0xac, 0xa7, 0xee, 0xec, 0x7b, 0xef, 0xf7, 0x67, 0xfa, 0xde,
+	0x5b, 0xb4, 0x20, 0xc0, 0xa3, 0xee, 0x26, 0x0d, 0x18, 0x69, 0x83, 0x88, 0x02, 0xe6, 0x93, 0x76,
+	0x95, 0xec, 0xc4, 0x10, 0x76, 0xec, 0x56, 0xc8, 0x23, 0x8e, 0x0b, 0x59, 0x86, 0xad, 0x33, 0xec,
+	0x76, 0xb5, 0x58, 0xf0, 0xb9, 0xcf, 0x65, 0x02, 0x49, 0x9e, 0x54, 0x6e, 0x71, 0xce, 0xe7, 0xdc,
+	0x6f, 0x02, 0xa1, 0xad, 0x80, 0x50, 0xc6, 0x78, 0x44, 0xa3, 0x80, 0x33, 0xa1, 0xa3, 0xb3, 0x3a,
+	0x2a, 0x4f, 0x4e, 0xbc, 0x41, 0x28, 0xeb, 0xa4, 0x21, 0x97, 0x8b, 0x6d, 0x2e, 0xd6, 0x15, 0xa2,
+	0x3a, 0xe8, 0x90, 0xa5, 0x4e, 0xc4, 0xa1, 0x02, 0x48, 0xbb, 0xea, 0x40, 0x44, 0xab, 0xc4, 0xe5,
+	0x01, 0xd3, 0xf1, 0xa5, 0xde, 0xb8, 0x14, 0x9e, 0x65, 0xb5, 0xa8, 0x1f, 0x30, 0x29, 0x41, 0xe5,
+	0x96, 0x1e, 0xa1, 0xe2, 0xf3, 0x24, 0xe3, 0x85, 0x32, 0xb2, 0xea, 0xba, 0x3c, 0x66, 0x51, 0x03,
+	0x76, 0x62, 0x10, 0x11, 0x36, 0xd1, 0x38, 0xf5, 0xbc, 0x10, 0x84, 0x30, 0x8d, 0x05, 0xa3, 0x3c,
+	0xd9, 0x48, 0x8f, 0xa5, 0x5d, 0x74, 0x7b, 0x60, 0x9d, 0x68, 0x71, 0x26, 0x00, 0xbf, 0x44, 0xe3,
+	0x54, 0xbd, 0x92, 0x85, 0x53, 0xb5, 0x82, 0xad, 0xac, 0xda, 0xa9, 0x55, 0x7b, 0x95, 0x75, 0xea,
+	0xf7, 0xbf, 0x7e, 0xae, 0xdc, 0xd5, 0xde, 0xba, 0x57, 0x29, 0xa5, 0xda, 0x7d, 0xc8, 0x29, 0x5e,
+	0x69, 0x19, 0x15, 0x24, 0x73, 0x9d, 0x36, 0x29, 0x73, 0x41, 0x5c, 0xac, 0xf5, 0xfd, 0x18, 0xba,
+	0xd9, 0x57, 0xa2, 0x65, 0xba, 0x28, 0x9f, 0xd0, 0x82, 0x67, 0x1a, 0x0b, 0xa3, 0xe5, 0xa9, 0xda,
+	0xac, 0xad, 0xc5, 0x24, 0x57, 0x97, 0x29, 0x79, 0xcc, 0x03, 0x56, 0x5f, 0x3e, 0xfc, 0x31, 0x9f,
+	0xfb, 0xf8, 0x73, 0xbe, 0xec, 0x07, 0xd1, 0x66, 0xec, 0xd8, 0x2e, 0xdf, 0xd6, 0xff, 0x8a, 0xfe,
+	0xa9, 0x08, 0x6f, 0x8b, 0x44, 0x9d, 0x16, 0x08, 0x59, 0x20, 0x1a, 0x1a, 0x1a, 0xfb, 0x68, 0x22,
+	0x66, 0x9a, 0x66, 0x64, 0xf8, 0x34, 0x19, 0x78, 0xe2, 0xa6, 0xc9, 0xdd, 0x2d, 0xf0, 0xcc, 0xd1,
+	0xff, 0xe0, 0x46, 0x41, 0xe3, 0x00, 0x4d, 0x8a, 0x16, 0x30, 0x8f, 0x3a, 0x4d, 0x30, 0xaf, 0x0d,
+	0x9f, 0xa7, 0x8b, 0x8e, 0x77, 0xd1, 0x0d, 0x0f, 0x9a, 0xe0, 0xd3, 0x08, 0xbc, 0x75, 0xdd, 0x1e,
+	0xe6, 0xd8, 0xf0, 0x29, 0x67, 0x32, 0x16, 0xdd, 0x73, 0x38, 0x44, 0xd3, 0x5d, 0xe6, 0x8d, 0x10,
+	0xc0, 0xcc, 0x0f, 0x9f, 0xf6, 0x7a, 0x46, 0xb1, 0x16, 0x02, 0x94, 0xde, 0xa0, 0x39, 0xd9, 0xa4,
+	0xba, 0xe1, 0x45, 0xbd, 0xb3, 0x16, 0x33, 0x0f, 0xc2, 0xb4, 0xbf, 0x6f, 0xa1, 0xfc, 0x86, 0x7c,
+	0xa1, 0xdb, 0x5b, 0x9f, 0xf0, 0x1a, 0x42, 0xdd, 0xa9, 0x36, 0x47, 0xe4, 0xb4, 0x2d, 0x9e, 0xd2,
+	0xa9, 0x76, 0x57, 0xaa, 0xf6, 0x19, 0xf5, 0x41, 0x63, 0x36, 0x7a, 0x2a, 0x4b, 0x5f, 0x0c, 0x74,
+	0xe7, 0x0c, 0x01, 0x7a, 0x5a, 0x5e, 0xa3, 0x09, 0x3d, 0x84, 0x42, 0xcf, 0xcb, 0x3f, 0x4f, 0x75,
+	0x06, 0x88, 0x9f, 0x0c, 0xb0, 0x71, 0xef, 0x42, 0x1b, 0x4a, 0x59, 0xaf, 0x8f, 0xda, 0x9f, 0x51,
+	0x34, 0x26, 0x7d, 0xe0, 0x0f, 0x06, 0x9a, 0x3e, 0xcd, 0x87, 0x97, 0xed, 0x41, 0xbb, 0xdb, 0x3e,
+	0x7b, 0x05, 0x16, 0xab, 0x57, 0xa8, 0x50, 0x6a, 0x4a, 0xf6, 0xdb, 0x6f, 0xbf, 0x0f, 0x46, 0xca,
+	0x78, 0x91, 0x24, 0xa5, 0x95, 0xd3, 0xdf, 0x92, 0xd4, 0x30, 0xd9, 0xd3, 0xeb, 0x69, 0x1f, 0x1f,
+	0x18, 0x68, 0x22, 0x5d, 0x4d, 0x78, 0xe9, 0x1c, 0xbe, 0xbe, 0x95, 0x57, 0x7c, 0x70, 0xa9, 0xdc,
+	0xcb, 0xa8, 0x72, 0x74, 0x76, 0x8f, 0xaa, 0x4f, 0x06, 0x9a, 0xe9, 0x6f, 0x05, 0x5c, 0x3b, 0x87,
+	0xf1, 0x8c, 0xc6, 0x2d, 0xae, 0x5c, 0xa9, 0x46, 0xab, 0x7d, 0x28, 0xd5, 0x12, 0x5c, 0x19, 0xa4,
+	0x56, 0x75, 0xbe, 0x20, 0x7b, 0xea, 0x61, 0x3f, 0xbb, 0xd4, 0xfa, 0xd3, 0xc3, 0x63, 0xcb, 0x38,
+	0x3a, 0xb6, 0x8c, 0x5f, 0xc7, 0x96, 0xf1, 0xee, 0xc4, 0xca, 0x1d, 0x9d, 0x58, 0xb9, 0xef, 0x27,
+	0x56, 0xee, 0x55, 0xad, 0x67, 0x2e, 0x13, 0x48, 0xd9, 0xb1, 0x2e, 0x6f, 0xf6, 0xe2, 0xef, 0x66,
+	0x0c, 0x72, 0x4e, 0x9d, 0xbc, 0x4c, 0x5a, 0xf9, 0x3b, 0x00, 0xb2, 0x95, 0x2f, 0xcf, 0x13, 0x08,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// VestingAccount returns the vesting account given its address.
+	VestingAccount(ctx context.Context, in *QueryVestingAccountRequest, opts ...grpc.CallOption) (*QueryVestingAccountResponse, error)
+	// Balances returns the vested, unvested, locked, spendable, and delegated
+	// balances of a vesting account at the current block time.
+	Balances(ctx context.Context, in *QueryBalancesRequest, opts ...grpc.CallOption) (*QueryBalancesResponse, error)
+	// AccountsByFunder returns the clawback vesting accounts funded by a given
+	// funder.
+	AccountsByFunder(ctx context.Context, in *QueryAccountsByFunderRequest, opts ...grpc.CallOption) (*QueryAccountsByFunderResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) VestingAccount(ctx context.Context, in *QueryVestingAccountRequest, opts ...grpc.CallOption) (*QueryVestingAccountResponse, error) {
+	out := new(QueryVestingAccountResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.vesting.v1.Query/VestingAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Balances(ctx context.Context, in *QueryBalancesRequest, opts ...grpc.CallOption) (*QueryBalancesResponse, error) {
+	out := new(QueryBalancesResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.vesting.v1.Query/Balances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountsByFunder(ctx context.Context, in *QueryAccountsByFunderRequest, opts ...grpc.CallOption) (*QueryAccountsByFunderResponse, error) {
+	out := new(QueryAccountsByFunderResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.vesting.v1.Query/AccountsByFunder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// VestingAccount returns the vesting account given its address.
+	VestingAccount(context.Context, *QueryVestingAccountRequest) (*QueryVestingAccountResponse, error)
+	// Balances returns the vested, unvested, locked, spendable, and delegated
+	// balances of a vesting account at the current block time.
+	Balances(context.Context, *QueryBalancesRequest) (*QueryBalancesResponse, error)
+	// AccountsByFunder returns the clawback vesting accounts funded by a given
+	// funder.
+	AccountsByFunder(context.Context, *QueryAccountsByFunderRequest) (*QueryAccountsByFunderResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) VestingAccount(ctx context.Context, req *QueryVestingAccountRequest) (*QueryVestingAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingAccount not implemented")
+}
+func (*UnimplementedQueryServer) Balances(ctx context.Context, req *QueryBalancesRequest) (*QueryBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Balances not implemented")
+}
+func (*UnimplementedQueryServer) AccountsByFunder(ctx context.Context, req *QueryAccountsByFunderRequest) (*QueryAccountsByFunderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountsByFunder not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_VestingAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.vesting.v1.Query/VestingAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingAccount(ctx, req.(*QueryVestingAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Balances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Balances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.vesting.v1.Query/Balances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Balances(ctx, req.(*QueryBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountsByFunder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountsByFunderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountsByFunder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.vesting.v1.Query/AccountsByFunder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountsByFunder(ctx, req.(*QueryAccountsByFunderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sedachain.vesting.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "VestingAccount",
+			Handler:    _Query_VestingAccount_Handler,
+		},
+		{
+			MethodName: "Balances",
+			Handler:    _Query_Balances_Handler,
+		},
+		{
+			MethodName: "AccountsByFunder",
+			Handler:    _Query_AccountsByFunder_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sedachain/vesting/v1/query.proto",
+}
+
+func (m *QueryVestingAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Account != nil {
+		{
+			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBalancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBalancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBalancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBalancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBalancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatedFree) > 0 {
+		for iNdEx := len(m.DelegatedFree) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatedFree[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.DelegatedVesting) > 0 {
+		for iNdEx := len(m.DelegatedVesting) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatedVesting[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Spendable) > 0 {
+		for iNdEx := len(m.Spendable) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spendable[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Locked) > 0 {
+		for iNdEx := len(m.Locked) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Unvested) > 0 {
+		for iNdEx := len(m.Unvested) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unvested[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Vested) > 0 {
+		for iNdEx := len(m.Vested) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vested[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountsByFunderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountsByFunderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountsByFunderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountsByFunderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountsByFunderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountsByFunderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryVestingAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVestingAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Account != nil {
+		l = m.Account.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBalancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBalancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Vested) > 0 {
+		for _, e := range m.Vested {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Unvested) > 0 {
+		for _, e := range m.Unvested {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Locked) > 0 {
+		for _, e := range m.Locked {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Spendable) > 0 {
+		for _, e := range m.Spendable {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.DelegatedVesting) > 0 {
+		for _, e := range m.DelegatedVesting {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.DelegatedFree) > 0 {
+		for _, e := range m.DelegatedFree {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAccountsByFunderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountsByFunderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryVestingAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Account == nil {
+				m.Account = &types.Any{}
+			}
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBalancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBalancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vested = append(m.Vested, types1.Coin{})
+			if err := m.Vested[len(m.Vested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unvested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unvested = append(m.Unvested, types1.Coin{})
+			if err := m.Unvested[len(m.Unvested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locked = append(m.Locked, types1.Coin{})
+			if err := m.Locked[len(m.Locked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spendable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spendable = append(m.Spendable, types1.Coin{})
+			if err := m.Spendable[len(m.Spendable)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedVesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatedVesting = append(m.DelegatedVesting, types1.Coin{})
+			if err := m.DelegatedVesting[len(m.DelegatedVesting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedFree", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatedFree = append(m.DelegatedFree, types1.Coin{})
+			if err := m.DelegatedFree[len(m.DelegatedFree)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountsByFunderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountsByFunderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountsByFunderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountsByFunderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountsByFunderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountsByFunderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, &types.Any{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
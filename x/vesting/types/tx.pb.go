@@ -11,6 +11,7 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	types1 "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -34,6 +35,8 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // MsgCreateVestingAccount defines a message that creates a vesting account.
+// If the recipient is already a clawback continuous vesting account of the
+// same funder, the grant is added to it by merging the schedules.
 type MsgCreateVestingAccount struct {
 	FromAddress string                                   `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
 	ToAddress   string                                   `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
@@ -42,6 +45,9 @@ type MsgCreateVestingAccount struct {
 	EndTime int64 `protobuf:"varint,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
 	// if true, leave funder field empty and disable clawback
 	DisableClawback bool `protobuf:"varint,5,opt,name=disable_clawback,json=disableClawback,proto3" json:"disable_clawback,omitempty"`
+	// start of vesting as unix time (in seconds). If zero, the block time
+	// is used.
+	StartTime int64 `protobuf:"varint,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
 }
 
 func (m *MsgCreateVestingAccount) Reset()         { *m = MsgCreateVestingAccount{} }
@@ -112,6 +118,13 @@ func (m *MsgCreateVestingAccount) GetDisableClawback() bool {
 	return false
 }
 
+func (m *MsgCreateVestingAccount) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
 // MsgCreateVestingAccountResponse defines the CreateVestingAccount response
 // type.
 type MsgCreateVestingAccountResponse struct {
@@ -150,6 +163,137 @@ func (m *MsgCreateVestingAccountResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCreateVestingAccountResponse proto.InternalMessageInfo
 
+// MsgCreatePeriodicVestingAccount defines a message that creates a periodic
+// vesting account.
+type MsgCreatePeriodicVestingAccount struct {
+	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	ToAddress   string `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// start of vesting as unix time (in seconds). If zero, the block time
+	// is used.
+	StartTime      int64           `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	VestingPeriods []types1.Period `protobuf:"bytes,4,rep,name=vesting_periods,json=vestingPeriods,proto3" json:"vesting_periods"`
+	// optional cliff as unix time (in seconds). Periods that end before
+	// the cliff are merged into a single period that ends at the cliff.
+	CliffTime int64 `protobuf:"varint,5,opt,name=cliff_time,json=cliffTime,proto3" json:"cliff_time,omitempty"`
+	// if true, leave funder field empty and disable clawback
+	DisableClawback bool `protobuf:"varint,6,opt,name=disable_clawback,json=disableClawback,proto3" json:"disable_clawback,omitempty"`
+}
+
+func (m *MsgCreatePeriodicVestingAccount) Reset()         { *m = MsgCreatePeriodicVestingAccount{} }
+func (m *MsgCreatePeriodicVestingAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgCreatePeriodicVestingAccount) ProtoMessage()    {}
+func (*MsgCreatePeriodicVestingAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_abaae49a55dd1e8c, []int{2}
+}
+func (m *MsgCreatePeriodicVestingAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreatePeriodicVestingAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreatePeriodicVestingAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreatePeriodicVestingAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreatePeriodicVestingAccount.Merge(m, src)
+}
+func (m *MsgCreatePeriodicVestingAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreatePeriodicVestingAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreatePeriodicVestingAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreatePeriodicVestingAccount proto.InternalMessageInfo
+
+func (m *MsgCreatePeriodicVestingAccount) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *MsgCreatePeriodicVestingAccount) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *MsgCreatePeriodicVestingAccount) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *MsgCreatePeriodicVestingAccount) GetVestingPeriods() []types1.Period {
+	if m != nil {
+		return m.VestingPeriods
+	}
+	return nil
+}
+
+func (m *MsgCreatePeriodicVestingAccount) GetCliffTime() int64 {
+	if m != nil {
+		return m.CliffTime
+	}
+	return 0
+}
+
+func (m *MsgCreatePeriodicVestingAccount) GetDisableClawback() bool {
+	if m != nil {
+		return m.DisableClawback
+	}
+	return false
+}
+
+// MsgCreatePeriodicVestingAccountResponse defines the
+// CreatePeriodicVestingAccount response type.
+type MsgCreatePeriodicVestingAccountResponse struct {
+}
+
+func (m *MsgCreatePeriodicVestingAccountResponse) Reset() {
+	*m = MsgCreatePeriodicVestingAccountResponse{}
+}
+func (m *MsgCreatePeriodicVestingAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreatePeriodicVestingAccountResponse) ProtoMessage()    {}
+func (*MsgCreatePeriodicVestingAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_abaae49a55dd1e8c, []int{3}
+}
+func (m *MsgCreatePeriodicVestingAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreatePeriodicVestingAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreatePeriodicVestingAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreatePeriodicVestingAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreatePeriodicVestingAccountResponse.Merge(m, src)
+}
+func (m *MsgCreatePeriodicVestingAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreatePeriodicVestingAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreatePeriodicVestingAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreatePeriodicVestingAccountResponse proto.InternalMessageInfo
+
 // MsgClawback defines a message that returns the vesting funds to the funder.
 type MsgClawback struct {
 	// funder_address is the address which funded the account.
@@ -162,7 +306,7 @@ func (m *MsgClawback) Reset()         { *m = MsgClawback{} }
 func (m *MsgClawback) String() string { return proto.CompactTextString(m) }
 func (*MsgClawback) ProtoMessage()    {}
 func (*MsgClawback) Descriptor() ([]byte, []int) {
-	return fileDescriptor_abaae49a55dd1e8c, []int{2}
+	return fileDescriptor_abaae49a55dd1e8c, []int{4}
 }
 func (m *MsgClawback) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -210,13 +354,17 @@ type MsgClawbackResponse struct {
 	ClawedUnbonded  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=clawed_unbonded,json=clawedUnbonded,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"clawed_unbonded"`
 	ClawedUnbonding github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=clawed_unbonding,json=clawedUnbonding,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"clawed_unbonding"`
 	ClawedBonded    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=clawed_bonded,json=clawedBonded,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"clawed_bonded"`
+	// shortfall is the amount that could not be clawed back from staking due
+	// to rounding, validators without tokens, or entry limits of the
+	// destination. It remains in the account, which is fully vested.
+	Shortfall github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=shortfall,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"shortfall"`
 }
 
 func (m *MsgClawbackResponse) Reset()         { *m = MsgClawbackResponse{} }
 func (m *MsgClawbackResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgClawbackResponse) ProtoMessage()    {}
 func (*MsgClawbackResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_abaae49a55dd1e8c, []int{3}
+	return fileDescriptor_abaae49a55dd1e8c, []int{5}
 }
 func (m *MsgClawbackResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -266,57 +414,195 @@ func (m *MsgClawbackResponse) GetClawedBonded() github_com_cosmos_cosmos_sdk_typ
 	return nil
 }
 
+func (m *MsgClawbackResponse) GetShortfall() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Shortfall
+	}
+	return nil
+}
+
+// MsgUpdateVestingFunder defines a message that reassigns the funder of a
+// clawback vesting account or disables its clawback permanently.
+type MsgUpdateVestingFunder struct {
+	// funder_address is the current funder of the account.
+	FunderAddress string `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// account_address is the address of the vesting account.
+	AccountAddress string `protobuf:"bytes,2,opt,name=account_address,json=accountAddress,proto3" json:"account_address,omitempty"`
+	// new_funder_address is the address of the new funder. It must be empty
+	// if disable_clawback is set.
+	NewFunderAddress string `protobuf:"bytes,3,opt,name=new_funder_address,json=newFunderAddress,proto3" json:"new_funder_address,omitempty"`
+	// if true, clear the funder and disable clawback permanently
+	DisableClawback bool `protobuf:"varint,4,opt,name=disable_clawback,json=disableClawback,proto3" json:"disable_clawback,omitempty"`
+}
+
+func (m *MsgUpdateVestingFunder) Reset()         { *m = MsgUpdateVestingFunder{} }
+func (m *MsgUpdateVestingFunder) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateVestingFunder) ProtoMessage()    {}
+func (*MsgUpdateVestingFunder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_abaae49a55dd1e8c, []int{6}
+}
+func (m *MsgUpdateVestingFunder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateVestingFunder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateVestingFunder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateVestingFunder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateVestingFunder.Merge(m, src)
+}
+func (m *MsgUpdateVestingFunder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateVestingFunder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateVestingFunder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateVestingFunder proto.InternalMessageInfo
+
+func (m *MsgUpdateVestingFunder) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
+	}
+	return ""
+}
+
+func (m *MsgUpdateVestingFunder) GetAccountAddress() string {
+	if m != nil {
+		return m.AccountAddress
+	}
+	return ""
+}
+
+func (m *MsgUpdateVestingFunder) GetNewFunderAddress() string {
+	if m != nil {
+		return m.NewFunderAddress
+	}
+	return ""
+}
+
+func (m *MsgUpdateVestingFunder) GetDisableClawback() bool {
+	if m != nil {
+		return m.DisableClawback
+	}
+	return false
+}
+
+// MsgUpdateVestingFunderResponse defines the MsgUpdateVestingFunder response
+// type.
+type MsgUpdateVestingFunderResponse struct {
+}
+
+func (m *MsgUpdateVestingFunderResponse) Reset()         { *m = MsgUpdateVestingFunderResponse{} }
+func (m *MsgUpdateVestingFunderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateVestingFunderResponse) ProtoMessage()    {}
+func (*MsgUpdateVestingFunderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_abaae49a55dd1e8c, []int{7}
+}
+func (m *MsgUpdateVestingFunderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateVestingFunderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateVestingFunderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateVestingFunderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateVestingFunderResponse.Merge(m, src)
+}
+func (m *MsgUpdateVestingFunderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateVestingFunderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateVestingFunderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateVestingFunderResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateVestingAccount)(nil), "sedachain.vesting.v1.MsgCreateVestingAccount")
 	proto.RegisterType((*MsgCreateVestingAccountResponse)(nil), "sedachain.vesting.v1.MsgCreateVestingAccountResponse")
+	proto.RegisterType((*MsgCreatePeriodicVestingAccount)(nil), "sedachain.vesting.v1.MsgCreatePeriodicVestingAccount")
+	proto.RegisterType((*MsgCreatePeriodicVestingAccountResponse)(nil), "sedachain.vesting.v1.MsgCreatePeriodicVestingAccountResponse")
 	proto.RegisterType((*MsgClawback)(nil), "sedachain.vesting.v1.MsgClawback")
 	proto.RegisterType((*MsgClawbackResponse)(nil), "sedachain.vesting.v1.MsgClawbackResponse")
+	proto.RegisterType((*MsgUpdateVestingFunder)(nil), "sedachain.vesting.v1.MsgUpdateVestingFunder")
+	proto.RegisterType((*MsgUpdateVestingFunderResponse)(nil), "sedachain.vesting.v1.MsgUpdateVestingFunderResponse")
 }
 
 func init() { proto.RegisterFile("sedachain/vesting/v1/tx.proto", fileDescriptor_abaae49a55dd1e8c) }
 
 var fileDescriptor_abaae49a55dd1e8c = []byte{
-	// 625 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0xb3, 0x5d, 0x5b, 0xdb, 0x69, 0x9b, 0xd4, 0x6d, 0xa0, 0x9b, 0x80, 0x9b, 0x34, 0x20,
-	0xa6, 0x81, 0xec, 0x9a, 0x88, 0x08, 0xd5, 0x4b, 0x13, 0xf0, 0x64, 0x2f, 0xeb, 0x0f, 0xc4, 0xcb,
-	0x32, 0xbb, 0x3b, 0xdd, 0x0e, 0xcd, 0xce, 0x84, 0x9d, 0x49, 0x6c, 0xc0, 0x83, 0x78, 0xf4, 0x20,
-	0x9e, 0x3d, 0x79, 0x14, 0x4f, 0x39, 0xf8, 0x47, 0xf4, 0x58, 0x3c, 0x79, 0x52, 0x49, 0x84, 0xf8,
-	0x0f, 0x78, 0x97, 0xdd, 0x99, 0x84, 0x44, 0x1a, 0x42, 0xa1, 0x97, 0x24, 0xfb, 0xde, 0xf7, 0xbd,
-	0xcf, 0xe4, 0xfb, 0x76, 0x1e, 0xb8, 0xc9, 0x90, 0x0f, 0xbd, 0x63, 0x88, 0x89, 0xd5, 0x45, 0x8c,
-	0x63, 0x12, 0x58, 0xdd, 0x9a, 0xc5, 0x4f, 0xcd, 0x76, 0x44, 0x39, 0xd5, 0xb2, 0x93, 0xb4, 0x29,
-	0xd3, 0x66, 0xb7, 0x96, 0xcf, 0x06, 0x34, 0xa0, 0x89, 0xc0, 0x8a, 0x7f, 0x09, 0x6d, 0xde, 0xf0,
-	0x28, 0x0b, 0x29, 0xb3, 0x5c, 0xc8, 0x90, 0xd5, 0xad, 0xb9, 0x88, 0xc3, 0x9a, 0xe5, 0x51, 0x4c,
-	0x64, 0x3e, 0x27, 0xf2, 0x8e, 0x28, 0x14, 0x0f, 0x32, 0xb5, 0x23, 0x4b, 0x43, 0x96, 0xe0, 0x43,
-	0x16, 0xc8, 0xc4, 0x0d, 0x18, 0x62, 0x42, 0xad, 0xe4, 0x53, 0x84, 0x4a, 0xef, 0x55, 0xb0, 0x73,
-	0xc8, 0x82, 0x66, 0x84, 0x20, 0x47, 0xcf, 0xc5, 0xa1, 0x0e, 0x3c, 0x8f, 0x76, 0x08, 0xd7, 0x1e,
-	0x80, 0x8d, 0xa3, 0x88, 0x86, 0x0e, 0xf4, 0xfd, 0x08, 0x31, 0xa6, 0x2b, 0x45, 0xa5, 0xbc, 0xd6,
-	0xd0, 0xbf, 0x7d, 0xad, 0x66, 0x25, 0xef, 0x40, 0x64, 0x9e, 0xf0, 0x08, 0x93, 0xc0, 0x5e, 0x8f,
-	0xd5, 0x32, 0xa4, 0xdd, 0x07, 0x80, 0xd3, 0x49, 0xe9, 0xd2, 0x82, 0xd2, 0x35, 0x4e, 0xc7, 0x85,
-	0x3d, 0xb0, 0x02, 0xc3, 0x98, 0xaf, 0xab, 0x45, 0xb5, 0xbc, 0x5e, 0xcf, 0x99, 0xb2, 0x22, 0x76,
-	0xc2, 0x94, 0x4e, 0x98, 0x4d, 0x8a, 0x49, 0xe3, 0xd1, 0xd9, 0x8f, 0x42, 0xea, 0xcb, 0xcf, 0x42,
-	0x39, 0xc0, 0xfc, 0xb8, 0xe3, 0x9a, 0x1e, 0x0d, 0xa5, 0x13, 0xf2, 0xab, 0xca, 0xfc, 0x13, 0x8b,
-	0xf7, 0xda, 0x88, 0x25, 0x05, 0xec, 0xe3, 0xa8, 0x5f, 0xd9, 0x68, 0xa1, 0x00, 0x7a, 0x3d, 0x27,
-	0xf6, 0x92, 0x7d, 0x1e, 0xf5, 0x2b, 0x8a, 0x2d, 0x81, 0x5a, 0x0e, 0xac, 0x22, 0xe2, 0x3b, 0x1c,
-	0x87, 0x48, 0xbf, 0x56, 0x54, 0xca, 0xaa, 0x7d, 0x1d, 0x11, 0xff, 0x29, 0x0e, 0x91, 0xb6, 0x07,
-	0xb6, 0x7c, 0xcc, 0xa0, 0xdb, 0x42, 0x8e, 0xd7, 0x82, 0xaf, 0x5c, 0xe8, 0x9d, 0xe8, 0xcb, 0x45,
-	0xa5, 0xbc, 0x6a, 0x67, 0x64, 0xbc, 0x29, 0xc3, 0xfb, 0x0f, 0xff, 0x7c, 0x2a, 0x28, 0x6f, 0x63,
-	0xd2, 0xb4, 0x7b, 0xef, 0x46, 0xfd, 0x4a, 0x69, 0xea, 0x54, 0x73, 0x4c, 0x2f, 0xed, 0x82, 0xc2,
-	0x9c, 0x94, 0x8d, 0x58, 0x9b, 0x12, 0x86, 0x4a, 0x11, 0x58, 0x8f, 0x25, 0x92, 0xa7, 0xdd, 0x02,
-	0xe9, 0xa3, 0x0e, 0xf1, 0x51, 0x34, 0x3b, 0x28, 0x7b, 0x53, 0x44, 0xc7, 0xbe, 0xde, 0x06, 0x19,
-	0x28, 0x1a, 0xcd, 0x4e, 0xc5, 0x4e, 0xcb, 0xb0, 0x14, 0xee, 0x6f, 0xc7, 0x67, 0xff, 0xaf, 0x65,
-	0xe9, 0xef, 0x12, 0xd8, 0x9e, 0x82, 0x8e, 0xcf, 0xa2, 0x71, 0x90, 0x89, 0xfd, 0x40, 0xbe, 0xd3,
-	0x21, 0x2e, 0x25, 0x3e, 0xf2, 0x75, 0x65, 0xd1, 0xd8, 0xee, 0x5c, 0x76, 0x6c, 0x76, 0x5a, 0x30,
-	0x9e, 0x49, 0x84, 0xd6, 0x05, 0x5b, 0x33, 0x54, 0x4c, 0x02, 0x7d, 0xe9, 0xea, 0xb1, 0x99, 0x69,
-	0x2c, 0x26, 0x81, 0xd6, 0x06, 0x9b, 0x92, 0x2b, 0xff, 0xab, 0x7a, 0xf5, 0xd0, 0x0d, 0x41, 0x68,
-	0x24, 0x80, 0xfa, 0x6f, 0x05, 0xa8, 0x87, 0x2c, 0xd0, 0x5e, 0x83, 0xec, 0x85, 0x77, 0xb4, 0x6a,
-	0x5e, 0xb4, 0x53, 0xcc, 0x39, 0xaf, 0x50, 0xfe, 0xde, 0xa5, 0xe4, 0x93, 0x29, 0xbf, 0x00, 0xab,
-	0x93, 0xd7, 0x6d, 0x77, 0x7e, 0x0b, 0x29, 0xc9, 0xef, 0x2d, 0x94, 0x8c, 0x3b, 0xe7, 0x97, 0xdf,
-	0xc4, 0x37, 0xb0, 0xf1, 0xf8, 0x6c, 0x60, 0x28, 0xe7, 0x03, 0x43, 0xf9, 0x35, 0x30, 0x94, 0x0f,
-	0x43, 0x23, 0x75, 0x3e, 0x34, 0x52, 0xdf, 0x87, 0x46, 0xea, 0x65, 0x7d, 0xca, 0xb8, 0xb8, 0x6b,
-	0xb2, 0xb6, 0x3c, 0xda, 0x4a, 0x1e, 0xaa, 0x62, 0xd7, 0x9e, 0x4e, 0xb6, 0x6d, 0x62, 0xa4, 0xbb,
-	0x92, 0x88, 0xee, 0xfe, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x4d, 0x33, 0x4a, 0xf4, 0x8f, 0x05, 0x00,
-	0x00,
+	// 854 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0xd7, 0x69, 0x68, 0xa6, 0xdd, 0xa4, 0xb8, 0x11, 0xeb, 0x46, 0xac, 0x93, 0x8d, 0x16,
+	0x6d, 0x5a, 0x6d, 0x6c, 0x52, 0x40, 0x48, 0x01, 0x0e, 0x9b, 0x4a, 0x3d, 0x51, 0x09, 0x19, 0x16,
+	0x21, 0x2e, 0x96, 0x63, 0x4f, 0xdc, 0xd1, 0xc6, 0x33, 0x91, 0x67, 0x92, 0x6e, 0x24, 0x0e, 0x88,
+	0x23, 0xa7, 0x3d, 0x70, 0xe2, 0xc4, 0x11, 0x21, 0x21, 0xf5, 0xc0, 0x1f, 0xd1, 0x63, 0xc5, 0x01,
+	0x71, 0x82, 0xaa, 0x3d, 0x94, 0xbf, 0x80, 0x33, 0xf2, 0xcc, 0xd8, 0xf9, 0x21, 0x87, 0xa8, 0x28,
+	0x07, 0x2e, 0x6d, 0xfc, 0xde, 0xf7, 0xbe, 0xef, 0xcd, 0xfb, 0x31, 0x36, 0x78, 0x48, 0xa1, 0xef,
+	0x7a, 0xa7, 0x2e, 0xc2, 0xd6, 0x18, 0x52, 0x86, 0x70, 0x60, 0x8d, 0xdb, 0x16, 0x7b, 0x69, 0x0e,
+	0x23, 0xc2, 0x88, 0x56, 0x49, 0xdd, 0xa6, 0x74, 0x9b, 0xe3, 0x76, 0xb5, 0x12, 0x90, 0x80, 0x70,
+	0x80, 0x15, 0xff, 0x12, 0xd8, 0xaa, 0xe1, 0x11, 0x1a, 0x12, 0x6a, 0xf5, 0x5c, 0x0a, 0xad, 0x71,
+	0xbb, 0x07, 0x99, 0xdb, 0xb6, 0x3c, 0x82, 0xb0, 0xf4, 0xef, 0x09, 0xbf, 0x23, 0x02, 0xc5, 0x83,
+	0x74, 0x3d, 0x90, 0xa1, 0x21, 0xe5, 0xf2, 0x21, 0x0d, 0xa4, 0xe3, 0x75, 0x37, 0x44, 0x98, 0x58,
+	0xfc, 0xaf, 0x34, 0x3d, 0x96, 0xd8, 0x69, 0xba, 0x42, 0x29, 0xc9, 0x8f, 0xa3, 0x1a, 0x3f, 0xab,
+	0xe0, 0xc1, 0x09, 0x0d, 0x8e, 0x22, 0xe8, 0x32, 0xf8, 0xb9, 0x70, 0x3d, 0xf3, 0x3c, 0x32, 0xc2,
+	0x4c, 0xfb, 0x00, 0x6c, 0xf7, 0x23, 0x12, 0x3a, 0xae, 0xef, 0x47, 0x90, 0x52, 0x5d, 0xa9, 0x2b,
+	0xcd, 0x62, 0x57, 0xff, 0xf5, 0x97, 0x56, 0x45, 0x66, 0xf5, 0x4c, 0x78, 0x3e, 0x65, 0x11, 0xc2,
+	0x81, 0xbd, 0x15, 0xa3, 0xa5, 0x49, 0x7b, 0x1f, 0x00, 0x46, 0xd2, 0xd0, 0x7b, 0x2b, 0x42, 0x8b,
+	0x8c, 0x24, 0x81, 0x13, 0x50, 0x70, 0xc3, 0x58, 0x5f, 0x57, 0xeb, 0x6a, 0x73, 0xeb, 0x70, 0xcf,
+	0x94, 0x11, 0x71, 0xbd, 0x4c, 0x79, 0x0a, 0xf3, 0x88, 0x20, 0xdc, 0x3d, 0xbe, 0xf8, 0xa3, 0x96,
+	0xfb, 0xe9, 0xcf, 0x5a, 0x33, 0x40, 0xec, 0x74, 0xd4, 0x33, 0x3d, 0x12, 0xca, 0x7a, 0xc9, 0x7f,
+	0x2d, 0xea, 0xbf, 0xb0, 0xd8, 0x64, 0x08, 0x29, 0x0f, 0xa0, 0xdf, 0xdf, 0x9e, 0x1f, 0x6c, 0x0f,
+	0x60, 0xe0, 0x7a, 0x13, 0x27, 0xae, 0x38, 0xfd, 0xf1, 0xf6, 0xfc, 0x40, 0xb1, 0xa5, 0xa0, 0xb6,
+	0x07, 0x36, 0x21, 0xf6, 0x1d, 0x86, 0x42, 0xa8, 0xe7, 0xeb, 0x4a, 0x53, 0xb5, 0x5f, 0x83, 0xd8,
+	0xff, 0x0c, 0x85, 0x50, 0xdb, 0x07, 0x3b, 0x3e, 0xa2, 0x6e, 0x6f, 0x00, 0x1d, 0x6f, 0xe0, 0x9e,
+	0xf5, 0x5c, 0xef, 0x85, 0xbe, 0x51, 0x57, 0x9a, 0x9b, 0x76, 0x59, 0xda, 0x8f, 0xa4, 0x59, 0x7b,
+	0x08, 0x00, 0x65, 0x6e, 0xc4, 0x04, 0x4f, 0x81, 0xf3, 0x14, 0xb9, 0x25, 0x66, 0xea, 0x7c, 0xf8,
+	0xd7, 0x0f, 0x35, 0xe5, 0x9b, 0x38, 0x91, 0xd9, 0xe2, 0x7e, 0x7b, 0x7b, 0x7e, 0xd0, 0x98, 0x49,
+	0x7a, 0x49, 0x4f, 0x1a, 0x8f, 0x40, 0x6d, 0x89, 0xcb, 0x86, 0x74, 0x48, 0x30, 0x85, 0x8d, 0x57,
+	0xea, 0x0c, 0xe6, 0x13, 0x18, 0x21, 0xe2, 0x23, 0xef, 0x7f, 0xd1, 0xda, 0xf9, 0xca, 0xa8, 0x0b,
+	0x95, 0xd1, 0x6c, 0x50, 0x96, 0xc3, 0xe9, 0x0c, 0x79, 0xda, 0x54, 0xcf, 0xf3, 0x11, 0x30, 0x92,
+	0x11, 0x98, 0xee, 0x96, 0x98, 0x02, 0x71, 0xba, 0x6e, 0x31, 0x9e, 0x03, 0xd1, 0xca, 0x92, 0x84,
+	0x08, 0x0f, 0x97, 0xf4, 0x06, 0xa8, 0xdf, 0x17, 0x92, 0x1b, 0x42, 0x92, 0x5b, 0x96, 0xb6, 0xb5,
+	0x90, 0xd9, 0xd6, 0x4e, 0x27, 0xb3, 0x67, 0x8f, 0xa7, 0xf7, 0xc2, 0x42, 0xcd, 0xe3, 0x8a, 0x27,
+	0x5d, 0xdb, 0x07, 0x4f, 0x56, 0x74, 0x24, 0xed, 0x5e, 0x04, 0xb6, 0x62, 0x68, 0x32, 0x4c, 0x6f,
+	0x81, 0x52, 0x7f, 0x84, 0x7d, 0x18, 0xcd, 0xb7, 0xca, 0xbe, 0x2f, 0xac, 0x49, 0x65, 0x9f, 0x80,
+	0xb2, 0x2b, 0x88, 0xe6, 0xfb, 0x62, 0x97, 0xa4, 0x59, 0x02, 0x3b, 0xbb, 0xf1, 0x29, 0x16, 0x28,
+	0x1b, 0x57, 0x2a, 0xd8, 0x9d, 0x11, 0x4d, 0x72, 0xd1, 0x18, 0x28, 0xc7, 0x55, 0x81, 0xbe, 0x33,
+	0xc2, 0x3d, 0x82, 0x7d, 0xe8, 0xeb, 0xca, 0xaa, 0x9d, 0x7c, 0xfb, 0xae, 0x3b, 0x69, 0x97, 0x84,
+	0xc6, 0x73, 0x29, 0xa1, 0x8d, 0xc1, 0xce, 0x9c, 0x2a, 0xc2, 0x81, 0x7e, 0x6f, 0xfd, 0xb2, 0xe5,
+	0x59, 0x59, 0x84, 0x03, 0x6d, 0x08, 0xee, 0x4b, 0x5d, 0x79, 0x56, 0x75, 0xfd, 0xa2, 0xdb, 0x42,
+	0xa1, 0x2b, 0x4e, 0x8a, 0x40, 0x91, 0x9e, 0x92, 0x88, 0xf5, 0xdd, 0xc1, 0x40, 0xcf, 0xaf, 0x5f,
+	0x6d, 0xca, 0xde, 0xf8, 0x4d, 0x01, 0x6f, 0x9c, 0xd0, 0xe0, 0xf9, 0xd0, 0x9f, 0x5e, 0x1c, 0xc7,
+	0x7c, 0x0a, 0xd6, 0x3d, 0x62, 0xda, 0x53, 0xa0, 0x61, 0x78, 0xe6, 0x2c, 0x70, 0xaa, 0x1c, 0xbb,
+	0x83, 0xe1, 0xd9, 0xf1, 0x1c, 0x6d, 0xd6, 0x06, 0xe6, 0xb3, 0x37, 0x30, 0x73, 0x76, 0xeb, 0xc0,
+	0xc8, 0x3e, 0x57, 0x32, 0xc5, 0x87, 0x7f, 0xab, 0x40, 0x3d, 0xa1, 0x81, 0xf6, 0x15, 0xa8, 0x64,
+	0xbe, 0xe6, 0x5a, 0x66, 0xd6, 0xcb, 0xdb, 0x5c, 0x72, 0xcd, 0x56, 0xdf, 0xbb, 0x13, 0x3c, 0xdd,
+	0xa5, 0xef, 0x14, 0xf0, 0xe6, 0xbf, 0x5e, 0xc9, 0xab, 0x78, 0xb3, 0xc3, 0xaa, 0x1f, 0xfd, 0xa7,
+	0xb0, 0x34, 0xad, 0x2f, 0xc0, 0x66, 0x7a, 0xd7, 0x3c, 0x5a, 0x4e, 0x25, 0x21, 0xd5, 0xfd, 0x95,
+	0x90, 0x94, 0x79, 0x02, 0x76, 0xb3, 0xa6, 0xed, 0xe9, 0x52, 0x86, 0x0c, 0x74, 0xf5, 0xdd, 0xbb,
+	0xa0, 0x13, 0xe9, 0xea, 0xc6, 0xd7, 0xf1, 0xbb, 0xa0, 0xfb, 0xf1, 0xc5, 0xb5, 0xa1, 0x5c, 0x5e,
+	0x1b, 0xca, 0xd5, 0xb5, 0xa1, 0xbc, 0xba, 0x31, 0x72, 0x97, 0x37, 0x46, 0xee, 0xf7, 0x1b, 0x23,
+	0xf7, 0xe5, 0xe1, 0xcc, 0x0a, 0xc5, 0x02, 0xfc, 0x5b, 0xc8, 0x23, 0x03, 0xfe, 0xd0, 0x12, 0xd7,
+	0xf9, 0xcb, 0xf4, 0xcb, 0x89, 0xaf, 0x54, 0xaf, 0xc0, 0x41, 0xef, 0xfc, 0x33, 0x00, 0x1a, 0x22,
+	0xd0, 0x04, 0x0a, 0x0a, 0x00, 0x00,
 }
 
 func (this *MsgCreateVestingAccount) Equal(that interface{}) bool {
@@ -358,6 +644,9 @@ func (this *MsgCreateVestingAccount) Equal(that interface{}) bool {
 	if this.DisableClawback != that1.DisableClawback {
 		return false
 	}
+	if this.StartTime != that1.StartTime {
+		return false
+	}
 	return true
 }
 
@@ -375,8 +664,14 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// CreateVestingAccount creates a new vesting account.
 	CreateVestingAccount(ctx context.Context, in *MsgCreateVestingAccount, opts ...grpc.CallOption) (*MsgCreateVestingAccountResponse, error)
-	// Clawback returns the vesting funds back to the funder.
+	// CreatePeriodicVestingAccount creates a new periodic vesting account.
+	CreatePeriodicVestingAccount(ctx context.Context, in *MsgCreatePeriodicVestingAccount, opts ...grpc.CallOption) (*MsgCreatePeriodicVestingAccountResponse, error)
+	// Clawback returns the vesting funds back to the funder or, if requested
+	// by the authority, sends them to the community pool.
 	Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*MsgClawbackResponse, error)
+	// UpdateVestingFunder reassigns the funder of a clawback vesting account
+	// or disables its clawback.
+	UpdateVestingFunder(ctx context.Context, in *MsgUpdateVestingFunder, opts ...grpc.CallOption) (*MsgUpdateVestingFunderResponse, error)
 }
 
 type msgClient struct {
@@ -396,6 +691,15 @@ func (c *msgClient) CreateVestingAccount(ctx context.Context, in *MsgCreateVesti
 	return out, nil
 }
 
+func (c *msgClient) CreatePeriodicVestingAccount(ctx context.Context, in *MsgCreatePeriodicVestingAccount, opts ...grpc.CallOption) (*MsgCreatePeriodicVestingAccountResponse, error) {
+	out := new(MsgCreatePeriodicVestingAccountResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.vesting.v1.Msg/CreatePeriodicVestingAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*MsgClawbackResponse, error) {
 	out := new(MsgClawbackResponse)
 	err := c.cc.Invoke(ctx, "/sedachain.vesting.v1.Msg/Clawback", in, out, opts...)
@@ -405,12 +709,27 @@ func (c *msgClient) Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) UpdateVestingFunder(ctx context.Context, in *MsgUpdateVestingFunder, opts ...grpc.CallOption) (*MsgUpdateVestingFunderResponse, error) {
+	out := new(MsgUpdateVestingFunderResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.vesting.v1.Msg/UpdateVestingFunder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateVestingAccount creates a new vesting account.
 	CreateVestingAccount(context.Context, *MsgCreateVestingAccount) (*MsgCreateVestingAccountResponse, error)
-	// Clawback returns the vesting funds back to the funder.
+	// CreatePeriodicVestingAccount creates a new periodic vesting account.
+	CreatePeriodicVestingAccount(context.Context, *MsgCreatePeriodicVestingAccount) (*MsgCreatePeriodicVestingAccountResponse, error)
+	// Clawback returns the vesting funds back to the funder or, if requested
+	// by the authority, sends them to the community pool.
 	Clawback(context.Context, *MsgClawback) (*MsgClawbackResponse, error)
+	// UpdateVestingFunder reassigns the funder of a clawback vesting account
+	// or disables its clawback.
+	UpdateVestingFunder(context.Context, *MsgUpdateVestingFunder) (*MsgUpdateVestingFunderResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -420,9 +739,15 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) CreateVestingAccount(ctx context.Context, req *MsgCreateVestingAccount) (*MsgCreateVestingAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateVestingAccount not implemented")
 }
+func (*UnimplementedMsgServer) CreatePeriodicVestingAccount(ctx context.Context, req *MsgCreatePeriodicVestingAccount) (*MsgCreatePeriodicVestingAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePeriodicVestingAccount not implemented")
+}
 func (*UnimplementedMsgServer) Clawback(ctx context.Context, req *MsgClawback) (*MsgClawbackResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Clawback not implemented")
 }
+func (*UnimplementedMsgServer) UpdateVestingFunder(ctx context.Context, req *MsgUpdateVestingFunder) (*MsgUpdateVestingFunderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVestingFunder not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -446,6 +771,24 @@ func _Msg_CreateVestingAccount_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreatePeriodicVestingAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePeriodicVestingAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreatePeriodicVestingAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.vesting.v1.Msg/CreatePeriodicVestingAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreatePeriodicVestingAccount(ctx, req.(*MsgCreatePeriodicVestingAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Clawback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgClawback)
 	if err := dec(in); err != nil {
@@ -464,6 +807,24 @@ func _Msg_Clawback_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateVestingFunder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateVestingFunder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateVestingFunder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.vesting.v1.Msg/UpdateVestingFunder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateVestingFunder(ctx, req.(*MsgUpdateVestingFunder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "sedachain.vesting.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -472,10 +833,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateVestingAccount",
 			Handler:    _Msg_CreateVestingAccount_Handler,
 		},
+		{
+			MethodName: "CreatePeriodicVestingAccount",
+			Handler:    _Msg_CreatePeriodicVestingAccount_Handler,
+		},
 		{
 			MethodName: "Clawback",
 			Handler:    _Msg_Clawback_Handler,
 		},
+		{
+			MethodName: "UpdateVestingFunder",
+			Handler:    _Msg_UpdateVestingFunder_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sedachain/vesting/v1/tx.proto",
@@ -501,6 +870,11 @@ func (m *MsgCreateVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.StartTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.DisableClawback {
 		i--
 		if m.DisableClawback {
@@ -570,7 +944,7 @@ func (m *MsgCreateVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgClawback) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreatePeriodicVestingAccount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -580,34 +954,68 @@ func (m *MsgClawback) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgClawback) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreatePeriodicVestingAccount) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgClawback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreatePeriodicVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.AccountAddress) > 0 {
-		i -= len(m.AccountAddress)
-		copy(dAtA[i:], m.AccountAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AccountAddress)))
+	if m.DisableClawback {
+		i--
+		if m.DisableClawback {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CliffTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CliffTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.VestingPeriods) > 0 {
+		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.FunderAddress) > 0 {
-		i -= len(m.FunderAddress)
-		copy(dAtA[i:], m.FunderAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgClawbackResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreatePeriodicVestingAccountResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -617,19 +1025,93 @@ func (m *MsgClawbackResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgClawbackResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreatePeriodicVestingAccountResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgClawbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreatePeriodicVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ClawedBonded) > 0 {
-		for iNdEx := len(m.ClawedBonded) - 1; iNdEx >= 0; iNdEx-- {
-			{
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClawback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClawback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClawback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AccountAddress) > 0 {
+		i -= len(m.AccountAddress)
+		copy(dAtA[i:], m.AccountAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AccountAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClawbackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClawbackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClawbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shortfall) > 0 {
+		for iNdEx := len(m.Shortfall) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shortfall[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ClawedBonded) > 0 {
+		for iNdEx := len(m.ClawedBonded) - 1; iNdEx >= 0; iNdEx-- {
+			{
 				size, err := m.ClawedBonded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
@@ -672,6 +1154,83 @@ func (m *MsgClawbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateVestingFunder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateVestingFunder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateVestingFunder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DisableClawback {
+		i--
+		if m.DisableClawback {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NewFunderAddress) > 0 {
+		i -= len(m.NewFunderAddress)
+		copy(dAtA[i:], m.NewFunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewFunderAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AccountAddress) > 0 {
+		i -= len(m.AccountAddress)
+		copy(dAtA[i:], m.AccountAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AccountAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateVestingFunderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateVestingFunderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateVestingFunderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -709,6 +1268,9 @@ func (m *MsgCreateVestingAccount) Size() (n int) {
 	if m.DisableClawback {
 		n += 2
 	}
+	if m.StartTime != 0 {
+		n += 1 + sovTx(uint64(m.StartTime))
+	}
 	return n
 }
 
@@ -721,6 +1283,47 @@ func (m *MsgCreateVestingAccountResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCreatePeriodicVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovTx(uint64(m.StartTime))
+	}
+	if len(m.VestingPeriods) > 0 {
+		for _, e := range m.VestingPeriods {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.CliffTime != 0 {
+		n += 1 + sovTx(uint64(m.CliffTime))
+	}
+	if m.DisableClawback {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgCreatePeriodicVestingAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgClawback) Size() (n int) {
 	if m == nil {
 		return 0
@@ -762,6 +1365,45 @@ func (m *MsgClawbackResponse) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	if len(m.Shortfall) > 0 {
+		for _, e := range m.Shortfall {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateVestingFunder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AccountAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewFunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DisableClawback {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUpdateVestingFunderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	return n
 }
 
@@ -937,6 +1579,25 @@ func (m *MsgCreateVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.DisableClawback = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1008,7 +1669,7 @@ func (m *MsgCreateVestingAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClawback) Unmarshal(dAtA []byte) error {
+func (m *MsgCreatePeriodicVestingAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1031,15 +1692,15 @@ func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClawback: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClawback: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1067,11 +1728,11 @@ func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1099,19 +1760,275 @@ func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, types1.Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffTime", wireType)
+			}
+			m.CliffTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CliffTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableClawback", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableClawback = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePeriodicVestingAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClawback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClawback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClawback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -1253,6 +2170,256 @@ func (m *MsgClawbackResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shortfall", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shortfall = append(m.Shortfall, types.Coin{})
+			if err := m.Shortfall[len(m.Shortfall)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateVestingFunder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateVestingFunder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateVestingFunder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewFunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableClawback", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableClawback = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateVestingFunderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateVestingFunderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateVestingFunderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10,3 +10,13 @@ const (
 	// RouterKey defines the module's message routing key
 	RouterKey = ModuleName
 )
+
+const (
+	// MaxStartTimePastOffset is how far in seconds the start time of a
+	// vesting account may be set before the block time.
+	MaxStartTimePastOffset int64 = 5 * 365 * 24 * 60 * 60
+
+	// MaxStartTimeFutureOffset is how far in seconds the start time of a
+	// vesting account may be set after the block time.
+	MaxStartTimeFutureOffset int64 = 365 * 24 * 60 * 60
+)
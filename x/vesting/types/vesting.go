@@ -1,6 +1,11 @@
 package types
 
 import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
@@ -9,8 +14,29 @@ import (
 var (
 	_ vestexported.VestingAccount = (*ClawbackContinuousVestingAccount)(nil)
 	_ authtypes.GenesisAccount    = (*ClawbackContinuousVestingAccount)(nil)
+	_ ClawbackVestingAccount      = (*ClawbackContinuousVestingAccount)(nil)
+
+	_ vestexported.VestingAccount = (*ClawbackPeriodicVestingAccount)(nil)
+	_ authtypes.GenesisAccount    = (*ClawbackPeriodicVestingAccount)(nil)
+	_ ClawbackVestingAccount      = (*ClawbackPeriodicVestingAccount)(nil)
 )
 
+// ClawbackVestingAccount is a vesting account whose vesting coins can
+// be clawed back by its funder.
+type ClawbackVestingAccount interface {
+	vestexported.VestingAccount
+
+	// GetFunderAddress returns the address of the funder or an empty
+	// string if clawback is disabled.
+	GetFunderAddress() string
+	// SetFunderAddress sets the address of the funder. An empty address
+	// disables clawback.
+	SetFunderAddress(funder string)
+	// EndVesting ends vesting at the given time so that all of the
+	// original vesting coins are vested from then on.
+	EndVesting(endTime int64)
+}
+
 // NewContinuousVestingAccountRaw creates a new ContinuousVestingAccount object from BaseVestingAccount
 func NewClawbackContinuousVestingAccountRaw(bva *vestingtypes.BaseVestingAccount, startTime int64, funder string) *ClawbackContinuousVestingAccount {
 	continuousVestingAcc := vestingtypes.NewContinuousVestingAccountRaw(bva, startTime)
@@ -19,3 +45,235 @@ func NewClawbackContinuousVestingAccountRaw(bva *vestingtypes.BaseVestingAccount
 		FunderAddress:            funder,
 	}
 }
+
+func (va ClawbackContinuousVestingAccount) GetFunderAddress() string {
+	return va.FunderAddress
+}
+
+func (va *ClawbackContinuousVestingAccount) SetFunderAddress(funder string) {
+	va.FunderAddress = funder
+}
+
+// GetVestedCoins returns the total number of vested coins. The coins of
+// each grant vest linearly over its own schedule once grants have been
+// added to the account.
+func (va ClawbackContinuousVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	if len(va.Grants) == 0 {
+		return va.ContinuousVestingAccount.GetVestedCoins(blockTime)
+	}
+
+	vested := sdk.NewCoins()
+	for _, grant := range va.Grants {
+		vested = vested.Add(grant.GetVestedCoins(blockTime)...)
+	}
+	return vested
+}
+
+// GetVestingCoins returns the total number of vesting coins.
+func (va ClawbackContinuousVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return va.OriginalVesting.Sub(va.GetVestedCoins(blockTime)...)
+}
+
+// LockedCoins returns the set of coins that are not spendable.
+func (va ClawbackContinuousVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return va.BaseVestingAccount.LockedCoinsFromVesting(va.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the
+// appropriate values for the amount of delegated vesting, delegated free,
+// and reducing the overall amount of base coins.
+func (va *ClawbackContinuousVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	va.BaseVestingAccount.TrackDelegation(balance, va.GetVestingCoins(blockTime), amount)
+}
+
+// Validate checks the funder address and the grants in addition to the
+// validation of the underlying continuous vesting account, which ensures
+// that the start time is before the end time.
+func (va ClawbackContinuousVestingAccount) Validate() error {
+	if err := validateFunderAddress(va.FunderAddress); err != nil {
+		return err
+	}
+	if len(va.Grants) > 0 {
+		startTime, endTime := va.Grants[0].StartTime, va.Grants[0].EndTime
+		total := sdk.NewCoins()
+		for i, grant := range va.Grants {
+			if grant.StartTime >= grant.EndTime {
+				return fmt.Errorf("grant %d start time %d must be before its end time %d", i, grant.StartTime, grant.EndTime)
+			}
+			if !grant.Amount.IsValid() || grant.Amount.IsZero() {
+				return fmt.Errorf("invalid amount %s of grant %d", grant.Amount, i)
+			}
+			startTime = min(startTime, grant.StartTime)
+			endTime = max(endTime, grant.EndTime)
+			total = total.Add(grant.Amount...)
+		}
+		if startTime != va.StartTime || endTime != va.EndTime {
+			return fmt.Errorf("grants from %d to %d do not match vesting from %d to %d", startTime, endTime, va.StartTime, va.EndTime)
+		}
+		if !total.Equal(va.OriginalVesting) {
+			return fmt.Errorf("original vesting %s does not match the total %s of the grants", va.OriginalVesting, total)
+		}
+	}
+	return va.ContinuousVestingAccount.Validate()
+}
+
+// EndVesting sets the end time to the given time and drops the grants
+// so that all of the original vesting coins are vested from then on. The
+// start time is moved before it if needed since no coins are vested
+// before or at the start time.
+func (va *ClawbackContinuousVestingAccount) EndVesting(endTime int64) {
+	if va.StartTime >= endTime {
+		va.StartTime = endTime - 1
+	}
+	va.EndTime = endTime
+	va.Grants = nil
+}
+
+// AddGrant adds a grant of the given amount vesting linearly from the
+// given block time until endTime. The account keeps track of the schedule
+// of each grant, starting with its original one, so that the coins of the
+// existing grants keep vesting as before.
+func (va *ClawbackContinuousVestingAccount) AddGrant(blockTime time.Time, amount sdk.Coins, endTime int64) {
+	if len(va.Grants) == 0 {
+		va.Grants = []ContinuousGrant{{
+			StartTime: va.StartTime,
+			EndTime:   va.EndTime,
+			Amount:    va.OriginalVesting,
+		}}
+	}
+
+	startTime := blockTime.Unix()
+	va.Grants = append(va.Grants, ContinuousGrant{
+		StartTime: startTime,
+		EndTime:   endTime,
+		Amount:    amount,
+	})
+
+	va.OriginalVesting = va.OriginalVesting.Add(amount...)
+	va.StartTime = min(va.StartTime, startTime)
+	va.EndTime = max(va.EndTime, endTime)
+}
+
+// GetVestedCoins returns the coins of the grant vested by the given block
+// time.
+func (g ContinuousGrant) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	now := blockTime.Unix()
+	switch {
+	case now <= g.StartTime:
+		return sdk.NewCoins()
+	case now >= g.EndTime:
+		return g.Amount
+	}
+
+	// calculate the vesting scalar
+	x := now - g.StartTime
+	y := g.EndTime - g.StartTime
+	s := math.LegacyNewDec(x).Quo(math.LegacyNewDec(y))
+
+	var vested sdk.Coins
+	for _, coin := range g.Amount {
+		vestedAmt := math.LegacyNewDecFromInt(coin.Amount).Mul(s).RoundInt()
+		vested = vested.Add(sdk.NewCoin(coin.Denom, vestedAmt))
+	}
+	return vested
+}
+
+// NewClawbackPeriodicVestingAccountRaw creates a new ClawbackPeriodicVestingAccount
+// object from BaseVestingAccount.
+func NewClawbackPeriodicVestingAccountRaw(bva *vestingtypes.BaseVestingAccount, startTime int64, periods vestingtypes.Periods, funder string) *ClawbackPeriodicVestingAccount {
+	periodicVestingAcc := vestingtypes.NewPeriodicVestingAccountRaw(bva, startTime, periods)
+	return &ClawbackPeriodicVestingAccount{
+		PeriodicVestingAccount: periodicVestingAcc,
+		FunderAddress:          funder,
+	}
+}
+
+func (va ClawbackPeriodicVestingAccount) GetFunderAddress() string {
+	return va.FunderAddress
+}
+
+func (va *ClawbackPeriodicVestingAccount) SetFunderAddress(funder string) {
+	va.FunderAddress = funder
+}
+
+// Validate checks the funder address in addition to the validation of
+// the underlying periodic vesting account, which ensures that the start
+// time is before the end time and that the periods match the original
+// vesting coins and the end time.
+func (va ClawbackPeriodicVestingAccount) Validate() error {
+	if err := validateFunderAddress(va.FunderAddress); err != nil {
+		return err
+	}
+	return va.PeriodicVestingAccount.Validate()
+}
+
+// validateFunderAddress checks that the given funder address is either
+// empty, meaning clawback is disabled, or a valid account address.
+func validateFunderAddress(funder string) error {
+	if funder == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(funder); err != nil {
+		return fmt.Errorf("invalid funder address %s: %w", funder, err)
+	}
+	return nil
+}
+
+// EndVesting keeps the periods that have ended by the given time and
+// replaces the remaining ones with a single period ending at the given
+// time so that the schedule stays consistent with the end time. The
+// start time is moved before it if needed since no coins are vested
+// before or at the start time.
+func (va *ClawbackPeriodicVestingAccount) EndVesting(endTime int64) {
+	if va.StartTime >= endTime {
+		va.StartTime = endTime - 1
+	}
+
+	var periods vestingtypes.Periods
+	elapsed := va.StartTime
+	remaining := va.OriginalVesting
+	for _, period := range va.VestingPeriods {
+		if elapsed+period.Length > endTime {
+			break
+		}
+		elapsed += period.Length
+		remaining = remaining.Sub(period.Amount...)
+		periods = append(periods, period)
+	}
+	if !remaining.IsZero() {
+		periods = append(periods, vestingtypes.Period{Length: endTime - elapsed, Amount: remaining})
+	}
+
+	va.VestingPeriods = periods
+	va.EndTime = endTime
+}
+
+// ApplyCliff returns the given periods starting at startTime with all
+// periods ending before cliffTime merged into a single period ending at
+// cliffTime. The periods are returned as is if cliffTime is not after
+// startTime.
+func ApplyCliff(startTime, cliffTime int64, periods vestingtypes.Periods) vestingtypes.Periods {
+	if cliffTime <= startTime {
+		return periods
+	}
+
+	var res vestingtypes.Periods
+	cliff := vestingtypes.Period{Length: cliffTime - startTime, Amount: sdk.NewCoins()}
+	end := startTime
+	for _, period := range periods {
+		end += period.Length
+		switch {
+		case end <= cliffTime:
+			cliff.Amount = cliff.Amount.Add(period.Amount...)
+		case end-period.Length < cliffTime:
+			// The period spans the cliff, so only its remainder is kept.
+			res = append(res, vestingtypes.Period{Length: end - cliffTime, Amount: period.Amount})
+		default:
+			res = append(res, period)
+		}
+	}
+	if cliff.Amount.IsZero() {
+		return periods
+	}
+	return append(vestingtypes.Periods{cliff}, res...)
+}
@@ -5,6 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	types "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -30,6 +33,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type ClawbackContinuousVestingAccount struct {
 	*types.ContinuousVestingAccount `protobuf:"bytes,1,opt,name=vesting_account,json=vestingAccount,proto3,embedded=vesting_account" json:"vesting_account,omitempty"`
 	FunderAddress                   string `protobuf:"bytes,2,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// grants lists the schedules of all grants once a grant has been added
+	// to the account. The account vests as its embedded continuous vesting
+	// account as long as it is empty.
+	Grants []ContinuousGrant `protobuf:"bytes,3,rep,name=grants,proto3" json:"grants"`
 }
 
 func (m *ClawbackContinuousVestingAccount) Reset()      { *m = ClawbackContinuousVestingAccount{} }
@@ -64,8 +71,112 @@ func (m *ClawbackContinuousVestingAccount) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ClawbackContinuousVestingAccount proto.InternalMessageInfo
 
+// ContinuousGrant is a grant of coins vesting linearly from its start time
+// until its end time.
+type ContinuousGrant struct {
+	StartTime int64                                    `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   int64                                    `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *ContinuousGrant) Reset()         { *m = ContinuousGrant{} }
+func (m *ContinuousGrant) String() string { return proto.CompactTextString(m) }
+func (*ContinuousGrant) ProtoMessage()    {}
+func (*ContinuousGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2e41653215b022f, []int{1}
+}
+func (m *ContinuousGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContinuousGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContinuousGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContinuousGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContinuousGrant.Merge(m, src)
+}
+func (m *ContinuousGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContinuousGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContinuousGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContinuousGrant proto.InternalMessageInfo
+
+func (m *ContinuousGrant) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *ContinuousGrant) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *ContinuousGrant) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// ClawbackPeriodicVestingAccount implements the VestingAccount interface.
+// It wraps a PeriodicVestingAccount provided by Cosmos SDK to provide
+// additional support for clawback.
+type ClawbackPeriodicVestingAccount struct {
+	*types.PeriodicVestingAccount `protobuf:"bytes,1,opt,name=vesting_account,json=vestingAccount,proto3,embedded=vesting_account" json:"vesting_account,omitempty"`
+	FunderAddress                 string `protobuf:"bytes,2,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+}
+
+func (m *ClawbackPeriodicVestingAccount) Reset()      { *m = ClawbackPeriodicVestingAccount{} }
+func (*ClawbackPeriodicVestingAccount) ProtoMessage() {}
+func (*ClawbackPeriodicVestingAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2e41653215b022f, []int{2}
+}
+func (m *ClawbackPeriodicVestingAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClawbackPeriodicVestingAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClawbackPeriodicVestingAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClawbackPeriodicVestingAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClawbackPeriodicVestingAccount.Merge(m, src)
+}
+func (m *ClawbackPeriodicVestingAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClawbackPeriodicVestingAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClawbackPeriodicVestingAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClawbackPeriodicVestingAccount proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*ClawbackContinuousVestingAccount)(nil), "sedachain.vesting.v1.ClawbackContinuousVestingAccount")
+	proto.RegisterType((*ContinuousGrant)(nil), "sedachain.vesting.v1.ContinuousGrant")
+	proto.RegisterType((*ClawbackPeriodicVestingAccount)(nil), "sedachain.vesting.v1.ClawbackPeriodicVestingAccount")
 }
 
 func init() {
@@ -73,24 +184,38 @@ func init() {
 }
 
 var fileDescriptor_f2e41653215b022f = []byte{
-	// 272 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2a, 0x4e, 0x4d, 0x49,
-	0x4c, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x2f, 0x4b, 0x2d, 0x2e, 0xc9, 0xcc, 0x4b, 0xd7, 0x2f, 0x33,
-	0x84, 0x31, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x44, 0xe0, 0x6a, 0xf4, 0x60, 0x12, 0x65,
-	0x86, 0x52, 0x2a, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0x48, 0xda, 0x92, 0x52, 0x4b, 0x12, 0xd1,
-	0xf4, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x99, 0xfa, 0x20, 0x16, 0x44, 0x54, 0x69, 0x0b,
-	0x23, 0x97, 0x82, 0x73, 0x4e, 0x62, 0x79, 0x52, 0x62, 0x72, 0xb6, 0x73, 0x7e, 0x5e, 0x49, 0x66,
-	0x5e, 0x69, 0x7e, 0x69, 0x71, 0x18, 0x44, 0xa7, 0x63, 0x72, 0x72, 0x7e, 0x69, 0x5e, 0x89, 0x50,
-	0x3c, 0x17, 0x3f, 0xd4, 0xac, 0xf8, 0x44, 0x88, 0x90, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91,
-	0x81, 0x1e, 0xc4, 0x6a, 0x24, 0xd7, 0x80, 0xad, 0xd6, 0xc3, 0x65, 0x94, 0x13, 0xcb, 0x85, 0x7b,
-	0xf2, 0x8c, 0x41, 0x7c, 0x65, 0xa8, 0x16, 0xa8, 0x72, 0xf1, 0xa5, 0x95, 0xe6, 0xa5, 0xa4, 0x16,
-	0xc5, 0x27, 0xa6, 0xa4, 0x14, 0xa5, 0x16, 0x17, 0x4b, 0x30, 0x29, 0x30, 0x6a, 0x70, 0x06, 0xf1,
-	0x42, 0x44, 0x1d, 0x21, 0x82, 0x56, 0x1c, 0x1d, 0x0b, 0xe4, 0x19, 0x66, 0x2c, 0x90, 0x67, 0x70,
-	0xf2, 0x39, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c,
-	0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xa3, 0xf4, 0xcc, 0x92,
-	0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0x50, 0x68, 0x81, 0xbd, 0x99, 0x9c, 0x9f, 0x03,
-	0xe6, 0xe8, 0x42, 0xc2, 0xb7, 0x02, 0x1e, 0x54, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60,
-	0x45, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x63, 0xa3, 0x68, 0xd1, 0x83, 0x01, 0x00, 0x00,
+	// 481 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xf6, 0x35, 0x55, 0x68, 0xaf, 0xd0, 0x0a, 0xab, 0x43, 0x1a, 0x09, 0x3b, 0x8a, 0xa8, 0x14,
+	0x55, 0xaa, 0x8f, 0x84, 0x8d, 0xad, 0x89, 0x04, 0x0b, 0x03, 0xb2, 0x10, 0x03, 0x12, 0xb2, 0xce,
+	0x77, 0x87, 0x7b, 0x6a, 0x7c, 0x57, 0xf9, 0xce, 0x86, 0xfc, 0x03, 0x46, 0x46, 0xc4, 0xd4, 0x11,
+	0x31, 0xe5, 0x57, 0xa0, 0x8e, 0x19, 0x99, 0x0a, 0x4a, 0x86, 0xfe, 0x06, 0x36, 0xe4, 0x3b, 0xa7,
+	0x75, 0xab, 0x66, 0xea, 0x62, 0x9f, 0xbf, 0xf7, 0xde, 0xf7, 0xde, 0x7d, 0xdf, 0x33, 0xec, 0x2a,
+	0x46, 0x31, 0x39, 0xc6, 0x5c, 0xa0, 0x82, 0x29, 0xcd, 0x45, 0x82, 0x8a, 0xfe, 0xf2, 0x18, 0x9c,
+	0x66, 0x52, 0x4b, 0x77, 0xf7, 0x2a, 0x27, 0x58, 0x06, 0x8a, 0x7e, 0xdb, 0x23, 0x52, 0xa5, 0x52,
+	0xa1, 0x18, 0x2b, 0x86, 0x8a, 0x7e, 0xcc, 0x34, 0xee, 0x23, 0x22, 0xb9, 0xb0, 0x55, 0xed, 0xa7,
+	0x55, 0xfc, 0x9a, 0xd6, 0xa6, 0xdc, 0xe0, 0x6e, 0xef, 0x26, 0x32, 0x91, 0xe6, 0x88, 0xca, 0x53,
+	0x85, 0x3e, 0xc6, 0x29, 0x17, 0x12, 0x99, 0xa7, 0x85, 0xba, 0xff, 0x00, 0xec, 0x8c, 0xc6, 0xf8,
+	0x53, 0x8c, 0xc9, 0xc9, 0x48, 0x0a, 0xcd, 0x45, 0x2e, 0x73, 0xf5, 0xce, 0x92, 0x1d, 0x11, 0x22,
+	0x73, 0xa1, 0xdd, 0x08, 0xee, 0x54, 0xf4, 0x11, 0xb6, 0x50, 0x0b, 0x74, 0x40, 0x6f, 0x6b, 0xf0,
+	0x2c, 0xb0, 0xd3, 0xd4, 0x2e, 0x60, 0xa6, 0x09, 0x56, 0x51, 0x0d, 0xd7, 0x67, 0x17, 0x3e, 0x08,
+	0xb7, 0x8b, 0x9b, 0x0d, 0xf6, 0xe1, 0xf6, 0xc7, 0x5c, 0x50, 0x96, 0x45, 0x98, 0xd2, 0x8c, 0x29,
+	0xd5, 0x5a, 0xeb, 0x80, 0xde, 0x66, 0xf8, 0xc8, 0xa2, 0x47, 0x16, 0x74, 0x47, 0xb0, 0x99, 0x64,
+	0x58, 0x68, 0xd5, 0x6a, 0x74, 0x1a, 0xbd, 0xad, 0xc1, 0x7e, 0x70, 0x97, 0x84, 0xb5, 0xe6, 0xaf,
+	0xca, 0xec, 0xe1, 0xfa, 0xf9, 0x85, 0xef, 0x84, 0x55, 0xe9, 0x8b, 0x8d, 0x2f, 0x67, 0xbe, 0xf3,
+	0xed, 0xcc, 0x77, 0xba, 0xbf, 0x00, 0xdc, 0xb9, 0x95, 0xeb, 0x3e, 0x81, 0x50, 0x69, 0x9c, 0xe9,
+	0x48, 0xf3, 0x94, 0x99, 0x5b, 0x36, 0xc2, 0x4d, 0x83, 0xbc, 0xe5, 0x29, 0x73, 0xf7, 0xe0, 0x06,
+	0x13, 0xd4, 0x06, 0xd7, 0x4c, 0xf0, 0x01, 0x13, 0xd4, 0x84, 0x26, 0xb0, 0x89, 0x53, 0xa3, 0x8d,
+	0x1d, 0x6e, 0x6f, 0xa9, 0x4d, 0xe9, 0x64, 0x4d, 0x18, 0x2e, 0x86, 0x2f, 0xcb, 0x81, 0x7e, 0xfe,
+	0xf1, 0x7b, 0x09, 0xd7, 0xc7, 0x79, 0x1c, 0x10, 0x99, 0xa2, 0xca, 0x56, 0xfb, 0x3a, 0x54, 0xf4,
+	0x04, 0xe9, 0xc9, 0x29, 0x53, 0xa6, 0x40, 0x7d, 0xbf, 0x9c, 0x1e, 0x3c, 0x1c, 0xb3, 0x04, 0x93,
+	0x49, 0x54, 0xee, 0x82, 0xfa, 0x71, 0x39, 0x3d, 0x00, 0x61, 0xd5, 0xb0, 0x3b, 0x05, 0xd0, 0x5b,
+	0x9a, 0xf8, 0x86, 0x65, 0x5c, 0x52, 0x4e, 0x6e, 0x59, 0xf8, 0x61, 0x95, 0x85, 0xc1, 0x2a, 0x0b,
+	0xef, 0x26, 0xba, 0x97, 0x81, 0xd7, 0xda, 0x0f, 0x5f, 0x9f, 0xcf, 0x3d, 0x30, 0x9b, 0x7b, 0xe0,
+	0xef, 0xdc, 0x03, 0x5f, 0x17, 0x9e, 0x33, 0x5b, 0x78, 0xce, 0xef, 0x85, 0xe7, 0xbc, 0x1f, 0xd4,
+	0x44, 0x29, 0xed, 0x35, 0x7b, 0x4a, 0xe4, 0xd8, 0x7c, 0x1c, 0xda, 0x7f, 0xea, 0xf3, 0xd5, 0xfa,
+	0x1b, 0x91, 0xe2, 0xa6, 0x49, 0x7a, 0xfe, 0x7f, 0x00, 0x1c, 0xf6, 0xc1, 0xda, 0x77, 0x03, 0x00,
+	0x00,
 }
 
 func (m *ClawbackContinuousVestingAccount) Marshal() (dAtA []byte, err error) {
@@ -113,6 +238,20 @@ func (m *ClawbackContinuousVestingAccount) MarshalToSizedBuffer(dAtA []byte) (in
 	_ = i
 	var l int
 	_ = l
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.FunderAddress) > 0 {
 		i -= len(m.FunderAddress)
 		copy(dAtA[i:], m.FunderAddress)
@@ -135,6 +274,95 @@ func (m *ClawbackContinuousVestingAccount) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *ContinuousGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContinuousGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContinuousGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.EndTime != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClawbackPeriodicVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClawbackPeriodicVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClawbackPeriodicVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PeriodicVestingAccount != nil {
+		{
+			size, err := m.PeriodicVestingAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVesting(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintVesting(dAtA []byte, offset int, v uint64) int {
 	offset -= sovVesting(v)
 	base := offset
@@ -160,6 +388,50 @@ func (m *ClawbackContinuousVestingAccount) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovVesting(uint64(l))
 	}
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContinuousGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartTime != 0 {
+		n += 1 + sovVesting(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovVesting(uint64(m.EndTime))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClawbackPeriodicVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PeriodicVestingAccount != nil {
+		l = m.PeriodicVestingAccount.Size()
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
 	return n
 }
 
@@ -266,6 +538,280 @@ func (m *ClawbackContinuousVestingAccount) Unmarshal(dAtA []byte) error {
 			}
 			m.FunderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, ContinuousGrant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContinuousGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContinuousGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContinuousGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClawbackPeriodicVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClawbackPeriodicVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClawbackPeriodicVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodicVestingAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PeriodicVestingAccount == nil {
+				m.PeriodicVestingAccount = &types.PeriodicVestingAccount{}
+			}
+			if err := m.PeriodicVestingAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVesting(dAtA[iNdEx:])
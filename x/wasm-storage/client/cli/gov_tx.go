@@ -8,7 +8,6 @@ import (
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
-	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -33,6 +32,9 @@ const (
 	flagAdmin     = "admin"
 	flagNoAdmin   = "no-admin"
 	flagFixMsg    = "fix-msg"
+
+	flagActivationHeight = "activation-height"
+	flagProxyName        = "name"
 )
 
 func SubmitProposalCmd() *cobra.Command {
@@ -43,7 +45,14 @@ func SubmitProposalCmd() *cobra.Command {
 	}
 	cmd.AddCommand(
 		ProposalStoreOverlayCmd(),
+		ProposalRemoveOverlayCmd(),
+		ProposalRemoveDataRequestCmd(),
+		ProposalActivateOverlayCmd(),
+		ProposalRollbackOverlayCmd(),
 		ProposalInstantiateAndRegisterProxyContract(),
+		ProposalRegisterProxyContractCmd(),
+		ProposalUpdateProxyContractCmd(),
+		ProposalRemoveProxyContractCmd(),
 	)
 	return cmd
 }
@@ -91,11 +100,183 @@ func ProposalStoreOverlayCmd() *cobra.Command {
 	return cmd
 }
 
+func ProposalRemoveOverlayCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-overlay-wasm [hash] --title [text] --summary [text] --authority [address]",
+		Short: "Submit a proposal to remove an Overlay Wasm",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, err := getProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return fmt.Errorf("authority: %s", err)
+			}
+			if len(authority) == 0 {
+				return errors.New("authority address is required")
+			}
+
+			src := &types.MsgRemoveOverlayWasm{
+				Authority: authority,
+				Hash:      args[0],
+			}
+			if err := src.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposalMsg, err := govv1.NewMsgSubmitProposal([]sdk.Msg{src}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, false)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+		SilenceUsage: true,
+	}
+
+	addCommonProposalFlags(cmd)
+	return cmd
+}
+
+func ProposalRemoveDataRequestCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-data-request-wasm [hash] --title [text] --summary [text] --authority [address]",
+		Short: "Submit a proposal to remove a Data Request Wasm",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, err := getProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return fmt.Errorf("authority: %s", err)
+			}
+			if len(authority) == 0 {
+				return errors.New("authority address is required")
+			}
+
+			src := &types.MsgRemoveDataRequestWasm{
+				Authority: authority,
+				Hash:      args[0],
+			}
+			if err := src.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposalMsg, err := govv1.NewMsgSubmitProposal([]sdk.Msg{src}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, false)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+		SilenceUsage: true,
+	}
+
+	addCommonProposalFlags(cmd)
+	return cmd
+}
+
+func ProposalActivateOverlayCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "activate-overlay-wasm [hash] --activation-height [height,optional] --title [text] --summary [text] --authority [address]",
+		Short: "Submit a proposal to activate an Overlay Wasm, optionally at a future height",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, err := getProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return fmt.Errorf("authority: %s", err)
+			}
+			if len(authority) == 0 {
+				return errors.New("authority address is required")
+			}
+
+			activationHeight, err := cmd.Flags().GetInt64(flagActivationHeight)
+			if err != nil {
+				return fmt.Errorf("activation height: %s", err)
+			}
+
+			src := &types.MsgActivateOverlayWasm{
+				Authority:        authority,
+				Hash:             args[0],
+				ActivationHeight: activationHeight,
+			}
+			if err := src.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposalMsg, err := govv1.NewMsgSubmitProposal([]sdk.Msg{src}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, false)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+		SilenceUsage: true,
+	}
+
+	cmd.Flags().Int64(flagActivationHeight, 0, "Block height at which the Overlay Wasm becomes active. Zero activates it upon proposal execution")
+	addCommonProposalFlags(cmd)
+	return cmd
+}
+
+func ProposalRollbackOverlayCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rollback-overlay-wasm [wasm_type] --title [text] --summary [text] --authority [address]",
+		Short: "Submit a proposal to restore the previously active Overlay Wasm of a given type",
+		Long:  "Submit a proposal to restore the previously active Overlay Wasm of a given type: data-request-executor or relayer",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, err := getProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return fmt.Errorf("authority: %s", err)
+			}
+			if len(authority) == 0 {
+				return errors.New("authority address is required")
+			}
+
+			src := &types.MsgRollbackOverlayWasm{
+				Authority: authority,
+				WasmType:  types.WasmTypeFromString(args[0]),
+			}
+			if err := src.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposalMsg, err := govv1.NewMsgSubmitProposal([]sdk.Msg{src}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, false)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+		SilenceUsage: true,
+	}
+
+	addCommonProposalFlags(cmd)
+	return cmd
+}
+
 func ProposalInstantiateAndRegisterProxyContract() *cobra.Command {
 	decoder := newArgDecoder(hex.DecodeString)
 	cmd := &cobra.Command{
 		Use: "instantiate-and-register-proxy-contract [code_id_int64] [json_encoded_init_args] [salt] --label [text] --admin [address,optional] --amount [coins,optional] " +
-			"--fix-msg [bool,optional]",
+			"--fix-msg [bool,optional] --name [text,optional]",
 		Short: "Submit a proposal to instantiate a proxy contract and register its address",
 		Args:  cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -136,22 +317,152 @@ func ProposalInstantiateAndRegisterProxyContract() *cobra.Command {
 	cmd.Flags().String(flagAdmin, "", "Address or key name of an admin")
 	cmd.Flags().Bool(flagNoAdmin, false, "You must set this explicitly if you don't want an admin")
 	cmd.Flags().Bool(flagFixMsg, false, "An optional flag to include the json_encoded_init_args for the predictable address generation mode")
+	cmd.Flags().String(flagProxyName, types.DefaultProxyContractName, "Name of the Proxy Contract registry entry under which the contract is registered")
 	decoder.RegisterFlags(cmd.PersistentFlags(), "salt")
 	addCommonProposalFlags(cmd)
 
 	return cmd
 }
 
-func parseStoreOverlayArgs(file, sender string, _ *flag.FlagSet) (*types.MsgStoreOverlayWasm, error) {
+func ProposalRegisterProxyContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-proxy-contract [name] [address] --title [text] --summary [text] --authority [address]",
+		Short: "Submit a proposal to add a named entry to the Proxy Contract registry",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, err := getProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return fmt.Errorf("authority: %s", err)
+			}
+			if len(authority) == 0 {
+				return errors.New("authority address is required")
+			}
+
+			src := &types.MsgRegisterProxyContract{
+				Authority: authority,
+				Name:      args[0],
+				Address:   args[1],
+			}
+			if err := src.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposalMsg, err := govv1.NewMsgSubmitProposal([]sdk.Msg{src}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, false)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+		SilenceUsage: true,
+	}
+
+	addCommonProposalFlags(cmd)
+	return cmd
+}
+
+func ProposalUpdateProxyContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-proxy-contract [name] [address] --title [text] --summary [text] --authority [address]",
+		Short: "Submit a proposal to change the address of a named entry of the Proxy Contract registry",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, err := getProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return fmt.Errorf("authority: %s", err)
+			}
+			if len(authority) == 0 {
+				return errors.New("authority address is required")
+			}
+
+			src := &types.MsgUpdateProxyContract{
+				Authority: authority,
+				Name:      args[0],
+				Address:   args[1],
+			}
+			if err := src.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposalMsg, err := govv1.NewMsgSubmitProposal([]sdk.Msg{src}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, false)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+		SilenceUsage: true,
+	}
+
+	addCommonProposalFlags(cmd)
+	return cmd
+}
+
+func ProposalRemoveProxyContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-proxy-contract [name] --title [text] --summary [text] --authority [address]",
+		Short: "Submit a proposal to remove a named entry from the Proxy Contract registry",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, err := getProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return fmt.Errorf("authority: %s", err)
+			}
+			if len(authority) == 0 {
+				return errors.New("authority address is required")
+			}
+
+			src := &types.MsgRemoveProxyContract{
+				Authority: authority,
+				Name:      args[0],
+			}
+			if err := src.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposalMsg, err := govv1.NewMsgSubmitProposal([]sdk.Msg{src}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, false)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+		SilenceUsage: true,
+	}
+
+	addCommonProposalFlags(cmd)
+	return cmd
+}
+
+func parseStoreOverlayArgs(file, sender string, flags *flag.FlagSet) (*types.MsgStoreOverlayWasm, error) {
 	zipped, err := gzipWasmFile(file)
 	if err != nil {
 		return nil, err
 	}
+	wasmType, err := flags.GetString(FlagWasmType)
+	if err != nil {
+		return nil, err
+	}
 
 	msg := &types.MsgStoreOverlayWasm{
 		Sender:   sender,
 		Wasm:     zipped,
-		WasmType: types.WasmTypeFromString(viper.GetString(FlagWasmType)),
+		WasmType: types.WasmTypeFromString(wasmType),
 	}
 	return msg, nil
 }
@@ -217,6 +528,11 @@ func parseInstantiateAndRegisterProxyContractArgs(rawCodeID, initMsg string, kr
 		return nil, fmt.Errorf("fix msg: %w", err)
 	}
 
+	name, err := flags.GetString(flagProxyName)
+	if err != nil {
+		return nil, fmt.Errorf("name: %w", err)
+	}
+
 	msg := types.MsgInstantiateAndRegisterProxyContract{
 		Sender: sender,
 		CodeID: codeID,
@@ -225,6 +541,7 @@ func parseInstantiateAndRegisterProxyContractArgs(rawCodeID, initMsg string, kr
 		Msg:    []byte(initMsg),
 		Admin:  adminStr,
 		FixMsg: fixMsg,
+		Name:   name,
 	}
 	return &msg, nil
 }
@@ -1,8 +1,12 @@
 package cli
 
 import (
+	"encoding/hex"
 	"fmt"
+	"os"
+	"strings"
 
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -11,6 +15,10 @@ import (
 	"github.com/sedaprotocol/seda-chain/x/wasm-storage/types"
 )
 
+const (
+	FlagOut = "out"
+)
+
 // GetQueryCmd returns the CLI query commands for this module
 func GetQueryCmd(_ string) *cobra.Command {
 	cmd := &cobra.Command{
@@ -26,7 +34,11 @@ func GetQueryCmd(_ string) *cobra.Command {
 		GetCmdQueryOverlayWasm(),
 		GetCmdQueryDataRequestWasms(),
 		GetCmdQueryOverlayWasms(),
+		GetCmdDownloadWasm(),
+		GetCmdQueryActiveOverlay(),
 		GetCmdQueryProxyContractRegistry(),
+		GetCmdQueryProxyContracts(),
+		GetCmdQueryProxyContractHistory(),
 	)
 	return cmd
 }
@@ -139,12 +151,98 @@ func GetCmdQueryOverlayWasms() *cobra.Command {
 	return cmd
 }
 
+// GetCmdDownloadWasm returns the command for downloading the bytecode
+// of a Data Request or Overlay Wasm to a file. The bytecode is fetched in
+// chunks and checked against the given hash before it is written.
+func GetCmdDownloadWasm() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "download <hash>",
+		Short: "Download the bytecode of a Data Request or Overlay Wasm given its hash",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			out, err := cmd.Flags().GetString(FlagOut)
+			if err != nil {
+				return err
+			}
+
+			var bytecode []byte
+			var wasmType types.WasmType
+			for {
+				res, err := queryClient.WasmBytecode(cmd.Context(), &types.QueryWasmBytecodeRequest{
+					Hash:   args[0],
+					Offset: uint64(len(bytecode)),
+				})
+				if err != nil {
+					return err
+				}
+				bytecode = append(bytecode, res.Chunk...)
+				wasmType = res.WasmType
+				if uint64(len(bytecode)) >= res.TotalSize || len(res.Chunk) == 0 {
+					break
+				}
+			}
+
+			// The bytecode is only written once its hash matches the
+			// requested one, which also catches incomplete downloads.
+			if hash := hex.EncodeToString(crypto.Keccak256(bytecode)); !strings.EqualFold(hash, args[0]) {
+				return fmt.Errorf("hash mismatch; expected %s, got %s", args[0], hash)
+			}
+			if err := os.WriteFile(out, bytecode, 0o600); err != nil {
+				return err
+			}
+			return clientCtx.PrintString(fmt.Sprintf("wrote %d bytes of %s Wasm to %s\n", len(bytecode), wasmType, out))
+		},
+	}
+
+	cmd.Flags().String(FlagOut, "", "File to write the bytecode to")
+	if err := cmd.MarkFlagRequired(FlagOut); err != nil {
+		panic(err)
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryActiveOverlay returns the command for querying the active,
+// scheduled, and previously active versions of an Overlay Wasm type.
+func GetCmdQueryActiveOverlay() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "active-overlay <wasm_type>",
+		Short: "Get the active version of an Overlay Wasm type: data-request-executor or relayer",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryActiveOverlayRequest{
+				WasmType: types.WasmTypeFromString(args[0]),
+			}
+			res, err := queryClient.ActiveOverlay(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryProxyContractRegistry returns the command for querying
 // Proxy Contract registry.
 func GetCmdQueryProxyContractRegistry() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "proxy-contract-registry",
-		Short: "Get the address of Proxy Contract",
+		Short: "Get the address of the data request Proxy Contract",
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -167,3 +265,61 @@ func GetCmdQueryProxyContractRegistry() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryProxyContracts returns the command for querying all entries
+// of the Proxy Contract registry.
+func GetCmdQueryProxyContracts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proxy-contracts",
+		Short: "List all named Proxy Contracts",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ProxyContracts(
+				cmd.Context(),
+				&types.QueryProxyContractsRequest{},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryProxyContractHistory returns the command for querying the
+// changes made to a named Proxy Contract.
+func GetCmdQueryProxyContractHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proxy-contract-history <name>",
+		Short: "Get the changes made to a named Proxy Contract, starting from the most recent one",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ProxyContractHistory(
+				cmd.Context(),
+				&types.QueryProxyContractHistoryRequest{Name: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
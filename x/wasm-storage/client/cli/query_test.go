@@ -0,0 +1,102 @@
+package cli_test
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	wasmstorage "github.com/sedaprotocol/seda-chain/x/wasm-storage"
+	"github.com/sedaprotocol/seda-chain/x/wasm-storage/client/cli"
+	"github.com/sedaprotocol/seda-chain/x/wasm-storage/types"
+)
+
+// bytecodeRPC serves a given bytecode in chunks of a given size to
+// WasmBytecode queries.
+type bytecodeRPC struct {
+	clitestutil.MockCometRPC
+	bytecode  []byte
+	chunkSize int
+}
+
+func (m bytecodeRPC) ABCIQueryWithOptions(_ context.Context, _ string, data cmtbytes.HexBytes, _ rpcclient.ABCIQueryOptions) (*coretypes.ResultABCIQuery, error) {
+	var req types.QueryWasmBytecodeRequest
+	if err := req.Unmarshal(data); err != nil {
+		return nil, err
+	}
+	start := min(int(req.Offset), len(m.bytecode))
+	end := min(start+m.chunkSize, len(m.bytecode))
+	res := types.QueryWasmBytecodeResponse{
+		Chunk:     m.bytecode[start:end],
+		Offset:    req.Offset,
+		TotalSize: uint64(len(m.bytecode)),
+		WasmType:  types.WasmTypeTally,
+	}
+	bz, err := res.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return &coretypes.ResultABCIQuery{Response: abci.ResponseQuery{Value: bz}}, nil
+}
+
+func TestDownloadWasm(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(wasmstorage.AppModuleBasic{})
+	bytecode := []byte("a Wasm binary that is downloaded in several chunks")
+	hash := hex.EncodeToString(crypto.Keccak256(bytecode))
+
+	testCases := []struct {
+		name      string
+		served    []byte
+		expErrMsg string
+	}{
+		{
+			name:   "multi-chunk download",
+			served: bytecode,
+		},
+		{
+			name:      "hash mismatch",
+			served:    append([]byte("tampered"), bytecode[8:]...),
+			expErrMsg: "hash mismatch",
+		},
+		{
+			name:      "incomplete download",
+			served:    bytecode[:10],
+			expErrMsg: "hash mismatch",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out := filepath.Join(t.TempDir(), "download.wasm")
+			clientCtx := client.Context{}.
+				WithCodec(encCfg.Codec).
+				WithInterfaceRegistry(encCfg.InterfaceRegistry).
+				WithClient(bytecodeRPC{bytecode: tc.served, chunkSize: 8})
+
+			res, err := clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdDownloadWasm(), []string{hash, "--" + cli.FlagOut, out})
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+				require.NoFileExists(t, out)
+				return
+			}
+			require.NoError(t, err)
+			require.Contains(t, res.String(), fmt.Sprintf("wrote %d bytes of WASM_TYPE_TALLY Wasm", len(bytecode)))
+			written, err := os.ReadFile(out)
+			require.NoError(t, err)
+			require.Equal(t, bytecode, written)
+		})
+	}
+}
@@ -1,21 +1,34 @@
 package cli
 
 import (
+	"bufio"
+	"encoding/hex"
 	"fmt"
 	"os"
+	"time"
 
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 
 	"github.com/CosmWasm/wasmd/x/wasm/ioutils"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 
 	"github.com/sedaprotocol/seda-chain/x/wasm-storage/types"
 )
 
-const FlagWasmType = "wasm-type"
+const (
+	FlagWasmType  = "wasm-type"
+	FlagChunkSize = "chunk-size"
+
+	// uploadTimeout is how long the chunked upload waits for each of
+	// its transactions to be committed.
+	uploadTimeout = time.Minute
+)
 
 // GetTxCmd returns the CLI transaction commands for this module
 func GetTxCmd() *cobra.Command {
@@ -35,6 +48,8 @@ func GetTxCmd() *cobra.Command {
 }
 
 // GetCmdStoreDataRequestWasm returns the command for storing Data Request Wasm.
+// Wasm files whose compressed size exceeds the chunk size are uploaded in
+// chunks over multiple transactions.
 func GetCmdStoreDataRequestWasm() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "store-data-request-wasm [wasm file]",
@@ -46,21 +61,42 @@ func GetCmdStoreDataRequestWasm() *cobra.Command {
 				return err
 			}
 
-			wasm, err := gzipWasmFile(args[0])
+			bytecode, err := readWasmFile(args[0])
+			if err != nil {
+				return err
+			}
+			wasm, err := ioutils.GzipIt(bytecode)
+			if err != nil {
+				return err
+			}
+			wasmType, err := cmd.Flags().GetString(FlagWasmType)
+			if err != nil {
+				return err
+			}
+
+			chunkSize, err := cmd.Flags().GetInt(FlagChunkSize)
 			if err != nil {
 				return err
 			}
+			if chunkSize <= 0 || chunkSize > types.MaxWasmUploadChunkSize {
+				return fmt.Errorf("chunk size must be between 1 and %d bytes", types.MaxWasmUploadChunkSize)
+			}
+			if len(wasm) > chunkSize {
+				msgs := splitWasmUpload(clientCtx.GetFromAddress().String(), bytecode, wasm, types.WasmTypeFromString(wasmType), chunkSize)
+				return broadcastSequentially(clientCtx, cmd, msgs)
+			}
 
 			msg := &types.MsgStoreDataRequestWasm{
 				Sender:   clientCtx.GetFromAddress().String(),
 				Wasm:     wasm,
-				WasmType: types.WasmTypeFromString(viper.GetString(FlagWasmType)),
+				WasmType: types.WasmTypeFromString(wasmType),
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	cmd.Flags().String(FlagWasmType, "", "Data Request Wasm type: data-request or tally")
+	cmd.Flags().Int(FlagChunkSize, types.MaxWasmUploadChunkSize, "Maximum number of compressed bytes per transaction")
 	err := cmd.MarkFlagRequired(FlagWasmType)
 	if err != nil {
 		panic(err)
@@ -69,7 +105,134 @@ func GetCmdStoreDataRequestWasm() *cobra.Command {
 	return cmd
 }
 
-func gzipWasmFile(filename string) ([]byte, error) {
+// splitWasmUpload returns the messages of a chunked upload of a given
+// compressed Wasm.
+func splitWasmUpload(sender string, bytecode, wasm []byte, wasmType types.WasmType, chunkSize int) []sdk.Msg {
+	hash := hex.EncodeToString(crypto.Keccak256(bytecode))
+	msgs := []sdk.Msg{
+		&types.MsgBeginWasmUpload{
+			Sender:     sender,
+			Hash:       hash,
+			UploadSize: uint64(len(wasm)),
+			WasmType:   wasmType,
+		},
+	}
+	for i := 0; i*chunkSize < len(wasm); i++ {
+		end := min((i+1)*chunkSize, len(wasm))
+		msgs = append(msgs, &types.MsgUploadWasmChunk{
+			Sender: sender,
+			Hash:   hash,
+			Index:  uint64(i),
+			Chunk:  wasm[i*chunkSize : end],
+		})
+	}
+	return append(msgs, &types.MsgFinalizeWasmUpload{
+		Sender: sender,
+		Hash:   hash,
+	})
+}
+
+// broadcastSequentially generates or broadcasts one transaction per
+// given message. Since each message depends on the state left by the
+// previous one, it waits for each transaction to be committed and fails
+// as soon as one of them is rejected or fails.
+func broadcastSequentially(clientCtx client.Context, cmd *cobra.Command, msgs []sdk.Msg) error {
+	txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+	if err != nil {
+		return err
+	}
+	if clientCtx.GenerateOnly || clientCtx.Offline {
+		for i, msg := range msgs {
+			if err := tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf.WithSequence(txf.Sequence()+uint64(i)), msg); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if !clientCtx.SkipConfirm {
+		buf := bufio.NewReader(os.Stdin)
+		prompt := fmt.Sprintf("confirm signing and broadcasting %d transactions", len(msgs))
+		ok, err := input.GetConfirmation(prompt, buf, os.Stderr)
+		if err != nil || !ok {
+			_, _ = fmt.Fprintln(os.Stderr, "canceled upload")
+			return err
+		}
+	}
+
+	txf, err = txf.Prepare(clientCtx)
+	if err != nil {
+		return err
+	}
+	for i, msg := range msgs {
+		res, err := broadcastTx(clientCtx, txf.WithSequence(txf.Sequence()+uint64(i)), msg)
+		if err != nil {
+			return err
+		}
+		if err := clientCtx.PrintProto(res); err != nil {
+			return err
+		}
+		if res.Code != 0 {
+			return fmt.Errorf("transaction %s was rejected with code %d: %s", res.TxHash, res.Code, res.RawLog)
+		}
+		if err := waitForTx(clientCtx, res.TxHash); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// broadcastTx signs and broadcasts a transaction with a given message
+// and returns the response of the node.
+func broadcastTx(clientCtx client.Context, txf tx.Factory, msg sdk.Msg) (*sdk.TxResponse, error) {
+	if m, ok := msg.(sdk.HasValidateBasic); ok {
+		if err := m.ValidateBasic(); err != nil {
+			return nil, err
+		}
+	}
+	if txf.SimulateAndExecute() {
+		_, adjusted, err := tx.CalculateGas(clientCtx, txf, msg)
+		if err != nil {
+			return nil, err
+		}
+		txf = txf.WithGas(adjusted)
+	}
+
+	builder, err := txf.BuildUnsignedTx(msg)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Sign(clientCtx.CmdContext, txf, clientCtx.FromName, builder, true); err != nil {
+		return nil, err
+	}
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(builder.GetTx())
+	if err != nil {
+		return nil, err
+	}
+	return clientCtx.BroadcastTx(txBytes)
+}
+
+// waitForTx waits until a transaction with a given hash is committed
+// and checks that it succeeded.
+func waitForTx(clientCtx client.Context, hash string) error {
+	deadline := time.Now().Add(uploadTimeout)
+	for time.Now().Before(deadline) {
+		res, err := authtx.QueryTx(clientCtx, hash)
+		if err != nil {
+			// the transaction is not committed yet
+			time.Sleep(time.Second)
+			continue
+		}
+		if res.Code != 0 {
+			return fmt.Errorf("transaction %s failed with code %d: %s", hash, res.Code, res.RawLog)
+		}
+		return nil
+	}
+	return fmt.Errorf("transaction %s was not committed within %s", hash, uploadTimeout)
+}
+
+// readWasmFile reads a given uncompressed Wasm file.
+func readWasmFile(filename string) ([]byte, error) {
 	wasm, err := os.ReadFile(filename)
 	if err != nil {
 		return nil, err
@@ -78,6 +241,14 @@ func gzipWasmFile(filename string) ([]byte, error) {
 	if !ioutils.IsWasm(wasm) {
 		return nil, fmt.Errorf("invalid Wasm file")
 	}
+	return wasm, nil
+}
+
+func gzipWasmFile(filename string) ([]byte, error) {
+	wasm, err := readWasmFile(filename)
+	if err != nil {
+		return nil, err
+	}
 
 	zipped, err := ioutils.GzipIt(wasm)
 	if err != nil {
@@ -0,0 +1,132 @@
+package cli_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/ioutils"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	wasmstorage "github.com/sedaprotocol/seda-chain/x/wasm-storage"
+	"github.com/sedaprotocol/seda-chain/x/wasm-storage/client/cli"
+)
+
+// uploadRPC records the broadcast transactions and commits them right
+// away with given CheckTx and DeliverTx result codes.
+type uploadRPC struct {
+	clitestutil.MockCometRPC
+	checkCode uint32
+	execCode  uint32
+	txs       *[]cmttypes.Tx
+}
+
+func (m uploadRPC) BroadcastTxSync(_ context.Context, tx cmttypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	*m.txs = append(*m.txs, tx)
+	return &coretypes.ResultBroadcastTx{Code: m.checkCode, Hash: tx.Hash()}, nil
+}
+
+func (m uploadRPC) Tx(_ context.Context, hash []byte, _ bool) (*coretypes.ResultTx, error) {
+	for _, tx := range *m.txs {
+		if bytes.Equal(tx.Hash(), hash) {
+			return &coretypes.ResultTx{
+				Hash:     hash,
+				Height:   1,
+				Tx:       tx,
+				TxResult: abci.ExecTxResult{Code: m.execCode},
+			}, nil
+		}
+	}
+	return nil, fmt.Errorf("tx %X not found", hash)
+}
+
+func (uploadRPC) Block(context.Context, *int64) (*coretypes.ResultBlock, error) {
+	return &coretypes.ResultBlock{Block: &cmttypes.Block{}}, nil
+}
+
+func TestStoreDataRequestWasmChunked(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(wasmstorage.AppModuleBasic{})
+	kr := keyring.NewInMemory(encCfg.Codec)
+	record, _, err := kr.NewMnemonic("uploader", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	addr, err := record.GetAddress()
+	require.NoError(t, err)
+
+	wasmFile := "../../keeper/test_utils/hello-world.wasm"
+	bytecode, err := os.ReadFile(wasmFile)
+	require.NoError(t, err)
+	zipped, err := ioutils.GzipIt(bytecode)
+	require.NoError(t, err)
+	chunkSize := len(zipped)/3 + 1
+	numMsgs := 2 + (len(zipped)+chunkSize-1)/chunkSize
+
+	testCases := []struct {
+		name         string
+		checkCode    uint32
+		execCode     uint32
+		expBroadcast int
+		expErrMsg    string
+	}{
+		{
+			name:         "all transactions succeed",
+			expBroadcast: numMsgs,
+		},
+		{
+			name:         "transaction rejected by CheckTx",
+			checkCode:    5,
+			expBroadcast: 1,
+			expErrMsg:    "was rejected with code 5",
+		},
+		{
+			name:         "committed transaction failed",
+			execCode:     3,
+			expBroadcast: 1,
+			expErrMsg:    "failed with code 3",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var txs []cmttypes.Tx
+			clientCtx := client.Context{}.
+				WithCodec(encCfg.Codec).
+				WithInterfaceRegistry(encCfg.InterfaceRegistry).
+				WithTxConfig(encCfg.TxConfig).
+				WithKeyring(kr).
+				WithChainID("test").
+				WithAccountRetriever(client.TestAccountRetriever{Accounts: map[string]client.TestAccount{
+					addr.String(): {Address: addr, Num: 1, Seq: 1},
+				}}).
+				WithClient(uploadRPC{checkCode: tc.checkCode, execCode: tc.execCode, txs: &txs})
+
+			args := []string{
+				wasmFile,
+				"--" + cli.FlagWasmType, "tally",
+				"--" + cli.FlagChunkSize, fmt.Sprint(chunkSize),
+				"--" + flags.FlagFrom, "uploader",
+				"--" + flags.FlagSkipConfirmation,
+			}
+			_, err := clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdStoreDataRequestWasm(), args)
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Len(t, txs, tc.expBroadcast)
+		})
+	}
+}
@@ -1,23 +1,36 @@
 package wasmstorage
 
 import (
+	"encoding/hex"
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/sedaprotocol/seda-chain/x/wasm-storage/keeper"
 	"github.com/sedaprotocol/seda-chain/x/wasm-storage/types"
 )
 
-// InitGenesis puts all data from genesis state into store.
+// InitGenesis puts all data from genesis state into store. Missing params
+// are replaced by the default params.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	if err := k.SetParams(ctx, data.ParamsOrDefault()); err != nil {
+		panic(err)
+	}
+	for i := range data.DataRequestWasms {
+		k.SetDataRequestWasm(ctx, &data.DataRequestWasms[i])
+	}
+	for i := range data.OverlayWasms {
+		k.SetOverlayWasm(ctx, &data.OverlayWasms[i])
+	}
 	for i := range data.Wasms {
 		wasm := data.Wasms[i]
-		if wasm.WasmType == types.WasmTypeDataRequest ||
-			wasm.WasmType == types.WasmTypeTally {
+		switch {
+		case wasm.WasmType.IsDataRequest():
 			k.SetDataRequestWasm(ctx, &wasm)
-		}
-		if wasm.WasmType == types.WasmTypeDataRequestExecutor ||
-			wasm.WasmType == types.WasmTypeRelayer {
+		case wasm.WasmType.IsOverlay():
 			k.SetOverlayWasm(ctx, &wasm)
+		default:
+			panic(fmt.Sprintf("invalid type %s of Wasm %s", wasm.WasmType, hex.EncodeToString(wasm.Hash)))
 		}
 	}
 	if data.ProxyContractRegistry != "" {
@@ -25,13 +38,43 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 		if err != nil {
 			panic(err)
 		}
-		k.SetProxyContractRegistry(ctx, proxyAddr)
+		k.SetProxyContract(ctx, types.DefaultProxyContractName, proxyAddr)
+	}
+	for _, contract := range data.ProxyContracts {
+		proxyAddr, err := sdk.AccAddressFromBech32(contract.Address)
+		if err != nil {
+			panic(err)
+		}
+		k.SetProxyContract(ctx, contract.Name, proxyAddr)
+	}
+	for _, change := range data.ProxyContractHistory {
+		k.AppendProxyContractChange(ctx, change)
+	}
+	for _, version := range data.ActiveOverlays {
+		k.SetActiveOverlay(ctx, version)
+	}
+	for _, version := range data.ScheduledOverlays {
+		k.SetScheduledOverlay(ctx, version)
+	}
+	for _, version := range data.OverlayHistory {
+		k.AppendOverlayHistory(ctx, version)
 	}
 }
 
-// ExportGenesis extracts all data from store to genesis state.
+// ExportGenesis extracts all data from store to genesis state. Pending
+// chunked uploads are not exported, since their expiration heights do
+// not carry over to a new chain, so they have to be started over after
+// a genesis export and import.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
-	wasms := k.GetAllWasms(ctx)
-	proxy := k.GetProxyContractRegistry(ctx)
-	return types.NewGenesisState(wasms, proxy.String())
+	gs := types.NewGenesisState(
+		k.GetParams(ctx),
+		k.GetAllDataRequestWasms(ctx),
+		k.GetAllOverlayWasms(ctx),
+		k.GetAllProxyContracts(ctx),
+	)
+	gs.ActiveOverlays = k.GetAllActiveOverlays(ctx)
+	gs.ScheduledOverlays = k.GetAllScheduledOverlays(ctx)
+	gs.OverlayHistory = k.GetAllOverlayHistory(ctx)
+	gs.ProxyContractHistory = k.GetAllProxyContractHistory(ctx)
+	return gs
 }
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sedaprotocol/seda-chain/x/wasm-storage/types"
+)
+
+// GetBytecode returns the bytecode with a given hash or nil if it does
+// not exist.
+func (k Keeper) GetBytecode(ctx sdk.Context, hash []byte) []byte {
+	store := ctx.KVStore(k.storeKey)
+	return store.Get(types.GetBytecodeKey(hash))
+}
+
+// GetBytecodeRefCount returns the number of Data Request and Overlay
+// Wasms referencing the bytecode with a given hash.
+func (k Keeper) GetBytecodeRefCount(ctx sdk.Context, hash []byte) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetBytecodeRefCountKey(hash))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// acquireBytecode adds a reference to the bytecode with a given hash,
+// storing the bytecode if it is not referenced yet.
+func (k Keeper) acquireBytecode(ctx sdk.Context, hash, bytecode []byte) {
+	store := ctx.KVStore(k.storeKey)
+	count := k.GetBytecodeRefCount(ctx, hash)
+	if count == 0 {
+		store.Set(types.GetBytecodeKey(hash), bytecode)
+	}
+	store.Set(types.GetBytecodeRefCountKey(hash), sdk.Uint64ToBigEndian(count+1))
+}
+
+// releaseBytecode removes a reference to the bytecode with a given hash,
+// deleting the bytecode once it is no longer referenced.
+func (k Keeper) releaseBytecode(ctx sdk.Context, hash []byte) {
+	store := ctx.KVStore(k.storeKey)
+	count := k.GetBytecodeRefCount(ctx, hash)
+	if count <= 1 {
+		store.Delete(types.GetBytecodeKey(hash))
+		store.Delete(types.GetBytecodeRefCountKey(hash))
+		return
+	}
+	store.Set(types.GetBytecodeRefCountKey(hash), sdk.Uint64ToBigEndian(count-1))
+}
@@ -0,0 +1,51 @@
+package keeper_test
+
+import (
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/sedaprotocol/seda-chain/x/wasm-storage/types"
+)
+
+func (s *KeeperTestSuite) TestSharedBytecode() {
+	s.SetupTest()
+	hash := crypto.Keccak256(mockedByteArray)
+	drWasm := &types.Wasm{
+		Hash:     hash,
+		Bytecode: mockedByteArray,
+		WasmType: types.WasmTypeDataRequest,
+		Size_:    uint64(len(mockedByteArray)),
+	}
+	overlayWasm := &types.Wasm{
+		Hash:     hash,
+		Bytecode: mockedByteArray,
+		WasmType: types.WasmTypeRelayer,
+		Size_:    uint64(len(mockedByteArray)),
+	}
+
+	s.wasmStorageKeeper.SetDataRequestWasm(s.ctx, drWasm)
+	s.wasmStorageKeeper.SetOverlayWasm(s.ctx, overlayWasm)
+	s.Require().Equal(uint64(2), s.wasmStorageKeeper.GetBytecodeRefCount(s.ctx, hash))
+	s.Require().Equal(mockedByteArray, s.wasmStorageKeeper.GetBytecode(s.ctx, hash))
+
+	// Overwriting a Wasm does not add a reference.
+	s.wasmStorageKeeper.SetDataRequestWasm(s.ctx, drWasm)
+	s.Require().Equal(uint64(2), s.wasmStorageKeeper.GetBytecodeRefCount(s.ctx, hash))
+
+	// The type-specific stores only hold metadata.
+	var stored types.Wasm
+	bz := s.ctx.KVStore(s.storeKey).Get(types.GetDataRequestWasmKey(hash))
+	s.Require().NoError(stored.Unmarshal(bz))
+	s.Require().Empty(stored.Bytecode)
+
+	s.wasmStorageKeeper.RemoveDataRequestWasm(s.ctx, hash)
+	s.Require().Equal(uint64(1), s.wasmStorageKeeper.GetBytecodeRefCount(s.ctx, hash))
+	s.Require().Equal(*overlayWasm, *s.wasmStorageKeeper.GetOverlayWasm(s.ctx, hash))
+
+	// Removing a Wasm that does not exist does not release a reference.
+	s.wasmStorageKeeper.RemoveDataRequestWasm(s.ctx, hash)
+	s.Require().Equal(uint64(1), s.wasmStorageKeeper.GetBytecodeRefCount(s.ctx, hash))
+
+	s.wasmStorageKeeper.RemoveOverlayWasm(s.ctx, hash)
+	s.Require().Zero(s.wasmStorageKeeper.GetBytecodeRefCount(s.ctx, hash))
+	s.Require().Nil(s.wasmStorageKeeper.GetBytecode(s.ctx, hash))
+}
@@ -1,12 +1,16 @@
 package keeper_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	storetypes "cosmossdk.io/store/types"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/testutil"
@@ -24,10 +28,22 @@ var (
 	mockedByteArray2 = []byte("a9dda829eb7f8ffe9fbesfa49e45d47d2dad9664fbb7adf72492e3c81ebd3e29134d9bc12212bf83c6840f10e8246b9db54a4859b7ccd0123d86e5872c1e50829a") //nolint:unused // unused
 )
 
+// mockWasmViewKeeper is a WasmViewKeeper backed by a map from contract
+// addresses to contract information.
+type mockWasmViewKeeper struct {
+	contracts map[string]*wasmtypes.ContractInfo
+}
+
+func (m *mockWasmViewKeeper) GetContractInfo(_ context.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo {
+	return m.contracts[contractAddress.String()]
+}
+
 type KeeperTestSuite struct {
 	suite.Suite
 	ctx               sdk.Context
+	storeKey          *storetypes.KVStoreKey
 	wasmStorageKeeper *keeper.Keeper
+	wasmViewKeeper    *mockWasmViewKeeper
 	blockTime         time.Time //nolint:unused // unused
 	cdc               codec.Codec
 	msgSrvr           wasmstoragetypes.MsgServer
@@ -37,8 +53,10 @@ type KeeperTestSuite struct {
 
 func (s *KeeperTestSuite) SetupTest() {
 	s.authority = authtypes.NewModuleAddress("gov").String()
-	wasmStorageKeeper, enCfg, ctx := setupKeeper(s.T(), s.authority)
+	s.wasmViewKeeper = &mockWasmViewKeeper{contracts: make(map[string]*wasmtypes.ContractInfo)}
+	wasmStorageKeeper, key, enCfg, ctx := setupKeeper(s.T(), s.authority, s.wasmViewKeeper)
 	s.wasmStorageKeeper = wasmStorageKeeper
+	s.storeKey = key
 	s.ctx = ctx
 	s.cdc = enCfg.Codec
 
@@ -55,7 +73,7 @@ func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
 
-func setupKeeper(t *testing.T, authority string) (*keeper.Keeper, moduletestutil.TestEncodingConfig, sdk.Context) {
+func setupKeeper(t *testing.T, authority string, wvk wasmstoragetypes.WasmViewKeeper) (*keeper.Keeper, *storetypes.KVStoreKey, moduletestutil.TestEncodingConfig, sdk.Context) {
 	t.Helper()
 	key := storetypes.NewKVStoreKey(wasmstoragetypes.StoreKey)
 	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
@@ -63,7 +81,8 @@ func setupKeeper(t *testing.T, authority string) (*keeper.Keeper, moduletestutil
 	encCfg := moduletestutil.MakeTestEncodingConfig(wasmstorage.AppModuleBasic{})
 	wasmstoragetypes.RegisterInterfaces(encCfg.InterfaceRegistry)
 
-	wasmStorageKeeper := keeper.NewKeeper(encCfg.Codec, key, authority, nil)
+	wasmStorageKeeper := keeper.NewKeeper(encCfg.Codec, key, authority, nil, wvk)
+	require.NoError(t, wasmStorageKeeper.SetParams(ctx, wasmstoragetypes.DefaultParams()))
 
-	return wasmStorageKeeper, encCfg, ctx
+	return wasmStorageKeeper, key, encCfg, ctx
 }
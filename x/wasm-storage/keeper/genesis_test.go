@@ -0,0 +1,244 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"os"
+	"time"
+
+	wasmstorage "github.com/sedaprotocol/seda-chain/x/wasm-storage"
+	"github.com/sedaprotocol/seda-chain/x/wasm-storage/types"
+)
+
+func (s *KeeperTestSuite) TestValidateGenesis() {
+	regWasm, err := os.ReadFile("test_utils/hello-world.wasm")
+	s.Require().NoError(err)
+	drWasm := *types.NewWasm(regWasm, types.WasmTypeDataRequest, time.Unix(0, 0).UTC())
+	overlayWasm := *types.NewWasm(regWasm, types.WasmTypeRelayer, time.Unix(0, 0).UTC())
+
+	tamperedWasm := drWasm
+	tamperedWasm.Bytecode = mockedByteArray
+	untypedWasm := drWasm
+	untypedWasm.WasmType = types.WasmTypeNil
+	oversizedWasm := *types.NewWasm(make([]byte, types.DefaultMaxWasmSize+1), types.WasmTypeTally, time.Unix(0, 0).UTC())
+
+	relayerVersion := types.OverlayVersion{
+		Hash:             overlayWasm.Hash,
+		WasmType:         types.WasmTypeRelayer,
+		ActivationHeight: 1,
+	}
+	executorVersion := relayerVersion
+	executorVersion.WasmType = types.WasmTypeDataRequestExecutor
+	missingVersion := relayerVersion
+	missingVersion.Hash = mockedByteArray
+
+	smallParams := types.DefaultParams()
+	smallParams.MaxWasmSize = uint64(len(regWasm) - 1)
+
+	cases := []struct {
+		name      string
+		genesis   types.GenesisState
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:    "same Wasm in both stores",
+			genesis: types.NewGenesisState(types.DefaultParams(), []types.Wasm{drWasm}, []types.Wasm{overlayWasm}, nil),
+			expErr:  false,
+		},
+		{
+			name: "legacy combined list",
+			genesis: types.GenesisState{
+				Params: types.DefaultParams(),
+				Wasms:  []types.Wasm{drWasm, overlayWasm},
+			},
+			expErr: false,
+		},
+		{
+			name: "missing params in legacy genesis",
+			genesis: types.GenesisState{
+				Wasms: []types.Wasm{drWasm, overlayWasm},
+			},
+			expErr: false,
+		},
+		{
+			name: "invalid params",
+			genesis: types.GenesisState{
+				Params: types.Params{ProxyContractCodeIDs: []uint64{1}},
+			},
+			expErr:    true,
+			expErrMsg: "invalid max Wasm size",
+		},
+		{
+			name:      "hash does not match bytecode",
+			genesis:   types.NewGenesisState(types.DefaultParams(), []types.Wasm{tamperedWasm}, nil, nil),
+			expErr:    true,
+			expErrMsg: "does not match its bytecode",
+		},
+		{
+			name:      "overlay type in data request store",
+			genesis:   types.NewGenesisState(types.DefaultParams(), []types.Wasm{overlayWasm}, nil, nil),
+			expErr:    true,
+			expErrMsg: "invalid type WASM_TYPE_RELAYER of Data Request Wasm",
+		},
+		{
+			name:      "data request type in overlay store",
+			genesis:   types.NewGenesisState(types.DefaultParams(), nil, []types.Wasm{drWasm}, nil),
+			expErr:    true,
+			expErrMsg: "invalid type WASM_TYPE_DATA_REQUEST of Overlay Wasm",
+		},
+		{
+			name: "invalid type in legacy list",
+			genesis: types.GenesisState{
+				Params: types.DefaultParams(),
+				Wasms:  []types.Wasm{untypedWasm},
+			},
+			expErr:    true,
+			expErrMsg: "invalid type WASM_TYPE_UNSPECIFIED",
+		},
+		{
+			name:      "oversized bytecode",
+			genesis:   types.NewGenesisState(types.DefaultParams(), []types.Wasm{oversizedWasm}, nil, nil),
+			expErr:    true,
+			expErrMsg: "exceeds max Wasm size",
+		},
+		{
+			name:      "bytecode exceeds params",
+			genesis:   types.NewGenesisState(smallParams, []types.Wasm{drWasm}, nil, nil),
+			expErr:    true,
+			expErrMsg: "exceeds max Wasm size",
+		},
+		{
+			name:      "duplicate Wasm",
+			genesis:   types.NewGenesisState(types.DefaultParams(), []types.Wasm{drWasm, drWasm}, nil, nil),
+			expErr:    true,
+			expErrMsg: "duplicate Wasm",
+		},
+		{
+			name: "duplicate Wasm across legacy list",
+			genesis: types.GenesisState{
+				Params:       types.DefaultParams(),
+				Wasms:        []types.Wasm{overlayWasm},
+				OverlayWasms: []types.Wasm{overlayWasm},
+			},
+			expErr:    true,
+			expErrMsg: "duplicate Wasm",
+		},
+		{
+			name: "overlay versions",
+			genesis: types.GenesisState{
+				Params:            types.DefaultParams(),
+				OverlayWasms:      []types.Wasm{overlayWasm},
+				ActiveOverlays:    []types.OverlayVersion{relayerVersion},
+				ScheduledOverlays: []types.OverlayVersion{relayerVersion},
+				OverlayHistory:    []types.OverlayVersion{relayerVersion},
+			},
+			expErr: false,
+		},
+		{
+			name: "overlay version of Wasm in legacy list",
+			genesis: types.GenesisState{
+				Params:         types.DefaultParams(),
+				Wasms:          []types.Wasm{overlayWasm},
+				ActiveOverlays: []types.OverlayVersion{relayerVersion},
+			},
+			expErr: false,
+		},
+		{
+			name: "active version of missing Wasm",
+			genesis: types.GenesisState{
+				Params:         types.DefaultParams(),
+				OverlayWasms:   []types.Wasm{overlayWasm},
+				ActiveOverlays: []types.OverlayVersion{missingVersion},
+			},
+			expErr:    true,
+			expErrMsg: "active version of WASM_TYPE_RELAYER refers to missing Overlay Wasm",
+		},
+		{
+			name: "scheduled version of data request Wasm",
+			genesis: types.GenesisState{
+				Params:            types.DefaultParams(),
+				DataRequestWasms:  []types.Wasm{drWasm},
+				ScheduledOverlays: []types.OverlayVersion{{Hash: drWasm.Hash, WasmType: types.WasmTypeRelayer}},
+			},
+			expErr:    true,
+			expErrMsg: "scheduled version of WASM_TYPE_RELAYER refers to missing Overlay Wasm",
+		},
+		{
+			name: "past version of Wasm of other type",
+			genesis: types.GenesisState{
+				Params:         types.DefaultParams(),
+				OverlayWasms:   []types.Wasm{overlayWasm},
+				OverlayHistory: []types.OverlayVersion{executorVersion},
+			},
+			expErr:    true,
+			expErrMsg: "past version of WASM_TYPE_DATA_REQUEST_EXECUTOR refers to Overlay Wasm " + hex.EncodeToString(overlayWasm.Hash) + " of type WASM_TYPE_RELAYER",
+		},
+		{
+			name: "invalid proxy contract registry",
+			genesis: types.GenesisState{
+				Params:                types.DefaultParams(),
+				ProxyContractRegistry: "seda1invalid",
+			},
+			expErr:    true,
+			expErrMsg: "invalid Proxy contract address",
+		},
+	}
+	for i := range cases {
+		tc := cases[i]
+		s.Run(tc.name, func() {
+			err := types.ValidateGenesis(tc.genesis)
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestInitGenesis() {
+	s.SetupTest()
+	regWasm, err := os.ReadFile("test_utils/hello-world.wasm")
+	s.Require().NoError(err)
+	drWasm := types.NewWasm(regWasm, types.WasmTypeTally, time.Unix(0, 0).UTC())
+	overlayWasm := types.NewWasm(mockedByteArray, types.WasmTypeDataRequestExecutor, time.Unix(0, 0).UTC())
+
+	// Wasms in the legacy combined list are routed by type and missing
+	// params are replaced by the default params.
+	wasmstorage.InitGenesis(s.ctx, *s.wasmStorageKeeper, types.GenesisState{
+		Wasms: []types.Wasm{*overlayWasm, *drWasm},
+	})
+	s.Require().True(s.wasmStorageKeeper.HasDataRequestWasm(s.ctx, drWasm))
+	s.Require().False(s.wasmStorageKeeper.HasOverlayWasm(s.ctx, drWasm))
+	s.Require().True(s.wasmStorageKeeper.HasOverlayWasm(s.ctx, overlayWasm))
+	s.Require().False(s.wasmStorageKeeper.HasDataRequestWasm(s.ctx, overlayWasm))
+
+	exported := wasmstorage.ExportGenesis(s.ctx, *s.wasmStorageKeeper)
+	s.Require().Empty(exported.Wasms)
+	s.Require().Equal([]types.Wasm{*drWasm}, exported.DataRequestWasms)
+	s.Require().Equal([]types.Wasm{*overlayWasm}, exported.OverlayWasms)
+	s.Require().Equal(types.DefaultParams(), exported.Params)
+}
+
+func (s *KeeperTestSuite) TestExportImportGenesis() {
+	s.SetupTest()
+	regWasm, err := os.ReadFile("test_utils/hello-world.wasm")
+	s.Require().NoError(err)
+	params := types.DefaultParams()
+	params.MaxWasmSize = 1000 * 1024
+	params.ProxyContractCodeIDs = []uint64{1, 2}
+
+	gs := types.NewGenesisState(
+		params,
+		[]types.Wasm{*types.NewWasm(regWasm, types.WasmTypeDataRequest, time.Unix(0, 0).UTC())},
+		[]types.Wasm{*types.NewWasm(regWasm, types.WasmTypeRelayer, time.Unix(0, 0).UTC())},
+		[]types.ProxyContract{},
+	)
+	s.Require().NoError(types.ValidateGenesis(gs))
+	wasmstorage.InitGenesis(s.ctx, *s.wasmStorageKeeper, gs)
+
+	exported := wasmstorage.ExportGenesis(s.ctx, *s.wasmStorageKeeper)
+	s.Require().Equal(gs.Params, exported.Params)
+	s.Require().Equal(gs.DataRequestWasms, exported.DataRequestWasms)
+	s.Require().Equal(gs.OverlayWasms, exported.OverlayWasms)
+}
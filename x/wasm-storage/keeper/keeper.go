@@ -14,18 +14,20 @@ import (
 )
 
 type Keeper struct {
-	cdc        codec.BinaryCodec
-	storeKey   storetypes.StoreKey
-	authority  string
-	wasmKeeper wasmtypes.ContractOpsKeeper
+	cdc            codec.BinaryCodec
+	storeKey       storetypes.StoreKey
+	authority      string
+	wasmKeeper     wasmtypes.ContractOpsKeeper
+	wasmViewKeeper types.WasmViewKeeper
 }
 
-func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, authority string, wk wasmtypes.ContractOpsKeeper) *Keeper {
+func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, authority string, wk wasmtypes.ContractOpsKeeper, wvk types.WasmViewKeeper) *Keeper {
 	return &Keeper{
-		cdc:        cdc,
-		storeKey:   storeKey,
-		authority:  authority,
-		wasmKeeper: wk,
+		cdc:            cdc,
+		storeKey:       storeKey,
+		authority:      authority,
+		wasmKeeper:     wk,
+		wasmViewKeeper: wvk,
 	}
 }
 
@@ -35,19 +37,14 @@ func (k Keeper) GetAuthority() string {
 }
 
 // SetDataRequestWasm stores Data Request Wasm using its hash as the key.
+// Its bytecode is stored in the shared bytecode store.
 func (k Keeper) SetDataRequestWasm(ctx sdk.Context, wasm *types.Wasm) {
-	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshal(wasm)
-	store.Set(types.GetDataRequestWasmKey(wasm.Hash), bz)
+	k.setWasm(ctx, types.GetDataRequestWasmKey(wasm.Hash), wasm)
 }
 
 // GetDataRequestWasm returns Data Request Wasm given its key.
 func (k Keeper) GetDataRequestWasm(ctx sdk.Context, hash []byte) *types.Wasm {
-	var wasm types.Wasm
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.GetDataRequestWasmKey(hash))
-	k.cdc.MustUnmarshal(bz, &wasm)
-	return &wasm
+	return k.getWasm(ctx, types.GetDataRequestWasmKey(hash))
 }
 
 // HasDataRequestWasm checks if a given Data Request Wasm exists.
@@ -56,30 +53,81 @@ func (k Keeper) HasDataRequestWasm(ctx sdk.Context, wasm *types.Wasm) bool {
 	return store.Has(types.GetDataRequestWasmKey(wasm.Hash))
 }
 
-// SetOverlayWasm stores Overlay Wasm using its hash as the key.
+// RemoveDataRequestWasm removes Data Request Wasm given its hash. Its
+// bytecode is deleted unless it is also referenced by an Overlay Wasm.
+func (k Keeper) RemoveDataRequestWasm(ctx sdk.Context, hash []byte) {
+	k.removeWasm(ctx, types.GetDataRequestWasmKey(hash), hash)
+}
+
+// SetOverlayWasm stores Overlay Wasm using its hash as the key. Its
+// bytecode is stored in the shared bytecode store.
 func (k Keeper) SetOverlayWasm(ctx sdk.Context, wasm *types.Wasm) {
-	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshal(wasm)
-	store.Set(types.GetOverlayWasmKey(wasm.Hash), bz)
+	k.setWasm(ctx, types.GetOverlayWasmKey(wasm.Hash), wasm)
 }
 
 // GetOverlayWasm returns Overlay Wasm given its key.
 func (k Keeper) GetOverlayWasm(ctx sdk.Context, hash []byte) *types.Wasm {
+	return k.getWasm(ctx, types.GetOverlayWasmKey(hash))
+}
+
+// HasOverlayWasm checks if a given Overlay Wasm exists.
+func (k Keeper) HasOverlayWasm(ctx sdk.Context, wasm *types.Wasm) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetOverlayWasmKey(wasm.Hash))
+}
+
+// RemoveOverlayWasm removes Overlay Wasm given its hash. Its bytecode is
+// deleted unless it is also referenced by a Data Request Wasm.
+func (k Keeper) RemoveOverlayWasm(ctx sdk.Context, hash []byte) {
+	k.removeWasm(ctx, types.GetOverlayWasmKey(hash), hash)
+}
+
+// setWasm stores the metadata of a given Wasm, including the size of its
+// bytecode, under a given key and references its bytecode in the shared
+// bytecode store.
+func (k Keeper) setWasm(ctx sdk.Context, key []byte, wasm *types.Wasm) {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(key) {
+		k.acquireBytecode(ctx, wasm.Hash, wasm.Bytecode)
+	}
+	metadata := *wasm
+	metadata.Size_ = uint64(len(wasm.Bytecode))
+	metadata.Bytecode = nil
+	store.Set(key, k.cdc.MustMarshal(&metadata))
+}
+
+// getWasm returns the Wasm stored under a given key along with its
+// bytecode.
+func (k Keeper) getWasm(ctx sdk.Context, key []byte) *types.Wasm {
+	wasm := k.getWasmMetadata(ctx, key)
+	wasm.Bytecode = k.GetBytecode(ctx, wasm.Hash)
+	return wasm
+}
+
+// getWasmMetadata returns the Wasm stored under a given key without
+// loading its bytecode.
+func (k Keeper) getWasmMetadata(ctx sdk.Context, key []byte) *types.Wasm {
 	var wasm types.Wasm
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.GetOverlayWasmKey(hash))
+	bz := store.Get(key)
 	k.cdc.MustUnmarshal(bz, &wasm)
 	return &wasm
 }
 
-// HasOverlayWasm checks if a given Overlay Wasm exists.
-func (k Keeper) HasOverlayWasm(ctx sdk.Context, wasm *types.Wasm) bool {
+// removeWasm removes the Wasm stored under a given key and releases its
+// reference to the bytecode with a given hash.
+func (k Keeper) removeWasm(ctx sdk.Context, key, hash []byte) {
 	store := ctx.KVStore(k.storeKey)
-	return store.Has(types.GetOverlayWasmKey(wasm.Hash))
+	if !store.Has(key) {
+		return
+	}
+	store.Delete(key)
+	k.releaseBytecode(ctx, hash)
 }
 
 // IterateAllDataRequestWasms iterates over the all the stored Data Request
-// Wasms and performs a given callback function.
+// Wasms and performs a given callback function. The bytecode of the Wasms
+// is not loaded.
 func (k Keeper) IterateAllDataRequestWasms(ctx sdk.Context, callback func(wasm types.Wasm) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
 	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixDataRequest)
@@ -96,7 +144,8 @@ func (k Keeper) IterateAllDataRequestWasms(ctx sdk.Context, callback func(wasm t
 }
 
 // IterateAllOverlayWasms iterates over the all the stored Overlay Wasms
-// and performs a given callback function.
+// and performs a given callback function. The bytecode of the Wasms is
+// not loaded.
 func (k Keeper) IterateAllOverlayWasms(ctx sdk.Context, callback func(wasm types.Wasm) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
 	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixOverlay)
@@ -133,13 +182,22 @@ func (k Keeper) ListOverlayWasms(ctx sdk.Context) []string {
 	return hashTypePairs
 }
 
-func (k Keeper) GetAllWasms(ctx sdk.Context) []types.Wasm {
+// GetAllDataRequestWasms returns all Data Request Wasms in the store.
+func (k Keeper) GetAllDataRequestWasms(ctx sdk.Context) []types.Wasm {
 	var wasms []types.Wasm
 	k.IterateAllDataRequestWasms(ctx, func(wasm types.Wasm) bool {
+		wasm.Bytecode = k.GetBytecode(ctx, wasm.Hash)
 		wasms = append(wasms, wasm)
 		return false
 	})
+	return wasms
+}
+
+// GetAllOverlayWasms returns all Overlay Wasms in the store.
+func (k Keeper) GetAllOverlayWasms(ctx sdk.Context) []types.Wasm {
+	var wasms []types.Wasm
 	k.IterateAllOverlayWasms(ctx, func(wasm types.Wasm) bool {
+		wasm.Bytecode = k.GetBytecode(ctx, wasm.Hash)
 		wasms = append(wasms, wasm)
 		return false
 	})
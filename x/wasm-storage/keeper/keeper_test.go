@@ -21,6 +21,7 @@ func (s *KeeperTestSuite) TestSetDataRequestWasm() {
 		Hash:     crypto.Keccak256(compWasm),
 		Bytecode: compWasm,
 		WasmType: wasmstoragetypes.WasmTypeDataRequest,
+		Size_:    uint64(len(compWasm)),
 	}
 	s.wasmStorageKeeper.SetDataRequestWasm(s.ctx, mockWasm)
 }
@@ -31,6 +32,7 @@ func (s *KeeperTestSuite) TestGetDataRequestWasm() {
 		Hash:     mockedByteArray,
 		Bytecode: mockedByteArray,
 		WasmType: wasmstoragetypes.WasmTypeDataRequest,
+		Size_:    uint64(len(mockedByteArray)),
 	}
 	s.wasmStorageKeeper.SetDataRequestWasm(s.ctx, mockWasm)
 	value := s.wasmStorageKeeper.GetDataRequestWasm(s.ctx, mockWasm.Hash)
@@ -44,6 +46,7 @@ func (s *KeeperTestSuite) TestHasDataRequestWasm() {
 		Hash:     mockedByteArray,
 		Bytecode: mockedByteArray,
 		WasmType: wasmstoragetypes.WasmTypeDataRequest,
+		Size_:    uint64(len(mockedByteArray)),
 	}
 	has := s.wasmStorageKeeper.HasDataRequestWasm(s.ctx, mockWasm)
 	s.Assert().False(has)
@@ -58,6 +61,7 @@ func (s *KeeperTestSuite) TestSetOverlayWasm() {
 		Hash:     mockedByteArray,
 		Bytecode: mockedByteArray,
 		WasmType: wasmstoragetypes.WasmTypeRelayer,
+		Size_:    uint64(len(mockedByteArray)),
 	}
 	s.wasmStorageKeeper.SetOverlayWasm(s.ctx, mockWasm)
 }
@@ -68,6 +72,7 @@ func (s *KeeperTestSuite) TestGetOverlayWasm() {
 		Hash:     mockedByteArray,
 		Bytecode: mockedByteArray,
 		WasmType: wasmstoragetypes.WasmTypeRelayer,
+		Size_:    uint64(len(mockedByteArray)),
 	}
 	s.wasmStorageKeeper.SetOverlayWasm(s.ctx, mockWasm)
 	value := s.wasmStorageKeeper.GetOverlayWasm(s.ctx, mockWasm.Hash)
@@ -81,6 +86,7 @@ func (s *KeeperTestSuite) TestHasOverlayWasm() {
 		Hash:     mockedByteArray,
 		Bytecode: mockedByteArray,
 		WasmType: wasmstoragetypes.WasmTypeRelayer,
+		Size_:    uint64(len(mockedByteArray)),
 	}
 	has := s.wasmStorageKeeper.HasOverlayWasm(s.ctx, mockWasm)
 	s.Assert().False(has)
@@ -95,11 +101,13 @@ func (s *KeeperTestSuite) TestIterateAllDataRequestWasm() {
 		Hash:     mockedByteArray,
 		Bytecode: mockedByteArray,
 		WasmType: wasmstoragetypes.WasmTypeDataRequest,
+		Size_:    uint64(len(mockedByteArray)),
 	}
 	mockWasm2 := &wasmstoragetypes.Wasm{
 		Hash:     append(mockedByteArray, 2),
 		Bytecode: mockedByteArray,
 		WasmType: wasmstoragetypes.WasmTypeDataRequest,
+		Size_:    uint64(len(mockedByteArray)),
 	}
 
 	s.wasmStorageKeeper.SetDataRequestWasm(s.ctx, mockWasm1)
@@ -116,11 +124,13 @@ func (s *KeeperTestSuite) TestListDateRequestWasm() {
 		Hash:     mockedByteArray,
 		Bytecode: mockedByteArray,
 		WasmType: wasmstoragetypes.WasmTypeDataRequest,
+		Size_:    uint64(len(mockedByteArray)),
 	}
 	mockWasm2 := &wasmstoragetypes.Wasm{
 		Hash:     append(mockedByteArray, 2),
 		Bytecode: mockedByteArray,
 		WasmType: wasmstoragetypes.WasmTypeDataRequest,
+		Size_:    uint64(len(mockedByteArray)),
 	}
 
 	s.wasmStorageKeeper.SetDataRequestWasm(s.ctx, mockWasm1)
@@ -137,11 +147,13 @@ func (s *KeeperTestSuite) TestListOverlayWasm() {
 		Hash:     mockedByteArray,
 		Bytecode: mockedByteArray,
 		WasmType: wasmstoragetypes.WasmTypeRelayer,
+		Size_:    uint64(len(mockedByteArray)),
 	}
 	mockWasm2 := &wasmstoragetypes.Wasm{
 		Hash:     append(mockedByteArray, 2),
 		Bytecode: mockedByteArray,
 		WasmType: wasmstoragetypes.WasmTypeRelayer,
+		Size_:    uint64(len(mockedByteArray)),
 	}
 
 	s.wasmStorageKeeper.SetOverlayWasm(s.ctx, mockWasm1)
@@ -158,11 +170,13 @@ func (s *KeeperTestSuite) TestGetAllWasms() {
 		Hash:     mockedByteArray,
 		Bytecode: mockedByteArray,
 		WasmType: wasmstoragetypes.WasmTypeDataRequest,
+		Size_:    uint64(len(mockedByteArray)),
 	}
 	mockWasm2 := &wasmstoragetypes.Wasm{
 		Hash:     append(mockedByteArray, 2),
 		Bytecode: mockedByteArray,
 		WasmType: wasmstoragetypes.WasmTypeDataRequest,
+		Size_:    uint64(len(mockedByteArray)),
 	}
 
 	s.wasmStorageKeeper.SetDataRequestWasm(s.ctx, mockWasm1)
@@ -172,20 +186,25 @@ func (s *KeeperTestSuite) TestGetAllWasms() {
 		Hash:     mockedByteArray,
 		Bytecode: mockedByteArray,
 		WasmType: wasmstoragetypes.WasmTypeRelayer,
+		Size_:    uint64(len(mockedByteArray)),
 	}
 	mockWasmO2 := &wasmstoragetypes.Wasm{
 		Hash:     append(mockedByteArray, 2),
 		Bytecode: mockedByteArray,
 		WasmType: wasmstoragetypes.WasmTypeRelayer,
+		Size_:    uint64(len(mockedByteArray)),
 	}
 
 	s.wasmStorageKeeper.SetOverlayWasm(s.ctx, mockWasmO1)
 	s.wasmStorageKeeper.SetOverlayWasm(s.ctx, mockWasmO2)
 
-	result := s.wasmStorageKeeper.GetAllWasms(s.ctx)
-	s.Assert().Equal(4, len(result))
+	result := s.wasmStorageKeeper.GetAllDataRequestWasms(s.ctx)
+	s.Assert().Equal(2, len(result))
 	s.Assert().Equal(*mockWasm1, result[0])
 	s.Assert().Equal(*mockWasm2, result[1])
-	s.Assert().Equal(*mockWasmO1, result[2])
-	s.Assert().Equal(*mockWasmO2, result[3])
+
+	result = s.wasmStorageKeeper.GetAllOverlayWasms(s.ctx)
+	s.Assert().Equal(2, len(result))
+	s.Assert().Equal(*mockWasmO1, result[0])
+	s.Assert().Equal(*mockWasmO2, result[1])
 }
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sedaprotocol/seda-chain/x/wasm-storage/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 moves the address of the single Proxy Contract into the
+// named Proxy Contract registry under the default name.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	store := ctx.KVStore(m.keeper.storeKey)
+	bz := store.Get(types.KeyPrefixProxyContractRegistry)
+	if len(bz) == 0 {
+		return nil
+	}
+
+	address := sdk.AccAddress(bz)
+	m.keeper.SetProxyContract(ctx, types.DefaultProxyContractName, address)
+	m.keeper.AppendProxyContractChange(ctx, types.ProxyContractChange{
+		Name:    types.DefaultProxyContractName,
+		Address: address.String(),
+		Height:  ctx.BlockHeight(),
+	})
+	store.Delete(types.KeyPrefixProxyContractRegistry)
+	return nil
+}
+
+// Migrate2to3 stores the default parameters, which were not part of the
+// genesis state and therefore never set before version 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	store := ctx.KVStore(m.keeper.storeKey)
+	if store.Has([]byte(types.KeyParams)) {
+		return nil
+	}
+	return m.keeper.SetParams(ctx, types.DefaultParams())
+}
+
+// Migrate3to4 moves the bytecode of all Data Request and Overlay Wasms
+// into the shared bytecode store, leaving only their metadata and the size
+// of their bytecode in the type-specific stores.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	store := ctx.KVStore(m.keeper.storeKey)
+	for _, prefix := range [][]byte{types.KeyPrefixDataRequest, types.KeyPrefixOverlay} {
+		var wasms []types.Wasm
+		iterator := storetypes.KVStorePrefixIterator(store, prefix)
+		for ; iterator.Valid(); iterator.Next() {
+			var wasm types.Wasm
+			m.keeper.cdc.MustUnmarshal(iterator.Value(), &wasm)
+			wasms = append(wasms, wasm)
+		}
+		iterator.Close()
+
+		for i := range wasms {
+			key := append(append([]byte{}, prefix...), wasms[i].Hash...)
+			store.Delete(key)
+			m.keeper.setWasm(ctx, key, &wasms[i])
+		}
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"github.com/ethereum/go-ethereum/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sedaprotocol/seda-chain/x/wasm-storage/keeper"
+	"github.com/sedaprotocol/seda-chain/x/wasm-storage/types"
+)
+
+func (s *KeeperTestSuite) TestMigrate1to2() {
+	s.SetupTest()
+	proxyAddr := sdk.AccAddress(mockedByteArray[:20])
+	s.ctx = s.ctx.WithBlockHeight(42)
+	s.ctx.KVStore(s.storeKey).Set(types.KeyPrefixProxyContractRegistry, proxyAddr.Bytes())
+
+	m := keeper.NewMigrator(*s.wasmStorageKeeper)
+	s.Require().NoError(m.Migrate1to2(s.ctx))
+
+	s.Require().Equal(proxyAddr, s.wasmStorageKeeper.GetProxyContractRegistry(s.ctx))
+	s.Require().False(s.ctx.KVStore(s.storeKey).Has(types.KeyPrefixProxyContractRegistry))
+	s.Require().Equal([]types.ProxyContract{
+		{Name: types.DefaultProxyContractName, Address: proxyAddr.String()},
+	}, s.wasmStorageKeeper.GetAllProxyContracts(s.ctx))
+	s.Require().Equal([]types.ProxyContractChange{
+		{Name: types.DefaultProxyContractName, Address: proxyAddr.String(), Height: 42},
+	}, s.wasmStorageKeeper.GetProxyContractHistory(s.ctx, types.DefaultProxyContractName))
+}
+
+func (s *KeeperTestSuite) TestMigrate2to3() {
+	s.SetupTest()
+	m := keeper.NewMigrator(*s.wasmStorageKeeper)
+	s.Require().NoError(m.Migrate2to3(s.ctx))
+	s.Require().Equal(types.DefaultParams(), s.wasmStorageKeeper.GetParams(s.ctx))
+
+	// Parameters that have already been set are kept.
+	params := types.DefaultParams()
+	params.ProxyContractCodeIDs = []uint64{7}
+	s.Require().NoError(s.wasmStorageKeeper.SetParams(s.ctx, params))
+	s.Require().NoError(m.Migrate2to3(s.ctx))
+	s.Require().Equal(params, s.wasmStorageKeeper.GetParams(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestMigrate3to4() {
+	s.SetupTest()
+	hash := crypto.Keccak256(mockedByteArray)
+	drWasm := types.Wasm{
+		Hash:     hash,
+		Bytecode: mockedByteArray,
+		WasmType: types.WasmTypeTally,
+	}
+	overlayWasm := types.Wasm{
+		Hash:     hash,
+		Bytecode: mockedByteArray,
+		WasmType: types.WasmTypeDataRequestExecutor,
+	}
+	// Version 3 stored the bytecode along with the metadata.
+	store := s.ctx.KVStore(s.storeKey)
+	bz, err := drWasm.Marshal()
+	s.Require().NoError(err)
+	store.Set(types.GetDataRequestWasmKey(hash), bz)
+	bz, err = overlayWasm.Marshal()
+	s.Require().NoError(err)
+	store.Set(types.GetOverlayWasmKey(hash), bz)
+
+	m := keeper.NewMigrator(*s.wasmStorageKeeper)
+	s.Require().NoError(m.Migrate3to4(s.ctx))
+
+	s.Require().Equal(uint64(2), s.wasmStorageKeeper.GetBytecodeRefCount(s.ctx, hash))
+	// The metadata now records the size of the bytecode.
+	drWasm.Size_ = uint64(len(mockedByteArray))
+	overlayWasm.Size_ = uint64(len(mockedByteArray))
+	s.Require().Equal(drWasm, *s.wasmStorageKeeper.GetDataRequestWasm(s.ctx, hash))
+	s.Require().Equal(overlayWasm, *s.wasmStorageKeeper.GetOverlayWasm(s.ctx, hash))
+
+	var stored types.Wasm
+	s.Require().NoError(stored.Unmarshal(store.Get(types.GetOverlayWasmKey(hash))))
+	s.Require().Empty(stored.Bytecode)
+}
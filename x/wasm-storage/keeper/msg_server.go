@@ -1,10 +1,15 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
-	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/klauspost/compress/zstd"
 
 	"github.com/CosmWasm/wasmd/x/wasm/ioutils"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,40 +17,17 @@ import (
 	"github.com/sedaprotocol/seda-chain/x/wasm-storage/types"
 )
 
-type msgServer struct {
-	Keeper
-}
-type EventStoreDataRequestWasmWrapper struct {
-	*types.EventStoreDataRequestWasm
-}
-
-// MarshalJSON customizes the JSON encoding of the type that implements it
-func (e EventStoreDataRequestWasmWrapper) MarshalJSON() ([]byte, error) {
-	// avoid infinite recursion when calling json.Marshal
-	type Alias types.EventStoreDataRequestWasm
-
-	return json.Marshal(&struct {
-		Hash json.RawMessage `json:"hash"`
-		*Alias
-	}{
-		Hash:  json.RawMessage(`"` + e.Hash + `"`),   // wrap the raw json value in double quotes
-		Alias: (*Alias)(e.EventStoreDataRequestWasm), // cast to embedded type
-	})
-}
+// zstdMagic is the magic number at the start of a zstd frame.
+var zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
 
-type EventStoreOverlayWasmWrapper struct {
-	*types.EventStoreOverlayWasm
-}
+// zstdMaxWindow is the largest window a zstd frame may declare. It is
+// the window used by the reference encoder up to its highest regular
+// compression level, so that a frame cannot make the decoder allocate
+// a window much larger than the Wasm it decompresses to.
+const zstdMaxWindow = 8 << 20
 
-func (e EventStoreOverlayWasmWrapper) MarshalJSON() ([]byte, error) {
-	type Alias types.EventStoreOverlayWasm
-	return json.Marshal(&struct {
-		Hash json.RawMessage `json:"hash"`
-		*Alias
-	}{
-		Hash:  json.RawMessage(`"` + e.Hash + `"`),
-		Alias: (*Alias)(e.EventStoreOverlayWasm),
-	})
+type msgServer struct {
+	Keeper
 }
 
 // NewMsgServerImpl returns an implementation of the MsgServer interface
@@ -59,71 +41,412 @@ var _ types.MsgServer = msgServer{}
 func (m msgServer) StoreDataRequestWasm(goCtx context.Context, msg *types.MsgStoreDataRequestWasm) (*types.MsgStoreDataRequestWasmResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	unzipped, err := unzipWasm(msg.Wasm)
+	unzipped, format, err := decompressWasm(msg.Wasm, m.GetParams(ctx).MaxWasmSize)
 	if err != nil {
 		return nil, err
 	}
-	wasm := types.NewWasm(unzipped, msg.WasmType, ctx.BlockTime())
-	if m.Keeper.HasDataRequestWasm(ctx, wasm) {
+	hashString, err := m.storeDataRequestWasm(ctx, unzipped, msg.WasmType, msg.Sender, format)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgStoreDataRequestWasmResponse{
+		Hash:   hashString,
+		Format: format,
+	}, nil
+}
+
+func (m msgServer) StoreOverlayWasm(goCtx context.Context, msg *types.MsgStoreOverlayWasm) (*types.MsgStoreOverlayWasmResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Sender != m.authority {
+		return nil, fmt.Errorf("invalid authority %s", msg.Sender)
+	}
+
+	unzipped, format, err := decompressWasm(msg.Wasm, m.GetParams(ctx).MaxWasmSize)
+	if err != nil {
+		return nil, err
+	}
+	hashString, err := m.storeOverlayWasm(ctx, unzipped, msg.WasmType, msg.Sender, format)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgStoreOverlayWasmResponse{
+		Hash:   hashString,
+		Format: format,
+	}, nil
+}
+
+// BeginWasmUpload starts a chunked upload of a Wasm that is expected
+// to have a given hash and size. The upload is discarded unless it is
+// finalized within WasmUploadExpiryBlocks blocks.
+func (m msgServer) BeginWasmUpload(goCtx context.Context, msg *types.MsgBeginWasmUpload) (*types.MsgBeginWasmUploadResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.WasmType.IsOverlay() && msg.Sender != m.authority {
+		return nil, fmt.Errorf("invalid authority %s", msg.Sender)
+	}
+	if maxSize := m.GetParams(ctx).MaxWasmSize; msg.UploadSize > maxSize {
+		return nil, fmt.Errorf("upload size cannot be larger than %d bytes", maxSize)
+	}
+
+	uploader, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sender address: %s", err)
+	}
+	hash, err := hex.DecodeString(msg.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hash: %s", err)
+	}
+	if m.Keeper.GetWasmUpload(ctx, uploader, hash) != nil {
+		return nil, fmt.Errorf("upload of Wasm with given hash is already pending")
+	}
+	if msg.WasmType.IsOverlay() && m.Keeper.HasOverlayWasm(ctx, &types.Wasm{Hash: hash}) {
+		return nil, fmt.Errorf("overlay Wasm with given hash already exists")
+	}
+	if msg.WasmType.IsDataRequest() && m.Keeper.HasDataRequestWasm(ctx, &types.Wasm{Hash: hash}) {
 		return nil, fmt.Errorf("data Request Wasm with given hash already exists")
 	}
-	m.Keeper.SetDataRequestWasm(ctx, wasm)
 
-	hashString := hex.EncodeToString(wasm.Hash)
+	upload := types.WasmUpload{
+		Hash:             hash,
+		Uploader:         msg.Sender,
+		WasmType:         msg.WasmType,
+		UploadSize:       msg.UploadSize,
+		ExpirationHeight: ctx.BlockHeight() + types.WasmUploadExpiryBlocks,
+	}
+	m.Keeper.BeginWasmUpload(ctx, upload)
 
 	err = ctx.EventManager().EmitTypedEvent(
-		&EventStoreDataRequestWasmWrapper{
-			EventStoreDataRequestWasm: &types.EventStoreDataRequestWasm{
-				Hash:     hashString,
-				WasmType: msg.WasmType,
-				Bytecode: msg.Wasm,
-			},
+		&types.EventBeginWasmUpload{
+			Hash:             msg.Hash,
+			WasmType:         msg.WasmType,
+			Uploader:         msg.Sender,
+			UploadSize:       msg.UploadSize,
+			ExpirationHeight: upload.ExpirationHeight,
 		})
 	if err != nil {
 		return nil, err
 	}
 
-	return &types.MsgStoreDataRequestWasmResponse{
-		Hash: hashString,
+	return &types.MsgBeginWasmUploadResponse{
+		ExpirationHeight: upload.ExpirationHeight,
 	}, nil
 }
 
-func (m msgServer) StoreOverlayWasm(goCtx context.Context, msg *types.MsgStoreOverlayWasm) (*types.MsgStoreOverlayWasmResponse, error) {
+// UploadWasmChunk appends the next chunk to a pending chunked upload.
+func (m msgServer) UploadWasmChunk(goCtx context.Context, msg *types.MsgUploadWasmChunk) (*types.MsgUploadWasmChunkResponse, error) {
 	if err := msg.ValidateBasic(); err != nil {
 		return nil, err
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if msg.Sender != m.authority {
-		return nil, fmt.Errorf("invalid authority %s", msg.Sender)
+	upload, err := m.getWasmUpload(ctx, msg.Sender, msg.Hash)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Index != upload.Chunks {
+		return nil, fmt.Errorf("unexpected chunk index; expected %d, got %d", upload.Chunks, msg.Index)
+	}
+	if upload.Received+uint64(len(msg.Chunk)) > upload.UploadSize {
+		return nil, fmt.Errorf("chunk exceeds upload size of %d bytes", upload.UploadSize)
+	}
+	m.Keeper.AppendWasmUploadChunk(ctx, upload, msg.Chunk)
+
+	return &types.MsgUploadWasmChunkResponse{
+		Received: upload.Received,
+	}, nil
+}
+
+// FinalizeWasmUpload verifies that all chunks of a pending chunked
+// upload have been received and that the resulting Wasm has the hash
+// given when the upload was begun. It then stores the Wasm like the
+// store messages would.
+func (m msgServer) FinalizeWasmUpload(goCtx context.Context, msg *types.MsgFinalizeWasmUpload) (*types.MsgFinalizeWasmUploadResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	upload, err := m.getWasmUpload(ctx, msg.Sender, msg.Hash)
+	if err != nil {
+		return nil, err
+	}
+	if upload.Received != upload.UploadSize {
+		return nil, fmt.Errorf("upload is incomplete; received %d of %d bytes", upload.Received, upload.UploadSize)
 	}
 
-	unzipped, err := unzipWasm(msg.Wasm)
+	unzipped, format, err := decompressWasm(m.Keeper.GetWasmUploadBytes(ctx, upload), m.GetParams(ctx).MaxWasmSize)
 	if err != nil {
 		return nil, err
 	}
-	wasm := types.NewWasm(unzipped, msg.WasmType, ctx.BlockTime())
+	if hash := crypto.Keccak256(unzipped); !bytes.Equal(hash, upload.Hash) {
+		return nil, fmt.Errorf("hash mismatch; expected %s, got %s", msg.Hash, hex.EncodeToString(hash))
+	}
+
+	var hashString string
+	if upload.WasmType.IsOverlay() {
+		hashString, err = m.storeOverlayWasm(ctx, unzipped, upload.WasmType, upload.Uploader, format)
+	} else {
+		hashString, err = m.storeDataRequestWasm(ctx, unzipped, upload.WasmType, upload.Uploader, format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	m.Keeper.RemoveWasmUpload(ctx, upload)
+
+	return &types.MsgFinalizeWasmUploadResponse{
+		Hash:   hashString,
+		Format: format,
+	}, nil
+}
+
+// getWasmUpload returns the pending chunked upload of a given sender
+// and hex-encoded hash.
+func (m msgServer) getWasmUpload(ctx sdk.Context, sender, hashString string) (*types.WasmUpload, error) {
+	uploader, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sender address: %s", err)
+	}
+	hash, err := hex.DecodeString(hashString)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hash: %s", err)
+	}
+	upload := m.Keeper.GetWasmUpload(ctx, uploader, hash)
+	if upload == nil {
+		return nil, fmt.Errorf("no pending upload of Wasm with given hash")
+	}
+	return upload, nil
+}
+
+// validateWasm charges gas for the size of a given uncompressed Wasm
+// before compiling it to check the requirements of its type, so that
+// the cost of the compilation is borne by the sender.
+func validateWasm(ctx sdk.Context, bytecode []byte, wasmType types.WasmType) error {
+	ctx.GasMeter().ConsumeGas(types.WasmValidationCostPerByte*uint64(len(bytecode)), "validate Wasm")
+	return types.ValidateWasm(ctx, bytecode, wasmType)
+}
+
+// storeDataRequestWasm validates and stores a given uncompressed Data
+// Request Wasm and returns its hex-encoded hash.
+func (m msgServer) storeDataRequestWasm(ctx sdk.Context, bytecode []byte, wasmType types.WasmType, uploader string, format types.WasmFormat) (string, error) {
+	if err := validateWasm(ctx, bytecode, wasmType); err != nil {
+		return "", err
+	}
+	wasm := types.NewWasm(bytecode, wasmType, ctx.BlockTime())
+	if m.Keeper.HasDataRequestWasm(ctx, wasm) {
+		return "", fmt.Errorf("data Request Wasm with given hash already exists")
+	}
+	m.Keeper.SetDataRequestWasm(ctx, wasm)
+
+	hashString := hex.EncodeToString(wasm.Hash)
+	err := ctx.EventManager().EmitTypedEvent(
+		&types.EventStoreDataRequestWasm{
+			Hash:         hashString,
+			WasmType:     wasmType,
+			BytecodeSize: uint64(len(wasm.Bytecode)),
+			Uploader:     uploader,
+			Height:       ctx.BlockHeight(),
+			Format:       format,
+		})
+	if err != nil {
+		return "", err
+	}
+	return hashString, nil
+}
+
+// storeOverlayWasm validates and stores a given uncompressed Overlay
+// Wasm and returns its hex-encoded hash.
+func (m msgServer) storeOverlayWasm(ctx sdk.Context, bytecode []byte, wasmType types.WasmType, uploader string, format types.WasmFormat) (string, error) {
+	if err := validateWasm(ctx, bytecode, wasmType); err != nil {
+		return "", err
+	}
+	wasm := types.NewWasm(bytecode, wasmType, ctx.BlockTime())
 	if m.Keeper.HasOverlayWasm(ctx, wasm) {
-		return nil, fmt.Errorf("overlay Wasm with given hash already exists")
+		return "", fmt.Errorf("overlay Wasm with given hash already exists")
 	}
 	m.Keeper.SetOverlayWasm(ctx, wasm)
 
 	hashString := hex.EncodeToString(wasm.Hash)
+	err := ctx.EventManager().EmitTypedEvent(
+		&types.EventStoreOverlayWasm{
+			Hash:         hashString,
+			WasmType:     wasmType,
+			BytecodeSize: uint64(len(wasm.Bytecode)),
+			Uploader:     uploader,
+			Height:       ctx.BlockHeight(),
+			Format:       format,
+		})
+	if err != nil {
+		return "", err
+	}
+	return hashString, nil
+}
+
+// RemoveDataRequestWasm removes a Data Request Wasm from the store.
+// It can only be executed by the module authority.
+func (m msgServer) RemoveDataRequestWasm(goCtx context.Context, msg *types.MsgRemoveDataRequestWasm) (*types.MsgRemoveDataRequestWasmResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != m.authority {
+		return nil, fmt.Errorf("invalid authority; expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	hash, err := hex.DecodeString(msg.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hash: %s", err)
+	}
+	wasm := &types.Wasm{Hash: hash}
+	if !m.Keeper.HasDataRequestWasm(ctx, wasm) {
+		return nil, fmt.Errorf("data Request Wasm with given hash does not exist")
+	}
+	wasm = m.Keeper.GetDataRequestWasm(ctx, hash)
+	m.Keeper.RemoveDataRequestWasm(ctx, hash)
+
 	err = ctx.EventManager().EmitTypedEvent(
-		&EventStoreOverlayWasmWrapper{
-			EventStoreOverlayWasm: &types.EventStoreOverlayWasm{
-				Hash:     hashString,
-				WasmType: msg.WasmType,
-				Bytecode: msg.Wasm,
-			},
+		&types.EventRemoveDataRequestWasm{
+			Hash:     msg.Hash,
+			WasmType: wasm.WasmType,
 		})
 	if err != nil {
 		return nil, err
 	}
 
-	return &types.MsgStoreOverlayWasmResponse{
-		Hash: hashString,
+	return &types.MsgRemoveDataRequestWasmResponse{}, nil
+}
+
+// RemoveOverlayWasm removes an Overlay Wasm from the store so that
+// overlay nodes stop using it. It can only be executed by the module
+// authority.
+func (m msgServer) RemoveOverlayWasm(goCtx context.Context, msg *types.MsgRemoveOverlayWasm) (*types.MsgRemoveOverlayWasmResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != m.authority {
+		return nil, fmt.Errorf("invalid authority; expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	hash, err := hex.DecodeString(msg.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hash: %s", err)
+	}
+	wasm := &types.Wasm{Hash: hash}
+	if !m.Keeper.HasOverlayWasm(ctx, wasm) {
+		return nil, fmt.Errorf("overlay Wasm with given hash does not exist")
+	}
+	wasm = m.Keeper.GetOverlayWasm(ctx, hash)
+	if m.Keeper.IsOverlayInUse(ctx, wasm.WasmType, hash) {
+		return nil, fmt.Errorf("overlay Wasm with given hash is active or scheduled for activation")
+	}
+	m.Keeper.RemoveOverlayWasm(ctx, hash)
+
+	err = ctx.EventManager().EmitTypedEvent(
+		&types.EventRemoveOverlayWasm{
+			Hash:     msg.Hash,
+			WasmType: wasm.WasmType,
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveOverlayWasmResponse{}, nil
+}
+
+// ActivateOverlayWasm sets the active version of an Overlay Wasm type
+// either immediately or, given a future activation height, by scheduling
+// it for activation at the beginning of that block.
+func (m msgServer) ActivateOverlayWasm(goCtx context.Context, msg *types.MsgActivateOverlayWasm) (*types.MsgActivateOverlayWasmResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != m.authority {
+		return nil, fmt.Errorf("invalid authority; expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	hash, err := hex.DecodeString(msg.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hash: %s", err)
+	}
+	if !m.Keeper.HasOverlayWasm(ctx, &types.Wasm{Hash: hash}) {
+		return nil, fmt.Errorf("overlay Wasm with given hash does not exist")
+	}
+	wasm := m.Keeper.GetOverlayWasm(ctx, hash)
+
+	version := types.OverlayVersion{
+		Hash:             hash,
+		WasmType:         wasm.WasmType,
+		ActivationHeight: msg.ActivationHeight,
+	}
+	switch {
+	case msg.ActivationHeight == 0 || msg.ActivationHeight == ctx.BlockHeight():
+		version.ActivationHeight = ctx.BlockHeight()
+		if err := m.Keeper.ActivateOverlay(ctx, version); err != nil {
+			return nil, err
+		}
+	case msg.ActivationHeight < ctx.BlockHeight():
+		return nil, fmt.Errorf("activation height %d is in the past", msg.ActivationHeight)
+	default:
+		m.Keeper.SetScheduledOverlay(ctx, version)
+		err = ctx.EventManager().EmitTypedEvent(
+			&types.EventScheduleOverlayWasm{
+				Hash:             msg.Hash,
+				WasmType:         version.WasmType,
+				ActivationHeight: version.ActivationHeight,
+			})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgActivateOverlayWasmResponse{
+		ActivationHeight: version.ActivationHeight,
+	}, nil
+}
+
+// RollbackOverlayWasm restores the previously active version of an
+// Overlay Wasm type.
+func (m msgServer) RollbackOverlayWasm(goCtx context.Context, msg *types.MsgRollbackOverlayWasm) (*types.MsgRollbackOverlayWasmResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != m.authority {
+		return nil, fmt.Errorf("invalid authority; expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	restored, err := m.Keeper.RollbackOverlay(ctx, msg.WasmType)
+	if err != nil {
+		return nil, err
+	}
+	if restored == nil {
+		return nil, fmt.Errorf("no previous version of %s to roll back to", msg.WasmType)
+	}
+
+	return &types.MsgRollbackOverlayWasmResponse{
+		Hash: hex.EncodeToString(restored.Hash),
 	}, nil
 }
 
@@ -136,6 +459,10 @@ func (m msgServer) InstantiateAndRegisterProxyContract(goCtx context.Context, ms
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if msg.Sender != m.authority {
+		return nil, fmt.Errorf("invalid authority %s", msg.Sender)
+	}
+
 	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
 		return nil, fmt.Errorf("invalid sender address: %s", err)
@@ -147,31 +474,207 @@ func (m msgServer) InstantiateAndRegisterProxyContract(goCtx context.Context, ms
 		}
 	}
 
+	if err := m.Keeper.ValidateProxyContractCodeID(ctx, msg.CodeID); err != nil {
+		return nil, err
+	}
+	if err := m.Keeper.ValidateProxyContractAdmin(ctx, msg.Admin); err != nil {
+		return nil, err
+	}
+
 	contractAddr, _, err := m.wasmKeeper.Instantiate2(ctx, msg.CodeID, senderAddr, adminAddr, msg.Msg, msg.Label, msg.Funds, msg.Salt, msg.FixMsg)
 	if err != nil {
 		return nil, err
 	}
 
 	// update Proxy Contract registry
-	m.SetProxyContractRegistry(ctx, contractAddr)
+	name := msg.Name
+	if name == "" {
+		name = types.DefaultProxyContractName
+	}
+	if m.Keeper.HasProxyContract(ctx, name) {
+		err = m.updateProxyContract(ctx, name, contractAddr)
+	} else {
+		err = m.registerProxyContract(ctx, name, contractAddr)
+	}
+	if err != nil {
+		return nil, err
+	}
 
 	return &types.MsgInstantiateAndRegisterProxyContractResponse{
 		ContractAddress: contractAddr.String(),
 	}, nil
 }
 
-// unzipWasm unzips a gzipped Wasm into
-func unzipWasm(wasm []byte) ([]byte, error) {
-	var unzipped []byte
-	var err error
-	if !ioutils.IsGzip(wasm) {
-		return nil, fmt.Errorf("wasm is not gzip compressed")
+// RegisterProxyContract adds a new named entry to the Proxy Contract
+// registry pointing to an already instantiated contract.
+func (m msgServer) RegisterProxyContract(goCtx context.Context, msg *types.MsgRegisterProxyContract) (*types.MsgRegisterProxyContractResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != m.authority {
+		return nil, fmt.Errorf("invalid authority; expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	if m.Keeper.HasProxyContract(ctx, msg.Name) {
+		return nil, fmt.Errorf("proxy contract %s is already registered", msg.Name)
 	}
-	unzipped, err = ioutils.Uncompress(wasm, types.MaxWasmSize)
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Address)
 	if err != nil {
+		return nil, fmt.Errorf("invalid proxy contract address: %s", err)
+	}
+	if err := m.Keeper.ValidateProxyContract(ctx, contractAddr); err != nil {
+		return nil, err
+	}
+	if err := m.registerProxyContract(ctx, msg.Name, contractAddr); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterProxyContractResponse{}, nil
+}
+
+// UpdateProxyContract changes the address of an existing named entry of
+// the Proxy Contract registry.
+func (m msgServer) UpdateProxyContract(goCtx context.Context, msg *types.MsgUpdateProxyContract) (*types.MsgUpdateProxyContractResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
 		return nil, err
 	}
-	return unzipped, nil
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != m.authority {
+		return nil, fmt.Errorf("invalid authority; expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	if !m.Keeper.HasProxyContract(ctx, msg.Name) {
+		return nil, fmt.Errorf("proxy contract %s is not registered", msg.Name)
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy contract address: %s", err)
+	}
+	if err := m.Keeper.ValidateProxyContract(ctx, contractAddr); err != nil {
+		return nil, err
+	}
+	if err := m.updateProxyContract(ctx, msg.Name, contractAddr); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateProxyContractResponse{}, nil
+}
+
+// RemoveProxyContract removes a named entry from the Proxy Contract
+// registry.
+func (m msgServer) RemoveProxyContract(goCtx context.Context, msg *types.MsgRemoveProxyContract) (*types.MsgRemoveProxyContractResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != m.authority {
+		return nil, fmt.Errorf("invalid authority; expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	contractAddr := m.Keeper.GetProxyContract(ctx, msg.Name)
+	if contractAddr == nil {
+		return nil, fmt.Errorf("proxy contract %s is not registered", msg.Name)
+	}
+	m.Keeper.RemoveProxyContract(ctx, msg.Name)
+	m.Keeper.AppendProxyContractChange(ctx, types.ProxyContractChange{
+		Name:   msg.Name,
+		Height: ctx.BlockHeight(),
+	})
+
+	err := ctx.EventManager().EmitTypedEvent(
+		&types.EventRemoveProxyContract{
+			Name:    msg.Name,
+			Address: contractAddr.String(),
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveProxyContractResponse{}, nil
+}
+
+// registerProxyContract stores a new named entry of the Proxy Contract
+// registry and records the change.
+func (m msgServer) registerProxyContract(ctx sdk.Context, name string, contractAddr sdk.AccAddress) error {
+	m.Keeper.SetProxyContract(ctx, name, contractAddr)
+	m.Keeper.AppendProxyContractChange(ctx, types.ProxyContractChange{
+		Name:    name,
+		Address: contractAddr.String(),
+		Height:  ctx.BlockHeight(),
+	})
+
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventRegisterProxyContract{
+			Name:    name,
+			Address: contractAddr.String(),
+		})
+}
+
+// updateProxyContract changes the address of an existing named entry of
+// the Proxy Contract registry and records the change.
+func (m msgServer) updateProxyContract(ctx sdk.Context, name string, contractAddr sdk.AccAddress) error {
+	previous := m.Keeper.GetProxyContract(ctx, name)
+	m.Keeper.SetProxyContract(ctx, name, contractAddr)
+	m.Keeper.AppendProxyContractChange(ctx, types.ProxyContractChange{
+		Name:    name,
+		Address: contractAddr.String(),
+		Height:  ctx.BlockHeight(),
+	})
+
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventUpdateProxyContract{
+			Name:            name,
+			Address:         contractAddr.String(),
+			PreviousAddress: previous.String(),
+		})
+}
+
+// decompressWasm detects whether a given Wasm is uncompressed or
+// compressed with gzip or zstd and returns its uncompressed bytecode.
+// The given size limit is applied both before and after decompression.
+func decompressWasm(wasm []byte, maxSize uint64) ([]byte, types.WasmFormat, error) {
+	if uint64(len(wasm)) > maxSize {
+		return nil, types.WasmFormatNil, fmt.Errorf("wasm cannot be longer than %d bytes", maxSize)
+	}
+
+	switch {
+	case ioutils.IsWasm(wasm):
+		return wasm, types.WasmFormatRaw, nil
+	case ioutils.IsGzip(wasm):
+		unzipped, err := ioutils.Uncompress(wasm, int64(maxSize))
+		if err != nil {
+			return nil, types.WasmFormatNil, err
+		}
+		return unzipped, types.WasmFormatGzip, nil
+	case bytes.HasPrefix(wasm, zstdMagic):
+		decoder, err := zstd.NewReader(bytes.NewReader(wasm),
+			zstd.WithDecoderConcurrency(1),
+			zstd.WithDecoderMaxWindow(zstdMaxWindow),
+			zstd.WithDecoderMaxMemory(max(maxSize, 1)),
+		)
+		if err != nil {
+			return nil, types.WasmFormatNil, err
+		}
+		defer decoder.Close()
+
+		unzipped, err := io.ReadAll(io.LimitReader(decoder, int64(maxSize)+1))
+		if err != nil && !errors.Is(err, zstd.ErrDecoderSizeExceeded) {
+			return nil, types.WasmFormatNil, err
+		}
+		if err != nil || uint64(len(unzipped)) > maxSize {
+			return nil, types.WasmFormatNil, fmt.Errorf("uncompressed wasm cannot be longer than %d bytes", maxSize)
+		}
+		return unzipped, types.WasmFormatZstd, nil
+	default:
+		return nil, types.WasmFormatNil, fmt.Errorf("wasm is neither uncompressed nor gzip or zstd compressed")
+	}
 }
 
 func (m msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
@@ -2,23 +2,75 @@ package keeper_test
 
 import (
 	"encoding/hex"
-	"encoding/json"
+	"fmt"
 	"os"
 
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/klauspost/compress/zstd"
+
+	storetypes "cosmossdk.io/store/types"
 
 	"github.com/CosmWasm/wasmd/x/wasm/ioutils"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	"github.com/sedaprotocol/seda-chain/x/wasm-storage/keeper"
 	"github.com/sedaprotocol/seda-chain/x/wasm-storage/types"
 )
 
+var (
+	// wasmNoEntryPoint is a module that only exports its memory.
+	wasmNoEntryPoint = []byte{
+		0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00, // magic, version
+		0x05, 0x03, 0x01, 0x00, 0x01, // memory section
+		0x07, 0x0a, 0x01, 0x06, 'm', 'e', 'm', 'o', 'r', 'y', 0x02, 0x00, // export section
+	}
+	// wasmFloat is a module whose entry point executes f32.const.
+	wasmFloat = []byte{
+		0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00, // magic, version
+		0x01, 0x04, 0x01, 0x60, 0x00, 0x00, // type section
+		0x03, 0x02, 0x01, 0x00, // function section
+		0x05, 0x03, 0x01, 0x00, 0x01, // memory section
+		0x07, 0x13, 0x02, 0x06, '_', 's', 't', 'a', 'r', 't', 0x00, 0x00,
+		0x06, 'm', 'e', 'm', 'o', 'r', 'y', 0x02, 0x00, // export section
+		0x0a, 0x0a, 0x01, 0x08, 0x00, 0x43, 0x00, 0x00, 0x00, 0x00, 0x1a, 0x0b, // code section
+	}
+	// wasmHTTPFetch is a module that imports env.http_fetch.
+	wasmHTTPFetch = []byte{
+		0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00, // magic, version
+		0x01, 0x04, 0x01, 0x60, 0x00, 0x00, // type section
+		0x02, 0x12, 0x01, 0x03, 'e', 'n', 'v', 0x0a, 'h', 't', 't', 'p', '_', 'f', 'e', 't', 'c', 'h', 0x00, 0x00, // import section
+		0x03, 0x02, 0x01, 0x00, // function section
+		0x05, 0x03, 0x01, 0x00, 0x01, // memory section
+		0x07, 0x13, 0x02, 0x06, '_', 's', 't', 'a', 'r', 't', 0x00, 0x01,
+		0x06, 'm', 'e', 'm', 'o', 'r', 'y', 0x02, 0x00, // export section
+		0x0a, 0x04, 0x01, 0x02, 0x00, 0x0b, // code section
+	}
+)
+
+// zstdCompress compresses a given Wasm with zstd.
+func zstdCompress(s *KeeperTestSuite, wasm []byte) []byte {
+	encoder, err := zstd.NewWriter(nil)
+	s.Require().NoError(err)
+	defer encoder.Close()
+	return encoder.EncodeAll(wasm, nil)
+}
+
 func (s *KeeperTestSuite) TestStoreDataRequestWasm() {
 	regWasm, err := os.ReadFile("test_utils/hello-world.wasm")
 	s.Require().NoError(err)
 	regWasmZipped, err := ioutils.GzipIt(regWasm)
 	s.Require().NoError(err)
 
+	notWasmZipped, err := ioutils.GzipIt([]byte("not a wasm binary"))
+	s.Require().NoError(err)
+	noEntryPointZipped, err := ioutils.GzipIt(wasmNoEntryPoint)
+	s.Require().NoError(err)
+	floatZipped, err := ioutils.GzipIt(wasmFloat)
+	s.Require().NoError(err)
+	httpFetchZipped, err := ioutils.GzipIt(wasmHTTPFetch)
+	s.Require().NoError(err)
+
 	oversizedWasm, err := os.ReadFile("test_utils/oversized.wasm")
 	s.Require().NoError(err)
 	oversizedWasmZipped, err := ioutils.GzipIt(oversizedWasm)
@@ -42,7 +94,8 @@ func (s *KeeperTestSuite) TestStoreDataRequestWasm() {
 			},
 			expErr: false,
 			expOutput: types.MsgStoreDataRequestWasmResponse{
-				Hash: hex.EncodeToString(crypto.Keccak256(regWasm)),
+				Hash:   hex.EncodeToString(crypto.Keccak256(regWasm)),
+				Format: types.WasmFormatGzip,
 			},
 		},
 		{
@@ -77,15 +130,87 @@ func (s *KeeperTestSuite) TestStoreDataRequestWasm() {
 		// 	expErrMsg: "not a Data Request Wasm",
 		// },
 		{
-			name: "unzipped Wasm",
+			name: "uncompressed Wasm",
 			input: types.MsgStoreDataRequestWasm{
 				Sender:   s.authority,
 				Wasm:     regWasm,
 				WasmType: types.WasmTypeDataRequest,
 			},
+			preRun: func() {},
+			expErr: false,
+			expOutput: types.MsgStoreDataRequestWasmResponse{
+				Hash:   hex.EncodeToString(crypto.Keccak256(regWasm)),
+				Format: types.WasmFormatRaw,
+			},
+		},
+		{
+			name: "zstd compressed Wasm",
+			input: types.MsgStoreDataRequestWasm{
+				Sender:   s.authority,
+				Wasm:     zstdCompress(s, regWasm),
+				WasmType: types.WasmTypeDataRequest,
+			},
+			preRun: func() {},
+			expErr: false,
+			expOutput: types.MsgStoreDataRequestWasmResponse{
+				Hash:   hex.EncodeToString(crypto.Keccak256(regWasm)),
+				Format: types.WasmFormatZstd,
+			},
+		},
+		{
+			name: "unsupported format",
+			input: types.MsgStoreDataRequestWasm{
+				Sender:   s.authority,
+				Wasm:     []byte("not a wasm binary"),
+				WasmType: types.WasmTypeDataRequest,
+			},
+			preRun:    func() {},
+			expErr:    true,
+			expErrMsg: "wasm is neither uncompressed nor gzip or zstd compressed",
+		},
+		{
+			name: "not a Wasm binary",
+			input: types.MsgStoreDataRequestWasm{
+				Sender:   s.authority,
+				Wasm:     notWasmZipped,
+				WasmType: types.WasmTypeDataRequest,
+			},
+			preRun:    func() {},
+			expErr:    true,
+			expErrMsg: "invalid Wasm: bad magic number or version",
+		},
+		{
+			name: "missing entry point",
+			input: types.MsgStoreDataRequestWasm{
+				Sender:   s.authority,
+				Wasm:     noEntryPointZipped,
+				WasmType: types.WasmTypeDataRequest,
+			},
+			preRun:    func() {},
+			expErr:    true,
+			expErrMsg: "invalid Wasm: missing function export _start",
+		},
+		{
+			name: "floating-point instructions",
+			input: types.MsgStoreDataRequestWasm{
+				Sender:   s.authority,
+				Wasm:     floatZipped,
+				WasmType: types.WasmTypeTally,
+			},
+			preRun:    func() {},
+			expErr:    true,
+			expErrMsg: "invalid Wasm: floating-point and SIMD features are not allowed",
+		},
+		{
+			name: "forbidden import in tally",
+			input: types.MsgStoreDataRequestWasm{
+				Sender:   s.authority,
+				Wasm:     httpFetchZipped,
+				WasmType: types.WasmTypeTally,
+			},
 			preRun:    func() {},
 			expErr:    true,
-			expErrMsg: "wasm is not gzip compressed",
+			expErrMsg: "invalid Wasm: forbidden import env.http_fetch",
 		},
 		{
 			name: "oversized Wasm",
@@ -98,6 +223,53 @@ func (s *KeeperTestSuite) TestStoreDataRequestWasm() {
 			expErr:    true,
 			expErrMsg: "",
 		},
+		{
+			name: "uncompressed Wasm larger than max Wasm size param",
+			input: types.MsgStoreDataRequestWasm{
+				Sender:   s.authority,
+				Wasm:     regWasmZipped,
+				WasmType: types.WasmTypeDataRequest,
+			},
+			preRun: func() {
+				params := types.DefaultParams()
+				params.MaxWasmSize = uint64(len(regWasmZipped))
+				s.Require().NoError(s.wasmStorageKeeper.SetParams(s.ctx, params))
+			},
+			expErr:    true,
+			expErrMsg: "exceeds limit",
+		},
+		{
+			name: "zstd compressed Wasm larger than max Wasm size param",
+			input: types.MsgStoreDataRequestWasm{
+				Sender:   s.authority,
+				Wasm:     zstdCompress(s, regWasm),
+				WasmType: types.WasmTypeDataRequest,
+			},
+			preRun: func() {
+				params := types.DefaultParams()
+				params.MaxWasmSize = uint64(len(regWasm)) - 1
+				s.Require().NoError(s.wasmStorageKeeper.SetParams(s.ctx, params))
+			},
+			expErr:    true,
+			expErrMsg: "uncompressed wasm cannot be longer than",
+		},
+		{
+			name: "zstd frame declaring too large a window",
+			input: types.MsgStoreDataRequestWasm{
+				Sender: s.authority,
+				// A frame declaring a 16 MiB window followed by an
+				// empty last raw block.
+				Wasm:     []byte{0x28, 0xb5, 0x2f, 0xfd, 0x00, 0x70, 0x01, 0x00, 0x00},
+				WasmType: types.WasmTypeDataRequest,
+			},
+			preRun: func() {
+				params := types.DefaultParams()
+				params.MaxWasmSize = 64 << 20
+				s.Require().NoError(s.wasmStorageKeeper.SetParams(s.ctx, params))
+			},
+			expErr:    true,
+			expErrMsg: "window size exceeded",
+		},
 	}
 	for i := range cases {
 		tc := cases[i]
@@ -116,6 +288,31 @@ func (s *KeeperTestSuite) TestStoreDataRequestWasm() {
 	}
 }
 
+func (s *KeeperTestSuite) TestStoreDataRequestWasmValidationGas() {
+	s.SetupTest()
+	regWasm, err := os.ReadFile("test_utils/hello-world.wasm")
+	s.Require().NoError(err)
+	regWasmZipped, err := ioutils.GzipIt(regWasm)
+	s.Require().NoError(err)
+	input := types.MsgStoreDataRequestWasm{
+		Sender:   s.authority,
+		Wasm:     regWasmZipped,
+		WasmType: types.WasmTypeDataRequest,
+	}
+
+	// The validation of the Wasm is charged before it is compiled.
+	validationCost := types.WasmValidationCostPerByte * uint64(len(regWasm))
+	ctx := s.ctx.WithGasMeter(storetypes.NewGasMeter(validationCost - 1))
+	s.Require().PanicsWithValue(storetypes.ErrorOutOfGas{Descriptor: "validate Wasm"}, func() {
+		_, _ = s.msgSrvr.StoreDataRequestWasm(ctx, &input)
+	})
+
+	ctx = s.ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	_, err = s.msgSrvr.StoreDataRequestWasm(ctx, &input)
+	s.Require().NoError(err)
+	s.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), validationCost)
+}
+
 func (s *KeeperTestSuite) TestStoreOverlayWasm() {
 	regWasm, err := os.ReadFile("test_utils/hello-world.wasm")
 	s.Require().NoError(err)
@@ -146,7 +343,8 @@ func (s *KeeperTestSuite) TestStoreOverlayWasm() {
 			expErr:    false,
 			expErrMsg: "",
 			expOutput: types.MsgStoreOverlayWasmResponse{
-				Hash: hex.EncodeToString(crypto.Keccak256(regWasm)),
+				Hash:   hex.EncodeToString(crypto.Keccak256(regWasm)),
+				Format: types.WasmFormatGzip,
 			},
 		},
 		{
@@ -191,15 +389,32 @@ func (s *KeeperTestSuite) TestStoreOverlayWasm() {
 			expErrMsg: "overlay Wasm with given hash already exists",
 		},
 		{
-			name: "unzipped Wasm",
+			name: "uncompressed Wasm",
 			input: types.MsgStoreOverlayWasm{
 				Sender:   s.authority,
 				Wasm:     regWasm,
 				WasmType: types.WasmTypeRelayer,
 			},
-			preRun:    func() {},
-			expErr:    true,
-			expErrMsg: "wasm is not gzip compressed",
+			preRun: func() {},
+			expErr: false,
+			expOutput: types.MsgStoreOverlayWasmResponse{
+				Hash:   hex.EncodeToString(crypto.Keccak256(regWasm)),
+				Format: types.WasmFormatRaw,
+			},
+		},
+		{
+			name: "zstd compressed Wasm",
+			input: types.MsgStoreOverlayWasm{
+				Sender:   s.authority,
+				Wasm:     zstdCompress(s, regWasm),
+				WasmType: types.WasmTypeRelayer,
+			},
+			preRun: func() {},
+			expErr: false,
+			expOutput: types.MsgStoreOverlayWasmResponse{
+				Hash:   hex.EncodeToString(crypto.Keccak256(regWasm)),
+				Format: types.WasmFormatZstd,
+			},
 		},
 		{
 			name: "oversized Wasm",
@@ -230,103 +445,902 @@ func (s *KeeperTestSuite) TestStoreOverlayWasm() {
 	}
 }
 
-func (s *KeeperTestSuite) TestMarshalJSON() {
+func (s *KeeperTestSuite) TestRemoveDataRequestWasm() {
+	regWasm, err := os.ReadFile("test_utils/hello-world.wasm")
+	s.Require().NoError(err)
+	regWasmZipped, err := ioutils.GzipIt(regWasm)
+	s.Require().NoError(err)
+	hash := hex.EncodeToString(crypto.Keccak256(regWasm))
+
 	cases := []struct {
-		name     string
-		hash     string
-		body     *types.EventStoreDataRequestWasm
-		expected string
+		name      string
+		preRun    func()
+		input     types.MsgRemoveDataRequestWasm
+		expErr    bool
+		expErrMsg string
 	}{
 		{
-			name: "Test WasmTypeDataRequest",
-			hash: "8558424e10c60eb4594cb2f1de834d5dd7a3b073d98d8641f8985fdbd84c3261",
-			body: &types.EventStoreDataRequestWasm{
-				Hash:     "8558424e10c60eb4594cb2f1de834d5dd7a3b073d98d8641f8985fdbd84c3261",
-				WasmType: types.WasmTypeDataRequest,
-				Bytecode: []byte("test WasmTypeDataRequest"),
+			name: "happy path",
+			input: types.MsgRemoveDataRequestWasm{
+				Authority: s.authority,
+				Hash:      hash,
+			},
+			preRun: func() {
+				_, err := s.msgSrvr.StoreDataRequestWasm(s.ctx, &types.MsgStoreDataRequestWasm{
+					Sender:   s.authority,
+					Wasm:     regWasmZipped,
+					WasmType: types.WasmTypeDataRequest,
+				})
+				s.Require().NoError(err)
+			},
+			expErr: false,
+		},
+		{
+			name: "invalid authority",
+			input: types.MsgRemoveDataRequestWasm{
+				Authority: "cosmos16wfryel63g7axeamw68630wglalcnk3l0zuadc",
+				Hash:      hash,
+			},
+			preRun:    func() {},
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "invalid hash",
+			input: types.MsgRemoveDataRequestWasm{
+				Authority: s.authority,
+				Hash:      "deadbeef",
+			},
+			preRun:    func() {},
+			expErr:    true,
+			expErrMsg: "invalid hash length",
+		},
+		{
+			name: "Data Request wasm does not exist",
+			input: types.MsgRemoveDataRequestWasm{
+				Authority: s.authority,
+				Hash:      hash,
 			},
-			expected: `{"hash":"8558424e10c60eb4594cb2f1de834d5dd7a3b073d98d8641f8985fdbd84c3261","wasm_type":1,"bytecode":"dGVzdCBXYXNtVHlwZURhdGFSZXF1ZXN0"}`,
+			preRun:    func() {},
+			expErr:    true,
+			expErrMsg: "data Request Wasm with given hash does not exist",
 		},
 	}
-
-	for _, tc := range cases {
+	for i := range cases {
+		tc := cases[i]
 		s.Run(tc.name, func() {
-			wrapper := &keeper.EventStoreDataRequestWasmWrapper{
-				EventStoreDataRequestWasm: &types.EventStoreDataRequestWasm{
-					Hash:     tc.hash,
-					WasmType: tc.body.WasmType,
-					Bytecode: tc.body.Bytecode,
-				},
+			s.SetupTest()
+			tc.preRun()
+			input := tc.input
+			_, err := s.msgSrvr.RemoveDataRequestWasm(s.ctx, &input)
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+				s.Require().False(s.wasmStorageKeeper.HasDataRequestWasm(s.ctx, &types.Wasm{Hash: crypto.Keccak256(regWasm)}))
 			}
-
-			data, err := json.Marshal(wrapper)
-			s.Require().NoError(err)
-			s.Require().Equal(tc.expected, string(data))
 		})
 	}
 }
 
-func (s *KeeperTestSuite) TestUpdateParams() {
-	authority := s.wasmStorageKeeper.GetAuthority()
+func (s *KeeperTestSuite) TestRemoveOverlayWasm() {
+	regWasm, err := os.ReadFile("test_utils/hello-world.wasm")
+	s.Require().NoError(err)
+	regWasmZipped, err := ioutils.GzipIt(regWasm)
+	s.Require().NoError(err)
+	hash := hex.EncodeToString(crypto.Keccak256(regWasm))
+
 	cases := []struct {
 		name      string
 		preRun    func()
-		input     types.MsgUpdateParams
+		input     types.MsgRemoveOverlayWasm
 		expErr    bool
 		expErrMsg string
 	}{
 		{
 			name: "happy path",
-			input: types.MsgUpdateParams{
+			input: types.MsgRemoveOverlayWasm{
 				Authority: s.authority,
-				Params: types.Params{
-					MaxWasmSize: 1000000, // 1 MB
-				},
+				Hash:      hash,
 			},
-			preRun:    func() {},
-			expErr:    false,
-			expErrMsg: "",
+			preRun: func() {
+				_, err := s.msgSrvr.StoreOverlayWasm(s.ctx, &types.MsgStoreOverlayWasm{
+					Sender:   s.authority,
+					Wasm:     regWasmZipped,
+					WasmType: types.WasmTypeRelayer,
+				})
+				s.Require().NoError(err)
+			},
+			expErr: false,
 		},
 		{
 			name: "invalid authority",
-			input: types.MsgUpdateParams{
+			input: types.MsgRemoveOverlayWasm{
 				Authority: "cosmos16wfryel63g7axeamw68630wglalcnk3l0zuadc",
-				Params: types.Params{
-					MaxWasmSize: 1, // 1 MB
-				},
+				Hash:      hash,
 			},
 			preRun:    func() {},
 			expErr:    true,
-			expErrMsg: "invalid authority; expected " + authority + ", got cosmos16wfryel63g7axeamw68630wglalcnk3l0zuadc",
+			expErrMsg: "invalid authority",
 		},
 		{
-			name: "invalid max wasm size",
-			input: types.MsgUpdateParams{
-				Authority: authority,
-				Params: types.Params{
-					MaxWasmSize: 0, // 0 MB
-				},
+			name: "Overlay wasm does not exist",
+			input: types.MsgRemoveOverlayWasm{
+				Authority: s.authority,
+				Hash:      hash,
 			},
 			preRun:    func() {},
 			expErr:    true,
-			expErrMsg: "invalid max Wasm size: 0",
+			expErrMsg: "overlay Wasm with given hash does not exist",
+		},
+		{
+			name: "Overlay wasm is active",
+			input: types.MsgRemoveOverlayWasm{
+				Authority: s.authority,
+				Hash:      hash,
+			},
+			preRun: func() {
+				_, err := s.msgSrvr.StoreOverlayWasm(s.ctx, &types.MsgStoreOverlayWasm{
+					Sender:   s.authority,
+					Wasm:     regWasmZipped,
+					WasmType: types.WasmTypeRelayer,
+				})
+				s.Require().NoError(err)
+				_, err = s.msgSrvr.ActivateOverlayWasm(s.ctx, &types.MsgActivateOverlayWasm{
+					Authority: s.authority,
+					Hash:      hash,
+				})
+				s.Require().NoError(err)
+			},
+			expErr:    true,
+			expErrMsg: "overlay Wasm with given hash is active or scheduled for activation",
 		},
 	}
-
-	for _, tc := range cases {
+	for i := range cases {
+		tc := cases[i]
 		s.Run(tc.name, func() {
 			s.SetupTest()
 			tc.preRun()
-			_, err := s.msgSrvr.UpdateParams(s.ctx, &tc.input)
+			input := tc.input
+			_, err := s.msgSrvr.RemoveOverlayWasm(s.ctx, &input)
 			if tc.expErr {
-				s.Require().Error(err)
-				s.Require().Equal(tc.expErrMsg, err.Error())
+				s.Require().ErrorContains(err, tc.expErrMsg)
 			} else {
 				s.Require().NoError(err)
+				s.Require().False(s.wasmStorageKeeper.HasOverlayWasm(s.ctx, &types.Wasm{Hash: crypto.Keccak256(regWasm)}))
+			}
+		})
+	}
+}
 
-				// Check that the Params were correctly set
-				params := s.wasmStorageKeeper.GetParams(s.ctx)
-				s.Require().Equal(tc.input.Params, params)
+func (s *KeeperTestSuite) TestActivateOverlayWasm() {
+	mockWasm := &types.Wasm{
+		Hash:     crypto.Keccak256(mockedByteArray),
+		Bytecode: mockedByteArray,
+		WasmType: types.WasmTypeRelayer,
+	}
+	hash := hex.EncodeToString(mockWasm.Hash)
+
+	cases := []struct {
+		name         string
+		preRun       func()
+		input        types.MsgActivateOverlayWasm
+		expErr       bool
+		expErrMsg    string
+		expActive    bool
+		expScheduled bool
+	}{
+		{
+			name: "activate immediately",
+			input: types.MsgActivateOverlayWasm{
+				Authority: s.authority,
+				Hash:      hash,
+			},
+			preRun: func() {
+				s.wasmStorageKeeper.SetOverlayWasm(s.ctx, mockWasm)
+			},
+			expActive: true,
+		},
+		{
+			name: "schedule activation",
+			input: types.MsgActivateOverlayWasm{
+				Authority:        s.authority,
+				Hash:             hash,
+				ActivationHeight: 100,
+			},
+			preRun: func() {
+				s.wasmStorageKeeper.SetOverlayWasm(s.ctx, mockWasm)
+			},
+			expScheduled: true,
+		},
+		{
+			name: "activation height in the past",
+			input: types.MsgActivateOverlayWasm{
+				Authority:        s.authority,
+				Hash:             hash,
+				ActivationHeight: 5,
+			},
+			preRun: func() {
+				s.wasmStorageKeeper.SetOverlayWasm(s.ctx, mockWasm)
+			},
+			expErr:    true,
+			expErrMsg: "activation height 5 is in the past",
+		},
+		{
+			name: "invalid authority",
+			input: types.MsgActivateOverlayWasm{
+				Authority: "cosmos16wfryel63g7axeamw68630wglalcnk3l0zuadc",
+				Hash:      hash,
+			},
+			preRun:    func() {},
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "Overlay wasm does not exist",
+			input: types.MsgActivateOverlayWasm{
+				Authority: s.authority,
+				Hash:      hash,
+			},
+			preRun:    func() {},
+			expErr:    true,
+			expErrMsg: "overlay Wasm with given hash does not exist",
+		},
+	}
+	for i := range cases {
+		tc := cases[i]
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.ctx = s.ctx.WithBlockHeight(10)
+			tc.preRun()
+			input := tc.input
+			_, err := s.msgSrvr.ActivateOverlayWasm(s.ctx, &input)
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+
+			active := s.wasmStorageKeeper.GetActiveOverlay(s.ctx, types.WasmTypeRelayer)
+			scheduled := s.wasmStorageKeeper.GetScheduledOverlay(s.ctx, types.WasmTypeRelayer)
+			if tc.expActive {
+				s.Require().NotNil(active)
+				s.Require().Equal(mockWasm.Hash, active.Hash)
+				s.Require().Equal(int64(10), active.ActivationHeight)
+			} else {
+				s.Require().Nil(active)
+			}
+			if tc.expScheduled {
+				s.Require().NotNil(scheduled)
+				s.Require().Equal(tc.input.ActivationHeight, scheduled.ActivationHeight)
+			} else {
+				s.Require().Nil(scheduled)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestRollbackOverlayWasm() {
+	s.SetupTest()
+	first := &types.Wasm{
+		Hash:     crypto.Keccak256(mockedByteArray),
+		Bytecode: mockedByteArray,
+		WasmType: types.WasmTypeRelayer,
+	}
+	second := &types.Wasm{
+		Hash:     crypto.Keccak256(mockedByteArray2),
+		Bytecode: mockedByteArray,
+		WasmType: types.WasmTypeRelayer,
+	}
+	s.wasmStorageKeeper.SetOverlayWasm(s.ctx, first)
+	s.wasmStorageKeeper.SetOverlayWasm(s.ctx, second)
+
+	input := types.MsgRollbackOverlayWasm{
+		Authority: s.authority,
+		WasmType:  types.WasmTypeRelayer,
+	}
+	_, err := s.msgSrvr.RollbackOverlayWasm(s.ctx, &input)
+	s.Require().ErrorContains(err, "no previous version")
+
+	s.ctx = s.ctx.WithBlockHeight(1)
+	_, err = s.msgSrvr.ActivateOverlayWasm(s.ctx, &types.MsgActivateOverlayWasm{
+		Authority: s.authority,
+		Hash:      hex.EncodeToString(first.Hash),
+	})
+	s.Require().NoError(err)
+
+	s.ctx = s.ctx.WithBlockHeight(2)
+	_, err = s.msgSrvr.ActivateOverlayWasm(s.ctx, &types.MsgActivateOverlayWasm{
+		Authority: s.authority,
+		Hash:      hex.EncodeToString(second.Hash),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(s.wasmStorageKeeper.GetOverlayHistory(s.ctx, types.WasmTypeRelayer), 1)
+
+	s.ctx = s.ctx.WithBlockHeight(3)
+	res, err := s.msgSrvr.RollbackOverlayWasm(s.ctx, &input)
+	s.Require().NoError(err)
+	s.Require().Equal(hex.EncodeToString(first.Hash), res.Hash)
+
+	active := s.wasmStorageKeeper.GetActiveOverlay(s.ctx, types.WasmTypeRelayer)
+	s.Require().NotNil(active)
+	s.Require().Equal(first.Hash, active.Hash)
+	s.Require().Equal(int64(3), active.ActivationHeight)
+	s.Require().Empty(s.wasmStorageKeeper.GetOverlayHistory(s.ctx, types.WasmTypeRelayer))
+
+	input.Authority = "cosmos16wfryel63g7axeamw68630wglalcnk3l0zuadc"
+	_, err = s.msgSrvr.RollbackOverlayWasm(s.ctx, &input)
+	s.Require().ErrorContains(err, "invalid authority")
+}
+
+func (s *KeeperTestSuite) TestProxyContractRegistry() {
+	s.SetupTest()
+	addr1 := sdk.AccAddress(mockedByteArray[:20]).String()
+	addr2 := sdk.AccAddress(mockedByteArray2[:20]).String()
+	addr3 := sdk.AccAddress(mockedByteArray[20:40]).String()
+	notContract := sdk.AccAddress(mockedByteArray2[20:40]).String()
+	addr4 := sdk.AccAddress(mockedByteArray[40:60]).String()
+	s.wasmViewKeeper.contracts[addr1] = &wasmtypes.ContractInfo{CodeID: 1, Admin: s.authority}
+	s.wasmViewKeeper.contracts[addr2] = &wasmtypes.ContractInfo{CodeID: 2, Admin: s.authority}
+	s.wasmViewKeeper.contracts[addr3] = &wasmtypes.ContractInfo{CodeID: 3, Admin: s.authority}
+	s.wasmViewKeeper.contracts[addr4] = &wasmtypes.ContractInfo{CodeID: 1, Admin: addr1}
+
+	params := types.DefaultParams()
+	params.ProxyContractCodeIDs = []uint64{1, 2}
+	params.ProxyContractAdmin = s.authority
+	s.Require().NoError(s.wasmStorageKeeper.SetParams(s.ctx, params))
+
+	cases := []struct {
+		name      string
+		msg       sdk.Msg
+		expErrMsg string
+	}{
+		{
+			name:      "not a contract",
+			msg:       &types.MsgRegisterProxyContract{Authority: s.authority, Name: "staking", Address: notContract},
+			expErrMsg: "is not a contract address",
+		},
+		{
+			name:      "code ID not allowed",
+			msg:       &types.MsgRegisterProxyContract{Authority: s.authority, Name: "staking", Address: addr3},
+			expErrMsg: "code ID 3 is not allowed for proxy contracts",
+		},
+		{
+			name:      "admin does not match policy",
+			msg:       &types.MsgRegisterProxyContract{Authority: s.authority, Name: "staking", Address: addr4},
+			expErrMsg: "proxy contract admin must be " + s.authority,
+		},
+		{
+			name:      "update unregistered",
+			msg:       &types.MsgUpdateProxyContract{Authority: s.authority, Name: "staking", Address: addr1},
+			expErrMsg: "proxy contract staking is not registered",
+		},
+		{
+			name: "register",
+			msg:  &types.MsgRegisterProxyContract{Authority: s.authority, Name: "staking", Address: addr1},
+		},
+		{
+			name:      "register duplicate",
+			msg:       &types.MsgRegisterProxyContract{Authority: s.authority, Name: "staking", Address: addr2},
+			expErrMsg: "proxy contract staking is already registered",
+		},
+		{
+			name: "register another",
+			msg:  &types.MsgRegisterProxyContract{Authority: s.authority, Name: "bridge", Address: addr2},
+		},
+		{
+			name: "update",
+			msg:  &types.MsgUpdateProxyContract{Authority: s.authority, Name: "staking", Address: addr2},
+		},
+		{
+			name:      "invalid name",
+			msg:       &types.MsgRegisterProxyContract{Authority: s.authority, Name: "Staking", Address: addr1},
+			expErrMsg: "invalid character",
+		},
+		{
+			name:      "invalid authority",
+			msg:       &types.MsgRegisterProxyContract{Authority: addr1, Name: "oracle", Address: addr1},
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "remove",
+			msg:  &types.MsgRemoveProxyContract{Authority: s.authority, Name: "bridge"},
+		},
+		{
+			name:      "remove unregistered",
+			msg:       &types.MsgRemoveProxyContract{Authority: s.authority, Name: "bridge"},
+			expErrMsg: "proxy contract bridge is not registered",
+		},
+	}
+	for i, tc := range cases {
+		s.Run(tc.name, func() {
+			s.ctx = s.ctx.WithBlockHeight(int64(i + 1))
+			var err error
+			switch msg := tc.msg.(type) {
+			case *types.MsgRegisterProxyContract:
+				_, err = s.msgSrvr.RegisterProxyContract(s.ctx, msg)
+			case *types.MsgUpdateProxyContract:
+				_, err = s.msgSrvr.UpdateProxyContract(s.ctx, msg)
+			case *types.MsgRemoveProxyContract:
+				_, err = s.msgSrvr.RemoveProxyContract(s.ctx, msg)
+			}
+			if tc.expErrMsg != "" {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	s.Require().Equal([]types.ProxyContract{
+		{Name: "staking", Address: addr2},
+	}, s.wasmStorageKeeper.GetAllProxyContracts(s.ctx))
+	s.Require().Equal([]types.ProxyContractChange{
+		{Name: "staking", Address: addr2, Height: 8},
+		{Name: "staking", Address: addr1, Height: 5},
+	}, s.wasmStorageKeeper.GetProxyContractHistory(s.ctx, "staking"))
+	s.Require().Equal([]types.ProxyContractChange{
+		{Name: "bridge", Height: 11},
+		{Name: "bridge", Address: addr2, Height: 7},
+	}, s.wasmStorageKeeper.GetProxyContractHistory(s.ctx, "bridge"))
+}
+
+func (s *KeeperTestSuite) TestInstantiateAndRegisterProxyContract() {
+	multisig := sdk.AccAddress(mockedByteArray[:20]).String()
+
+	cases := []struct {
+		name      string
+		sender    string
+		admin     string
+		params    types.Params
+		expErrMsg string
+	}{
+		{
+			name:      "invalid authority",
+			sender:    multisig,
+			admin:     multisig,
+			params:    types.DefaultParams(),
+			expErrMsg: "invalid authority",
+		},
+		{
+			name:   "admin does not match policy",
+			sender: s.authority,
+			admin:  s.authority,
+			params: types.Params{
+				MaxWasmSize:        types.DefaultMaxWasmSize,
+				ProxyContractAdmin: multisig,
+			},
+			expErrMsg: "proxy contract admin must be " + multisig,
+		},
+		{
+			name:   "no admin with policy",
+			sender: s.authority,
+			params: types.Params{
+				MaxWasmSize:        types.DefaultMaxWasmSize,
+				ProxyContractAdmin: multisig,
+			},
+			expErrMsg: "proxy contract admin must be " + multisig,
+		},
+		{
+			name:   "code ID not allowed",
+			sender: s.authority,
+			admin:  multisig,
+			params: types.Params{
+				MaxWasmSize:          types.DefaultMaxWasmSize,
+				ProxyContractCodeIDs: []uint64{2},
+			},
+			expErrMsg: "code ID 1 is not allowed for proxy contracts",
+		},
+	}
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.Require().NoError(s.wasmStorageKeeper.SetParams(s.ctx, tc.params))
+			_, err := s.msgSrvr.InstantiateAndRegisterProxyContract(s.ctx, &types.MsgInstantiateAndRegisterProxyContract{
+				Sender: tc.sender,
+				Admin:  tc.admin,
+				CodeID: 1,
+				Label:  "proxy",
+				Msg:    []byte("{}"),
+				Salt:   []byte("salt"),
+			})
+			s.Require().ErrorContains(err, tc.expErrMsg)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestStoreWasmEvents() {
+	s.SetupTest()
+	regWasm, err := os.ReadFile("test_utils/hello-world.wasm")
+	s.Require().NoError(err)
+	regWasmZipped, err := ioutils.GzipIt(regWasm)
+	s.Require().NoError(err)
+
+	s.ctx = s.ctx.WithBlockHeight(7).WithEventManager(sdk.NewEventManager())
+	_, err = s.msgSrvr.StoreOverlayWasm(s.ctx, &types.MsgStoreOverlayWasm{
+		Sender:   s.authority,
+		Wasm:     regWasmZipped,
+		WasmType: types.WasmTypeRelayer,
+	})
+	s.Require().NoError(err)
+
+	events := s.ctx.EventManager().Events()
+	s.Require().Len(events, 1)
+	s.Require().Equal("sedachain.wasm_storage.v1.EventStoreOverlayWasm", events[0].Type)
+
+	attrs := make(map[string]string)
+	for _, attr := range events[0].Attributes {
+		attrs[attr.Key] = attr.Value
+	}
+	s.Require().NotContains(attrs, "bytecode")
+	s.Require().Equal(`"`+hex.EncodeToString(crypto.Keccak256(regWasm))+`"`, attrs["hash"])
+	s.Require().Equal(fmt.Sprintf(`"%d"`, len(regWasm)), attrs["bytecode_size"])
+	s.Require().Equal(`"`+s.authority+`"`, attrs["uploader"])
+	s.Require().Equal(`"7"`, attrs["height"])
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	authority := s.wasmStorageKeeper.GetAuthority()
+	cases := []struct {
+		name      string
+		preRun    func()
+		input     types.MsgUpdateParams
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "happy path",
+			input: types.MsgUpdateParams{
+				Authority: s.authority,
+				Params: types.Params{
+					MaxWasmSize: 1000000, // 1 MB
+				},
+			},
+			preRun:    func() {},
+			expErr:    false,
+			expErrMsg: "",
+		},
+		{
+			name: "invalid authority",
+			input: types.MsgUpdateParams{
+				Authority: "cosmos16wfryel63g7axeamw68630wglalcnk3l0zuadc",
+				Params: types.Params{
+					MaxWasmSize: 1, // 1 MB
+				},
+			},
+			preRun:    func() {},
+			expErr:    true,
+			expErrMsg: "invalid authority; expected " + authority + ", got cosmos16wfryel63g7axeamw68630wglalcnk3l0zuadc",
+		},
+		{
+			name: "invalid max wasm size",
+			input: types.MsgUpdateParams{
+				Authority: authority,
+				Params: types.Params{
+					MaxWasmSize: 0, // 0 MB
+				},
+			},
+			preRun:    func() {},
+			expErr:    true,
+			expErrMsg: "invalid max Wasm size: 0",
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			tc.preRun()
+			_, err := s.msgSrvr.UpdateParams(s.ctx, &tc.input)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Equal(tc.expErrMsg, err.Error())
+			} else {
+				s.Require().NoError(err)
+
+				// Check that the Params were correctly set
+				params := s.wasmStorageKeeper.GetParams(s.ctx)
+				s.Require().Equal(tc.input.Params, params)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestChunkedWasmUpload() {
+	regWasm, err := os.ReadFile("test_utils/hello-world.wasm")
+	s.Require().NoError(err)
+	regWasmZipped, err := ioutils.GzipIt(regWasm)
+	s.Require().NoError(err)
+	hash := hex.EncodeToString(crypto.Keccak256(regWasm))
+	otherHash := hex.EncodeToString(crypto.Keccak256(mockedByteArray))
+
+	chunkSize := len(regWasmZipped)/3 + 1
+	var chunks [][]byte
+	for i := 0; i < len(regWasmZipped); i += chunkSize {
+		chunks = append(chunks, regWasmZipped[i:min(i+chunkSize, len(regWasmZipped))])
+	}
+	uploader := "cosmos16wfryel63g7axeamw68630wglalcnk3l0zuadc"
+
+	begin := func(hash string, size int, wasmType types.WasmType) {
+		_, err := s.msgSrvr.BeginWasmUpload(s.ctx, &types.MsgBeginWasmUpload{
+			Sender:     uploader,
+			Hash:       hash,
+			UploadSize: uint64(size),
+			WasmType:   wasmType,
+		})
+		s.Require().NoError(err)
+	}
+	upload := func(hash string, chunks [][]byte) {
+		for i, chunk := range chunks {
+			_, err := s.msgSrvr.UploadWasmChunk(s.ctx, &types.MsgUploadWasmChunk{
+				Sender: uploader,
+				Hash:   hash,
+				Index:  uint64(i),
+				Chunk:  chunk,
+			})
+			s.Require().NoError(err)
+		}
+	}
+
+	cases := []struct {
+		name      string
+		preRun    func()
+		input     types.MsgFinalizeWasmUpload
+		expErr    bool
+		expErrMsg string
+		expOutput types.MsgFinalizeWasmUploadResponse
+	}{
+		{
+			name: "happy path",
+			preRun: func() {
+				begin(hash, len(regWasmZipped), types.WasmTypeDataRequest)
+				upload(hash, chunks)
+			},
+			input: types.MsgFinalizeWasmUpload{
+				Sender: uploader,
+				Hash:   hash,
+			},
+			expErr: false,
+			expOutput: types.MsgFinalizeWasmUploadResponse{
+				Hash:   hash,
+				Format: types.WasmFormatGzip,
+			},
+		},
+		{
+			name:   "no pending upload",
+			preRun: func() {},
+			input: types.MsgFinalizeWasmUpload{
+				Sender: uploader,
+				Hash:   hash,
+			},
+			expErr:    true,
+			expErrMsg: "no pending upload of Wasm with given hash",
+		},
+		{
+			name: "incomplete upload",
+			preRun: func() {
+				begin(hash, len(regWasmZipped), types.WasmTypeDataRequest)
+				upload(hash, chunks[:len(chunks)-1])
+			},
+			input: types.MsgFinalizeWasmUpload{
+				Sender: uploader,
+				Hash:   hash,
+			},
+			expErr:    true,
+			expErrMsg: "upload is incomplete",
+		},
+		{
+			name: "hash mismatch",
+			preRun: func() {
+				begin(otherHash, len(regWasmZipped), types.WasmTypeDataRequest)
+				upload(otherHash, chunks)
+			},
+			input: types.MsgFinalizeWasmUpload{
+				Sender: uploader,
+				Hash:   otherHash,
+			},
+			expErr:    true,
+			expErrMsg: "hash mismatch",
+		},
+	}
+	for i := range cases {
+		tc := cases[i]
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			tc.preRun()
+			input := tc.input
+			res, err := s.msgSrvr.FinalizeWasmUpload(s.ctx, &input)
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+			} else {
+				s.Require().Nil(err)
+				s.Require().Equal(tc.expOutput, *res)
+				s.Require().True(s.wasmStorageKeeper.HasDataRequestWasm(s.ctx, &types.Wasm{Hash: crypto.Keccak256(regWasm)}))
+				s.Require().Nil(s.wasmStorageKeeper.GetWasmUpload(s.ctx, sdk.MustAccAddressFromBech32(uploader), crypto.Keccak256(regWasm)))
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestUploadWasmChunk() {
+	hash := hex.EncodeToString(crypto.Keccak256(mockedByteArray))
+	uploader := "cosmos16wfryel63g7axeamw68630wglalcnk3l0zuadc"
+
+	cases := []struct {
+		name      string
+		input     types.MsgUploadWasmChunk
+		expErr    bool
+		expErrMsg string
+		expOutput types.MsgUploadWasmChunkResponse
+	}{
+		{
+			name: "happy path",
+			input: types.MsgUploadWasmChunk{
+				Sender: uploader,
+				Hash:   hash,
+				Index:  0,
+				Chunk:  mockedByteArray[:64],
+			},
+			expErr: false,
+			expOutput: types.MsgUploadWasmChunkResponse{
+				Received: 64,
+			},
+		},
+		{
+			name: "unexpected index",
+			input: types.MsgUploadWasmChunk{
+				Sender: uploader,
+				Hash:   hash,
+				Index:  1,
+				Chunk:  mockedByteArray[:64],
+			},
+			expErr:    true,
+			expErrMsg: "unexpected chunk index; expected 0, got 1",
+		},
+		{
+			name: "chunk exceeds upload size",
+			input: types.MsgUploadWasmChunk{
+				Sender: uploader,
+				Hash:   hash,
+				Index:  0,
+				Chunk:  append(mockedByteArray, 0x00),
+			},
+			expErr:    true,
+			expErrMsg: "chunk exceeds upload size",
+		},
+		{
+			name: "sender without pending upload",
+			input: types.MsgUploadWasmChunk{
+				Sender: s.authority,
+				Hash:   hash,
+				Index:  0,
+				Chunk:  mockedByteArray[:64],
+			},
+			expErr:    true,
+			expErrMsg: "no pending upload of Wasm with given hash",
+		},
+	}
+	for i := range cases {
+		tc := cases[i]
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			_, err := s.msgSrvr.BeginWasmUpload(s.ctx, &types.MsgBeginWasmUpload{
+				Sender:     uploader,
+				Hash:       hash,
+				UploadSize: uint64(len(mockedByteArray)),
+				WasmType:   types.WasmTypeDataRequest,
+			})
+			s.Require().NoError(err)
+
+			input := tc.input
+			res, err := s.msgSrvr.UploadWasmChunk(s.ctx, &input)
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+			} else {
+				s.Require().Nil(err)
+				s.Require().Equal(tc.expOutput, *res)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestBeginWasmUpload() {
+	hash := hex.EncodeToString(crypto.Keccak256(mockedByteArray))
+	uploader := "cosmos16wfryel63g7axeamw68630wglalcnk3l0zuadc"
+
+	cases := []struct {
+		name      string
+		preRun    func()
+		input     types.MsgBeginWasmUpload
+		expErr    bool
+		expErrMsg string
+		expOutput types.MsgBeginWasmUploadResponse
+	}{
+		{
+			name:   "happy path",
+			preRun: func() {},
+			input: types.MsgBeginWasmUpload{
+				Sender:     uploader,
+				Hash:       hash,
+				UploadSize: 1000,
+				WasmType:   types.WasmTypeTally,
+			},
+			expErr: false,
+			expOutput: types.MsgBeginWasmUploadResponse{
+				ExpirationHeight: 10 + types.WasmUploadExpiryBlocks,
+			},
+		},
+		{
+			name:   "overlay Wasm by authority",
+			preRun: func() {},
+			input: types.MsgBeginWasmUpload{
+				Sender:     s.authority,
+				Hash:       hash,
+				UploadSize: 1000,
+				WasmType:   types.WasmTypeRelayer,
+			},
+			expErr: false,
+			expOutput: types.MsgBeginWasmUploadResponse{
+				ExpirationHeight: 10 + types.WasmUploadExpiryBlocks,
+			},
+		},
+		{
+			name:   "overlay Wasm by non-authority",
+			preRun: func() {},
+			input: types.MsgBeginWasmUpload{
+				Sender:     uploader,
+				Hash:       hash,
+				UploadSize: 1000,
+				WasmType:   types.WasmTypeRelayer,
+			},
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "upload already pending",
+			preRun: func() {
+				_, err := s.msgSrvr.BeginWasmUpload(s.ctx, &types.MsgBeginWasmUpload{
+					Sender:     uploader,
+					Hash:       hash,
+					UploadSize: 1000,
+					WasmType:   types.WasmTypeTally,
+				})
+				s.Require().NoError(err)
+			},
+			input: types.MsgBeginWasmUpload{
+				Sender:     uploader,
+				Hash:       hash,
+				UploadSize: 1000,
+				WasmType:   types.WasmTypeTally,
+			},
+			expErr:    true,
+			expErrMsg: "upload of Wasm with given hash is already pending",
+		},
+		{
+			name:   "oversized upload",
+			preRun: func() {},
+			input: types.MsgBeginWasmUpload{
+				Sender:     uploader,
+				Hash:       hash,
+				UploadSize: types.DefaultMaxWasmSize + 1,
+				WasmType:   types.WasmTypeTally,
+			},
+			expErr:    true,
+			expErrMsg: "upload size cannot be larger than",
+		},
+	}
+	for i := range cases {
+		tc := cases[i]
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.ctx = s.ctx.WithBlockHeight(10)
+			tc.preRun()
+			input := tc.input
+			res, err := s.msgSrvr.BeginWasmUpload(s.ctx, &input)
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+			} else {
+				s.Require().Nil(err)
+				s.Require().Equal(tc.expOutput, *res)
 			}
 		})
 	}
@@ -0,0 +1,246 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sedaprotocol/seda-chain/x/wasm-storage/types"
+)
+
+// SetActiveOverlay stores the active version of an Overlay Wasm type.
+func (k Keeper) SetActiveOverlay(ctx sdk.Context, version types.OverlayVersion) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&version)
+	store.Set(types.GetActiveOverlayKey(version.WasmType), bz)
+}
+
+// GetActiveOverlay returns the active version of a given Overlay Wasm
+// type or nil if no version has been activated.
+func (k Keeper) GetActiveOverlay(ctx sdk.Context, wasmType types.WasmType) *types.OverlayVersion {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetActiveOverlayKey(wasmType))
+	if bz == nil {
+		return nil
+	}
+	var version types.OverlayVersion
+	k.cdc.MustUnmarshal(bz, &version)
+	return &version
+}
+
+// SetScheduledOverlay stores the version of an Overlay Wasm type that is
+// to be activated at a future height, replacing any earlier schedule.
+func (k Keeper) SetScheduledOverlay(ctx sdk.Context, version types.OverlayVersion) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&version)
+	store.Set(types.GetScheduledOverlayKey(version.WasmType), bz)
+}
+
+// GetScheduledOverlay returns the scheduled version of a given Overlay
+// Wasm type or nil if there is none.
+func (k Keeper) GetScheduledOverlay(ctx sdk.Context, wasmType types.WasmType) *types.OverlayVersion {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetScheduledOverlayKey(wasmType))
+	if bz == nil {
+		return nil
+	}
+	var version types.OverlayVersion
+	k.cdc.MustUnmarshal(bz, &version)
+	return &version
+}
+
+// RemoveScheduledOverlay removes the scheduled version of a given
+// Overlay Wasm type.
+func (k Keeper) RemoveScheduledOverlay(ctx sdk.Context, wasmType types.WasmType) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetScheduledOverlayKey(wasmType))
+}
+
+// IterateScheduledOverlays iterates over the scheduled versions of all
+// Overlay Wasm types and performs a given callback function.
+func (k Keeper) IterateScheduledOverlays(ctx sdk.Context, callback func(version types.OverlayVersion) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixScheduledOverlay)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var version types.OverlayVersion
+		k.cdc.MustUnmarshal(iterator.Value(), &version)
+
+		if callback(version) {
+			break
+		}
+	}
+}
+
+// AppendOverlayHistory records a previously active version of an
+// Overlay Wasm type after all versions recorded so far.
+func (k Keeper) AppendOverlayHistory(ctx sdk.Context, version types.OverlayVersion) {
+	store := ctx.KVStore(k.storeKey)
+
+	var seq uint64
+	iterator := storetypes.KVStoreReversePrefixIterator(store, types.GetOverlayHistoryPrefix(version.WasmType))
+	if iterator.Valid() {
+		key := iterator.Key()
+		seq = sdk.BigEndianToUint64(key[len(key)-8:]) + 1
+	}
+	iterator.Close()
+
+	bz := k.cdc.MustMarshal(&version)
+	store.Set(types.GetOverlayHistoryKey(version.WasmType, seq), bz)
+}
+
+// GetOverlayHistory returns the previously active versions of a given
+// Overlay Wasm type, starting from the most recent one.
+func (k Keeper) GetOverlayHistory(ctx sdk.Context, wasmType types.WasmType) []types.OverlayVersion {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStoreReversePrefixIterator(store, types.GetOverlayHistoryPrefix(wasmType))
+	defer iterator.Close()
+
+	var history []types.OverlayVersion
+	for ; iterator.Valid(); iterator.Next() {
+		var version types.OverlayVersion
+		k.cdc.MustUnmarshal(iterator.Value(), &version)
+		history = append(history, version)
+	}
+	return history
+}
+
+// GetAllOverlayHistory returns the previously active versions of all
+// Overlay Wasm types.
+func (k Keeper) GetAllOverlayHistory(ctx sdk.Context) []types.OverlayVersion {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixOverlayHistory)
+	defer iterator.Close()
+
+	var history []types.OverlayVersion
+	for ; iterator.Valid(); iterator.Next() {
+		var version types.OverlayVersion
+		k.cdc.MustUnmarshal(iterator.Value(), &version)
+		history = append(history, version)
+	}
+	return history
+}
+
+// GetAllActiveOverlays returns the active versions of all Overlay Wasm
+// types.
+func (k Keeper) GetAllActiveOverlays(ctx sdk.Context) []types.OverlayVersion {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixActiveOverlay)
+	defer iterator.Close()
+
+	var versions []types.OverlayVersion
+	for ; iterator.Valid(); iterator.Next() {
+		var version types.OverlayVersion
+		k.cdc.MustUnmarshal(iterator.Value(), &version)
+		versions = append(versions, version)
+	}
+	return versions
+}
+
+// GetAllScheduledOverlays returns the scheduled versions of all Overlay
+// Wasm types.
+func (k Keeper) GetAllScheduledOverlays(ctx sdk.Context) []types.OverlayVersion {
+	var versions []types.OverlayVersion
+	k.IterateScheduledOverlays(ctx, func(version types.OverlayVersion) bool {
+		versions = append(versions, version)
+		return false
+	})
+	return versions
+}
+
+// IsOverlayInUse checks if an Overlay Wasm with a given hash is active
+// or scheduled for activation.
+func (k Keeper) IsOverlayInUse(ctx sdk.Context, wasmType types.WasmType, hash []byte) bool {
+	active := k.GetActiveOverlay(ctx, wasmType)
+	if active != nil && bytes.Equal(active.Hash, hash) {
+		return true
+	}
+	scheduled := k.GetScheduledOverlay(ctx, wasmType)
+	return scheduled != nil && bytes.Equal(scheduled.Hash, hash)
+}
+
+// ActivateOverlay makes a given version the active version of its
+// Overlay Wasm type, moving the currently active version into history.
+// Activating the Wasm that is already active leaves history unchanged,
+// so that a rollback does not restore the same Wasm.
+func (k Keeper) ActivateOverlay(ctx sdk.Context, version types.OverlayVersion) error {
+	active := k.GetActiveOverlay(ctx, version.WasmType)
+	if active != nil && !bytes.Equal(active.Hash, version.Hash) {
+		k.AppendOverlayHistory(ctx, *active)
+	}
+	k.SetActiveOverlay(ctx, version)
+
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventActivateOverlayWasm{
+			Hash:             hex.EncodeToString(version.Hash),
+			WasmType:         version.WasmType,
+			ActivationHeight: version.ActivationHeight,
+		})
+}
+
+// RollbackOverlay restores the most recent previously active version of
+// a given Overlay Wasm type and cancels any scheduled activation. The
+// version being rolled back is discarded rather than added to history.
+// It returns nil if there is no version to roll back to.
+func (k Keeper) RollbackOverlay(ctx sdk.Context, wasmType types.WasmType) (*types.OverlayVersion, error) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStoreReversePrefixIterator(store, types.GetOverlayHistoryPrefix(wasmType))
+	if !iterator.Valid() {
+		iterator.Close()
+		return nil, nil
+	}
+	key := iterator.Key()
+	var previous types.OverlayVersion
+	k.cdc.MustUnmarshal(iterator.Value(), &previous)
+	iterator.Close()
+
+	if !k.HasOverlayWasm(ctx, &types.Wasm{Hash: previous.Hash}) {
+		return nil, fmt.Errorf("previous version %s has been removed", hex.EncodeToString(previous.Hash))
+	}
+
+	store.Delete(key)
+	k.RemoveScheduledOverlay(ctx, wasmType)
+
+	restored := types.OverlayVersion{
+		Hash:             previous.Hash,
+		WasmType:         wasmType,
+		ActivationHeight: ctx.BlockHeight(),
+	}
+	k.SetActiveOverlay(ctx, restored)
+
+	err := ctx.EventManager().EmitTypedEvent(
+		&types.EventActivateOverlayWasm{
+			Hash:             hex.EncodeToString(restored.Hash),
+			WasmType:         restored.WasmType,
+			ActivationHeight: restored.ActivationHeight,
+		})
+	if err != nil {
+		return nil, err
+	}
+	return &restored, nil
+}
+
+// ActivateScheduledOverlays activates the scheduled Overlay Wasm versions
+// whose activation height has been reached.
+func (k Keeper) ActivateScheduledOverlays(ctx sdk.Context) error {
+	var due []types.OverlayVersion
+	k.IterateScheduledOverlays(ctx, func(version types.OverlayVersion) bool {
+		if version.ActivationHeight <= ctx.BlockHeight() {
+			due = append(due, version)
+		}
+		return false
+	})
+
+	for _, version := range due {
+		k.RemoveScheduledOverlay(ctx, version.WasmType)
+		if err := k.ActivateOverlay(ctx, version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
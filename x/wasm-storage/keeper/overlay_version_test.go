@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"github.com/sedaprotocol/seda-chain/x/wasm-storage/types"
+)
+
+func (s *KeeperTestSuite) TestActivateScheduledOverlays() {
+	s.SetupTest()
+	current := types.OverlayVersion{
+		Hash:             mockedByteArray,
+		WasmType:         types.WasmTypeRelayer,
+		ActivationHeight: 1,
+	}
+	next := types.OverlayVersion{
+		Hash:             append(mockedByteArray, 2),
+		WasmType:         types.WasmTypeRelayer,
+		ActivationHeight: 20,
+	}
+	s.wasmStorageKeeper.SetActiveOverlay(s.ctx, current)
+	s.wasmStorageKeeper.SetScheduledOverlay(s.ctx, next)
+
+	s.ctx = s.ctx.WithBlockHeight(19)
+	s.Require().NoError(s.wasmStorageKeeper.ActivateScheduledOverlays(s.ctx))
+	s.Require().Equal(current, *s.wasmStorageKeeper.GetActiveOverlay(s.ctx, types.WasmTypeRelayer))
+	s.Require().NotNil(s.wasmStorageKeeper.GetScheduledOverlay(s.ctx, types.WasmTypeRelayer))
+
+	s.ctx = s.ctx.WithBlockHeight(20)
+	s.Require().NoError(s.wasmStorageKeeper.ActivateScheduledOverlays(s.ctx))
+	s.Require().Equal(next, *s.wasmStorageKeeper.GetActiveOverlay(s.ctx, types.WasmTypeRelayer))
+	s.Require().Nil(s.wasmStorageKeeper.GetScheduledOverlay(s.ctx, types.WasmTypeRelayer))
+	s.Require().Equal([]types.OverlayVersion{current}, s.wasmStorageKeeper.GetOverlayHistory(s.ctx, types.WasmTypeRelayer))
+}
+
+func (s *KeeperTestSuite) TestOverlayHistoryAtSameHeight() {
+	s.SetupTest()
+	s.ctx = s.ctx.WithBlockHeight(5)
+	var versions []types.OverlayVersion
+	for i := byte(0); i < 3; i++ {
+		wasm := types.NewWasm(append(mockedByteArray, i), types.WasmTypeRelayer, s.ctx.BlockTime())
+		s.wasmStorageKeeper.SetOverlayWasm(s.ctx, wasm)
+		version := types.OverlayVersion{
+			Hash:             wasm.Hash,
+			WasmType:         types.WasmTypeRelayer,
+			ActivationHeight: s.ctx.BlockHeight(),
+		}
+		s.Require().NoError(s.wasmStorageKeeper.ActivateOverlay(s.ctx, version))
+		versions = append(versions, version)
+	}
+
+	// Versions activated at the same height do not overwrite each other
+	// in history.
+	s.Require().Equal([]types.OverlayVersion{versions[1], versions[0]}, s.wasmStorageKeeper.GetOverlayHistory(s.ctx, types.WasmTypeRelayer))
+
+	restored, err := s.wasmStorageKeeper.RollbackOverlay(s.ctx, types.WasmTypeRelayer)
+	s.Require().NoError(err)
+	s.Require().Equal(versions[1], *restored)
+	s.Require().NoError(s.wasmStorageKeeper.ActivateOverlay(s.ctx, versions[2]))
+	s.Require().Equal([]types.OverlayVersion{versions[1], versions[0]}, s.wasmStorageKeeper.GetOverlayHistory(s.ctx, types.WasmTypeRelayer))
+
+	restored, err = s.wasmStorageKeeper.RollbackOverlay(s.ctx, types.WasmTypeRelayer)
+	s.Require().NoError(err)
+	s.Require().Equal(versions[1], *restored)
+	restored, err = s.wasmStorageKeeper.RollbackOverlay(s.ctx, types.WasmTypeRelayer)
+	s.Require().NoError(err)
+	s.Require().Equal(versions[0], *restored)
+	s.Require().Empty(s.wasmStorageKeeper.GetOverlayHistory(s.ctx, types.WasmTypeRelayer))
+}
+
+func (s *KeeperTestSuite) TestActivateActiveOverlay() {
+	s.SetupTest()
+	wasm := types.NewWasm(mockedByteArray, types.WasmTypeRelayer, s.ctx.BlockTime())
+	s.wasmStorageKeeper.SetOverlayWasm(s.ctx, wasm)
+	version := types.OverlayVersion{
+		Hash:             wasm.Hash,
+		WasmType:         types.WasmTypeRelayer,
+		ActivationHeight: 5,
+	}
+	s.Require().NoError(s.wasmStorageKeeper.ActivateOverlay(s.ctx, version))
+
+	// Activating the active Wasm again does not add it to history.
+	again := version
+	again.ActivationHeight = 10
+	s.Require().NoError(s.wasmStorageKeeper.ActivateOverlay(s.ctx, again))
+	s.Require().Equal(again, *s.wasmStorageKeeper.GetActiveOverlay(s.ctx, types.WasmTypeRelayer))
+	s.Require().Empty(s.wasmStorageKeeper.GetOverlayHistory(s.ctx, types.WasmTypeRelayer))
+
+	restored, err := s.wasmStorageKeeper.RollbackOverlay(s.ctx, types.WasmTypeRelayer)
+	s.Require().NoError(err)
+	s.Require().Nil(restored)
+}
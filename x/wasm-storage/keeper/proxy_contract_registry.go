@@ -1,20 +1,147 @@
 package keeper
 
 import (
+	"fmt"
+	"slices"
+
+	storetypes "cosmossdk.io/store/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/sedaprotocol/seda-chain/x/wasm-storage/types"
 )
 
-// SetProxyContractRegistry stores Proxy Contract address.
-func (k Keeper) SetProxyContractRegistry(ctx sdk.Context, address sdk.AccAddress) {
+// SetProxyContract stores the address of a named Proxy Contract.
+func (k Keeper) SetProxyContract(ctx sdk.Context, name string, address sdk.AccAddress) {
 	store := ctx.KVStore(k.storeKey)
-	store.Set(types.KeyPrefixProxyContractRegistry, address.Bytes())
+	store.Set(types.GetProxyContractKey(name), address.Bytes())
 }
 
-// GetProxyContractRegistry returns Proxy Contract address.
-func (k Keeper) GetProxyContractRegistry(ctx sdk.Context) sdk.AccAddress {
+// GetProxyContract returns the address of a named Proxy Contract or
+// nil if there is no entry with the given name.
+func (k Keeper) GetProxyContract(ctx sdk.Context, name string) sdk.AccAddress {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.KeyPrefixProxyContractRegistry)
+	bz := store.Get(types.GetProxyContractKey(name))
+	if bz == nil {
+		return nil
+	}
 	return sdk.AccAddress(bz)
 }
+
+// HasProxyContract checks if a named Proxy Contract exists.
+func (k Keeper) HasProxyContract(ctx sdk.Context, name string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetProxyContractKey(name))
+}
+
+// RemoveProxyContract removes a named Proxy Contract.
+func (k Keeper) RemoveProxyContract(ctx sdk.Context, name string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetProxyContractKey(name))
+}
+
+// GetAllProxyContracts returns all entries of the Proxy Contract registry
+// ordered by name.
+func (k Keeper) GetAllProxyContracts(ctx sdk.Context) []types.ProxyContract {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixProxyContract)
+	defer iterator.Close()
+
+	var contracts []types.ProxyContract
+	for ; iterator.Valid(); iterator.Next() {
+		contracts = append(contracts, types.ProxyContract{
+			Name:    string(iterator.Key()[len(types.KeyPrefixProxyContract):]),
+			Address: sdk.AccAddress(iterator.Value()).String(),
+		})
+	}
+	return contracts
+}
+
+// ValidateProxyContractCodeID checks that a given code ID is allowed for
+// Proxy Contracts by the module parameters.
+func (k Keeper) ValidateProxyContractCodeID(ctx sdk.Context, codeID uint64) error {
+	allowed := k.GetParams(ctx).ProxyContractCodeIDs
+	if len(allowed) != 0 && !slices.Contains(allowed, codeID) {
+		return fmt.Errorf("code ID %d is not allowed for proxy contracts", codeID)
+	}
+	return nil
+}
+
+// ValidateProxyContractAdmin checks that a given CosmWasm admin matches
+// the Proxy Contract admin required by the module parameters, if any.
+func (k Keeper) ValidateProxyContractAdmin(ctx sdk.Context, admin string) error {
+	required := k.GetParams(ctx).ProxyContractAdmin
+	if required != "" && admin != required {
+		return fmt.Errorf("proxy contract admin must be %s, got %q", required, admin)
+	}
+	return nil
+}
+
+// ValidateProxyContract checks that a given address belongs to a live
+// CosmWasm contract whose code ID and admin are allowed for Proxy
+// Contracts.
+func (k Keeper) ValidateProxyContract(ctx sdk.Context, address sdk.AccAddress) error {
+	info := k.wasmViewKeeper.GetContractInfo(ctx, address)
+	if info == nil {
+		return fmt.Errorf("%s is not a contract address", address)
+	}
+	if err := k.ValidateProxyContractCodeID(ctx, info.CodeID); err != nil {
+		return err
+	}
+	return k.ValidateProxyContractAdmin(ctx, info.Admin)
+}
+
+// GetProxyContractRegistry returns the address of the data request Proxy
+// Contract.
+func (k Keeper) GetProxyContractRegistry(ctx sdk.Context) sdk.AccAddress {
+	return k.GetProxyContract(ctx, types.DefaultProxyContractName)
+}
+
+// AppendProxyContractChange records a change made to a named Proxy
+// Contract after all changes recorded so far.
+func (k Keeper) AppendProxyContractChange(ctx sdk.Context, change types.ProxyContractChange) {
+	store := ctx.KVStore(k.storeKey)
+
+	var seq uint64
+	iterator := storetypes.KVStoreReversePrefixIterator(store, types.GetProxyContractHistoryPrefix(change.Name))
+	if iterator.Valid() {
+		key := iterator.Key()
+		seq = sdk.BigEndianToUint64(key[len(key)-8:]) + 1
+	}
+	iterator.Close()
+
+	bz := k.cdc.MustMarshal(&change)
+	store.Set(types.GetProxyContractHistoryKey(change.Name, seq), bz)
+}
+
+// GetProxyContractHistory returns the changes made to a named Proxy
+// Contract, starting from the most recent one.
+func (k Keeper) GetProxyContractHistory(ctx sdk.Context, name string) []types.ProxyContractChange {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStoreReversePrefixIterator(store, types.GetProxyContractHistoryPrefix(name))
+	defer iterator.Close()
+
+	var history []types.ProxyContractChange
+	for ; iterator.Valid(); iterator.Next() {
+		var change types.ProxyContractChange
+		k.cdc.MustUnmarshal(iterator.Value(), &change)
+		history = append(history, change)
+	}
+	return history
+}
+
+// GetAllProxyContractHistory returns the changes made to all named Proxy
+// Contracts, with the changes of each name in the order they were made.
+func (k Keeper) GetAllProxyContractHistory(ctx sdk.Context) []types.ProxyContractChange {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixProxyContractHistory)
+	defer iterator.Close()
+
+	var history []types.ProxyContractChange
+	for ; iterator.Valid(); iterator.Next() {
+		var change types.ProxyContractChange
+		k.cdc.MustUnmarshal(iterator.Value(), &change)
+		history = append(history, change)
+	}
+	return history
+}
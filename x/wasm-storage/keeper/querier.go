@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"encoding/hex"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -57,9 +58,76 @@ func (q Querier) OverlayWasms(c context.Context, _ *types.QueryOverlayWasmsReque
 	}, nil
 }
 
+func (q Querier) WasmBytecode(c context.Context, req *types.QueryWasmBytecodeRequest) (*types.QueryWasmBytecodeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	hash, err := hex.DecodeString(req.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	var wasm *types.Wasm
+	switch {
+	case q.HasDataRequestWasm(ctx, &types.Wasm{Hash: hash}):
+		wasm = q.GetDataRequestWasm(ctx, hash)
+	case q.HasOverlayWasm(ctx, &types.Wasm{Hash: hash}):
+		wasm = q.GetOverlayWasm(ctx, hash)
+	default:
+		return nil, fmt.Errorf("wasm with hash %s does not exist", req.Hash)
+	}
+
+	size := uint64(len(wasm.Bytecode))
+	if req.Offset > size {
+		return nil, fmt.Errorf("offset %d exceeds bytecode size %d", req.Offset, size)
+	}
+	limit := req.Limit
+	if limit == 0 || limit > types.MaxBytecodeChunkSize {
+		limit = types.MaxBytecodeChunkSize
+	}
+	end := req.Offset + limit
+	if end > size {
+		end = size
+	}
+
+	return &types.QueryWasmBytecodeResponse{
+		Chunk:     wasm.Bytecode[req.Offset:end],
+		Offset:    req.Offset,
+		TotalSize: size,
+		WasmType:  wasm.WasmType,
+	}, nil
+}
+
+func (q Querier) ActiveOverlay(c context.Context, req *types.QueryActiveOverlayRequest) (*types.QueryActiveOverlayResponse, error) {
+	if req.WasmType != types.WasmTypeDataRequestExecutor && req.WasmType != types.WasmTypeRelayer {
+		return nil, fmt.Errorf("overlay Wasm type must be data-request-executor or relayer")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryActiveOverlayResponse{
+		Active:    q.GetActiveOverlay(ctx, req.WasmType),
+		Scheduled: q.GetScheduledOverlay(ctx, req.WasmType),
+		History:   q.GetOverlayHistory(ctx, req.WasmType),
+	}, nil
+}
+
 func (q Querier) ProxyContractRegistry(c context.Context, _ *types.QueryProxyContractRegistryRequest) (*types.QueryProxyContractRegistryResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	return &types.QueryProxyContractRegistryResponse{
 		Address: q.GetProxyContractRegistry(ctx).String(),
 	}, nil
 }
+
+func (q Querier) ProxyContracts(c context.Context, _ *types.QueryProxyContractsRequest) (*types.QueryProxyContractsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryProxyContractsResponse{
+		ProxyContracts: q.GetAllProxyContracts(ctx),
+	}, nil
+}
+
+func (q Querier) ProxyContractHistory(c context.Context, req *types.QueryProxyContractHistoryRequest) (*types.QueryProxyContractHistoryResponse, error) {
+	if err := types.ValidateProxyContractName(req.Name); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryProxyContractHistoryResponse{
+		History: q.GetProxyContractHistory(ctx, req.Name),
+	}, nil
+}
@@ -5,7 +5,12 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/ethereum/go-ethereum/crypto"
+
 	"github.com/CosmWasm/wasmd/x/wasm/ioutils"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/sedaprotocol/seda-chain/x/wasm-storage/types"
 )
@@ -67,9 +72,7 @@ func (s *KeeperTestSuite) TestDataRequestWasms() {
 	storedWasm, err := s.msgSrvr.StoreDataRequestWasm(s.ctx, &input)
 	s.Require().NoError(err)
 
-	wasm2, err := os.ReadFile("test_utils/cowsay.wasm")
-	s.Require().NoError(err)
-	compWasm2, err := ioutils.GzipIt(wasm2)
+	compWasm2, err := ioutils.GzipIt(wasmHTTPFetch)
 	s.Require().NoError(err)
 	input2 := types.MsgStoreDataRequestWasm{
 		Sender:   s.authority,
@@ -83,8 +86,8 @@ func (s *KeeperTestSuite) TestDataRequestWasms() {
 	res, err := s.queryClient.DataRequestWasms(s.ctx, &req)
 	s.Require().NoError(err)
 	s.Require().NotNil(res)
-	s.Require().Equal(fmt.Sprintf("%s,%s", storedWasm.Hash, "WASM_TYPE_DATA_REQUEST"), res.HashTypePairs[0])
-	s.Require().Equal(fmt.Sprintf("%s,%s", storedWasm2.Hash, "WASM_TYPE_DATA_REQUEST"), res.HashTypePairs[1])
+	s.Require().Equal(fmt.Sprintf("%s,%s", storedWasm2.Hash, "WASM_TYPE_DATA_REQUEST"), res.HashTypePairs[0])
+	s.Require().Equal(fmt.Sprintf("%s,%s", storedWasm.Hash, "WASM_TYPE_DATA_REQUEST"), res.HashTypePairs[1])
 }
 
 func (s *KeeperTestSuite) TestOverlayWasms() {
@@ -121,3 +124,108 @@ func (s *KeeperTestSuite) TestOverlayWasms() {
 	s.Require().Equal(fmt.Sprintf("%s,%s", storedWasm.Hash, "WASM_TYPE_RELAYER"), res.HashTypePairs[0])
 	s.Require().Equal(fmt.Sprintf("%s,%s", storedWasm2.Hash, "WASM_TYPE_RELAYER"), res.HashTypePairs[1])
 }
+
+func (s *KeeperTestSuite) TestActiveOverlay() {
+	s.SetupTest()
+	mockWasm := &types.Wasm{
+		Hash:     crypto.Keccak256(mockedByteArray),
+		Bytecode: mockedByteArray,
+		WasmType: types.WasmTypeDataRequestExecutor,
+	}
+	s.wasmStorageKeeper.SetOverlayWasm(s.ctx, mockWasm)
+	_, err := s.msgSrvr.ActivateOverlayWasm(s.ctx, &types.MsgActivateOverlayWasm{
+		Authority: s.authority,
+		Hash:      hex.EncodeToString(mockWasm.Hash),
+	})
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.ActiveOverlay(s.ctx, &types.QueryActiveOverlayRequest{
+		WasmType: types.WasmTypeDataRequestExecutor,
+	})
+	s.Require().NoError(err)
+	s.Require().NotNil(res.Active)
+	s.Require().Equal(mockWasm.Hash, res.Active.Hash)
+	s.Require().Nil(res.Scheduled)
+	s.Require().Empty(res.History)
+
+	res, err = s.queryClient.ActiveOverlay(s.ctx, &types.QueryActiveOverlayRequest{
+		WasmType: types.WasmTypeRelayer,
+	})
+	s.Require().NoError(err)
+	s.Require().Nil(res.Active)
+
+	_, err = s.queryClient.ActiveOverlay(s.ctx, &types.QueryActiveOverlayRequest{
+		WasmType: types.WasmTypeDataRequest,
+	})
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestWasmBytecode() {
+	s.SetupTest()
+	wasm, err := os.ReadFile("test_utils/hello-world.wasm")
+	s.Require().NoError(err)
+	compWasm, err := ioutils.GzipIt(wasm)
+	s.Require().NoError(err)
+
+	stored, err := s.msgSrvr.StoreDataRequestWasm(s.ctx, &types.MsgStoreDataRequestWasm{
+		Sender:   s.authority,
+		Wasm:     compWasm,
+		WasmType: types.WasmTypeDataRequest,
+	})
+	s.Require().NoError(err)
+
+	var bytecode []byte
+	for {
+		res, err := s.queryClient.WasmBytecode(s.ctx, &types.QueryWasmBytecodeRequest{
+			Hash:   stored.Hash,
+			Offset: uint64(len(bytecode)),
+			Limit:  1000,
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(len(wasm)), res.TotalSize)
+		s.Require().Equal(types.WasmTypeDataRequest, res.WasmType)
+		s.Require().LessOrEqual(len(res.Chunk), 1000)
+		bytecode = append(bytecode, res.Chunk...)
+		if uint64(len(bytecode)) == res.TotalSize {
+			break
+		}
+	}
+	s.Require().Equal(wasm, bytecode)
+
+	_, err = s.queryClient.WasmBytecode(s.ctx, &types.QueryWasmBytecodeRequest{
+		Hash:   stored.Hash,
+		Offset: uint64(len(wasm)) + 1,
+	})
+	s.Require().ErrorContains(err, "exceeds bytecode size")
+
+	_, err = s.queryClient.WasmBytecode(s.ctx, &types.QueryWasmBytecodeRequest{
+		Hash: hex.EncodeToString(crypto.Keccak256(mockedByteArray)),
+	})
+	s.Require().ErrorContains(err, "does not exist")
+}
+
+func (s *KeeperTestSuite) TestProxyContracts() {
+	s.SetupTest()
+	addr := sdk.AccAddress(mockedByteArray[:20]).String()
+	s.wasmViewKeeper.contracts[addr] = &wasmtypes.ContractInfo{CodeID: 1}
+	_, err := s.msgSrvr.RegisterProxyContract(s.ctx, &types.MsgRegisterProxyContract{
+		Authority: s.authority,
+		Name:      types.DefaultProxyContractName,
+		Address:   addr,
+	})
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.ProxyContracts(s.ctx, &types.QueryProxyContractsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.ProxyContract{{Name: types.DefaultProxyContractName, Address: addr}}, res.ProxyContracts)
+
+	registry, err := s.queryClient.ProxyContractRegistry(s.ctx, &types.QueryProxyContractRegistryRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(addr, registry.Address)
+
+	history, err := s.queryClient.ProxyContractHistory(s.ctx, &types.QueryProxyContractHistoryRequest{
+		Name: types.DefaultProxyContractName,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(history.History, 1)
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sedaprotocol/seda-chain/x/wasm-storage/types"
+)
+
+// NewMessageEncoderOption returns the wasm keeper option that lets
+// CosmWasm contracts send wasm-storage messages as custom messages.
+func NewMessageEncoderOption() wasmkeeper.Option {
+	return wasmkeeper.WithMessageEncoders(&wasmkeeper.MessageEncoders{
+		Custom: EncodeCustomMsg,
+	})
+}
+
+// EncodeCustomMsg encodes a wasm-storage custom message sent by a given
+// contract into SDK messages signed by the contract.
+func EncodeCustomMsg(sender sdk.AccAddress, msg json.RawMessage) ([]sdk.Msg, error) {
+	var customMsg types.WasmStorageMsg
+	if err := json.Unmarshal(msg, &customMsg); err != nil {
+		return nil, fmt.Errorf("invalid wasm-storage message: %s", err)
+	}
+
+	switch {
+	case customMsg.StoreDataRequestWasm != nil:
+		sdkMsg := &types.MsgStoreDataRequestWasm{
+			Sender:   sender.String(),
+			Wasm:     customMsg.StoreDataRequestWasm.Wasm,
+			WasmType: types.WasmTypeFromString(customMsg.StoreDataRequestWasm.WasmType),
+		}
+		if err := sdkMsg.ValidateBasic(); err != nil {
+			return nil, err
+		}
+		return []sdk.Msg{sdkMsg}, nil
+	default:
+		return nil, fmt.Errorf("unknown wasm-storage message variant")
+	}
+}
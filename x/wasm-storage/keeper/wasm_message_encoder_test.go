@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"os"
+
+	"github.com/CosmWasm/wasmd/x/wasm/ioutils"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sedaprotocol/seda-chain/x/wasm-storage/keeper"
+	"github.com/sedaprotocol/seda-chain/x/wasm-storage/types"
+)
+
+func (s *KeeperTestSuite) TestEncodeCustomMsg() {
+	wasm, err := os.ReadFile("test_utils/hello-world.wasm")
+	s.Require().NoError(err)
+	compWasm, err := ioutils.GzipIt(wasm)
+	s.Require().NoError(err)
+	encodedWasm := base64.StdEncoding.EncodeToString(compWasm)
+	contractAddr := sdk.AccAddress(mockedByteArray[:20])
+
+	cases := []struct {
+		name      string
+		msg       string
+		expErrMsg string
+	}{
+		{
+			name: "store data request wasm",
+			msg:  `{"store_data_request_wasm":{"wasm":"` + encodedWasm + `","wasm_type":"data-request"}}`,
+		},
+		{
+			name:      "overlay wasm type",
+			msg:       `{"store_data_request_wasm":{"wasm":"` + encodedWasm + `","wasm_type":"relayer"}}`,
+			expErrMsg: "data Request Wasm type must be data-request or tally",
+		},
+		{
+			name:      "unknown variant",
+			msg:       `{"store_overlay_wasm":{}}`,
+			expErrMsg: "unknown wasm-storage message variant",
+		},
+	}
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			msgs, err := keeper.EncodeCustomMsg(contractAddr, json.RawMessage(tc.msg))
+			if tc.expErrMsg != "" {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Len(msgs, 1)
+
+			msg, ok := msgs[0].(*types.MsgStoreDataRequestWasm)
+			s.Require().True(ok)
+			s.Require().Equal(contractAddr.String(), msg.Sender)
+
+			res, err := s.msgSrvr.StoreDataRequestWasm(s.ctx, msg)
+			s.Require().NoError(err)
+			s.Require().True(s.wasmStorageKeeper.HasDataRequestWasm(s.ctx, types.NewWasm(wasm, types.WasmTypeDataRequest, s.ctx.BlockTime())))
+			s.Require().NotEmpty(res.Hash)
+		})
+	}
+}
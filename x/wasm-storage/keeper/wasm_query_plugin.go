@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sedaprotocol/seda-chain/x/wasm-storage/types"
+)
+
+// NewCustomQuerier returns a CosmWasm custom querier that answers
+// wasm-storage queries. The keeper is taken by reference so that the
+// querier can be created before the wasm-storage keeper, which itself
+// depends on the wasm keeper.
+func NewCustomQuerier(k *Keeper) wasmkeeper.CustomQuerier {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var query types.WasmStorageQuery
+		if err := json.Unmarshal(request, &query); err != nil {
+			return nil, fmt.Errorf("invalid wasm-storage query: %s", err)
+		}
+		if set := countSet(query.DataRequestWasmExists != nil, query.WasmInfo != nil, query.ProxyContract != nil); set != 1 {
+			return nil, fmt.Errorf("wasm-storage query must set exactly one variant, got %d", set)
+		}
+
+		var res interface{}
+		var err error
+		switch {
+		case query.DataRequestWasmExists != nil:
+			res, err = k.queryDataRequestWasmExists(ctx, query.DataRequestWasmExists)
+		case query.WasmInfo != nil:
+			res, err = k.queryWasmInfo(ctx, query.WasmInfo)
+		case query.ProxyContract != nil:
+			res, err = k.queryProxyContract(ctx, query.ProxyContract)
+		default:
+			return nil, fmt.Errorf("unknown wasm-storage query variant")
+		}
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(res)
+	}
+}
+
+func (k Keeper) queryDataRequestWasmExists(ctx sdk.Context, query *types.DataRequestWasmExistsQuery) (*types.DataRequestWasmExistsResponse, error) {
+	hash, err := hex.DecodeString(query.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hash: %s", err)
+	}
+	if !k.HasDataRequestWasm(ctx, &types.Wasm{Hash: hash}) {
+		return &types.DataRequestWasmExistsResponse{Exists: false}, nil
+	}
+	wasm := k.GetDataRequestWasm(ctx, hash)
+	return &types.DataRequestWasmExistsResponse{
+		Exists:   true,
+		WasmType: wasm.WasmType.String(),
+	}, nil
+}
+
+func (k Keeper) queryWasmInfo(ctx sdk.Context, query *types.WasmInfoQuery) (*types.WasmInfoResponse, error) {
+	hash, err := hex.DecodeString(query.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hash: %s", err)
+	}
+
+	var wasm *types.Wasm
+	switch {
+	case k.HasDataRequestWasm(ctx, &types.Wasm{Hash: hash}):
+		wasm = k.getWasmMetadata(ctx, types.GetDataRequestWasmKey(hash))
+	case k.HasOverlayWasm(ctx, &types.Wasm{Hash: hash}):
+		wasm = k.getWasmMetadata(ctx, types.GetOverlayWasmKey(hash))
+	default:
+		return nil, fmt.Errorf("wasm with hash %s does not exist", query.Hash)
+	}
+
+	return &types.WasmInfoResponse{
+		Hash:     query.Hash,
+		WasmType: wasm.WasmType.String(),
+		Size:     wasm.Size_,
+		AddedAt:  wasm.AddedAt.Unix(),
+	}, nil
+}
+
+func (k Keeper) queryProxyContract(ctx sdk.Context, query *types.ProxyContractQuery) (*types.ProxyContractResponse, error) {
+	name := query.Name
+	if name == "" {
+		name = types.DefaultProxyContractName
+	}
+	address := k.GetProxyContract(ctx, name)
+	if address == nil {
+		return nil, fmt.Errorf("proxy contract %s is not registered", name)
+	}
+	return &types.ProxyContractResponse{
+		Address: address.String(),
+	}, nil
+}
+
+// countSet returns the number of given conditions that hold.
+func countSet(conds ...bool) int {
+	n := 0
+	for _, cond := range conds {
+		if cond {
+			n++
+		}
+	}
+	return n
+}
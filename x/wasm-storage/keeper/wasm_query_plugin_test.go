@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sedaprotocol/seda-chain/x/wasm-storage/keeper"
+	"github.com/sedaprotocol/seda-chain/x/wasm-storage/types"
+)
+
+func (s *KeeperTestSuite) TestCustomQuerier() {
+	s.SetupTest()
+	drWasm := types.NewWasm(mockedByteArray, types.WasmTypeTally, s.ctx.BlockTime())
+	s.wasmStorageKeeper.SetDataRequestWasm(s.ctx, drWasm)
+	overlayWasm := types.NewWasm(mockedByteArray2, types.WasmTypeRelayer, s.ctx.BlockTime())
+	s.wasmStorageKeeper.SetOverlayWasm(s.ctx, overlayWasm)
+	proxyAddr := sdk.AccAddress(mockedByteArray[:20])
+	s.wasmStorageKeeper.SetProxyContract(s.ctx, types.DefaultProxyContractName, proxyAddr)
+
+	drHash := hex.EncodeToString(drWasm.Hash)
+	overlayHash := hex.EncodeToString(overlayWasm.Hash)
+	missingHash := hex.EncodeToString(crypto.Keccak256([]byte("missing")))
+
+	cases := []struct {
+		name      string
+		request   string
+		expected  interface{}
+		expErrMsg string
+	}{
+		{
+			name:    "data request wasm exists",
+			request: `{"data_request_wasm_exists":{"hash":"` + drHash + `"}}`,
+			expected: types.DataRequestWasmExistsResponse{
+				Exists:   true,
+				WasmType: "WASM_TYPE_TALLY",
+			},
+		},
+		{
+			name:     "overlay wasm is not a data request wasm",
+			request:  `{"data_request_wasm_exists":{"hash":"` + overlayHash + `"}}`,
+			expected: types.DataRequestWasmExistsResponse{Exists: false},
+		},
+		{
+			name:    "wasm info",
+			request: `{"wasm_info":{"hash":"` + overlayHash + `"}}`,
+			expected: types.WasmInfoResponse{
+				Hash:     overlayHash,
+				WasmType: "WASM_TYPE_RELAYER",
+				Size:     uint64(len(mockedByteArray2)),
+				AddedAt:  s.ctx.BlockTime().Unix(),
+			},
+		},
+		{
+			name:      "wasm info of missing wasm",
+			request:   `{"wasm_info":{"hash":"` + missingHash + `"}}`,
+			expErrMsg: "does not exist",
+		},
+		{
+			name:     "default proxy contract",
+			request:  `{"proxy_contract":{}}`,
+			expected: types.ProxyContractResponse{Address: proxyAddr.String()},
+		},
+		{
+			name:      "unregistered proxy contract",
+			request:   `{"proxy_contract":{"name":"staking"}}`,
+			expErrMsg: "proxy contract staking is not registered",
+		},
+		{
+			name:      "unknown variant",
+			request:   `{"params":{}}`,
+			expErrMsg: "wasm-storage query must set exactly one variant, got 0",
+		},
+		{
+			name:      "multiple variants",
+			request:   `{"wasm_info":{"hash":"` + overlayHash + `"},"proxy_contract":{}}`,
+			expErrMsg: "wasm-storage query must set exactly one variant, got 2",
+		},
+	}
+
+	querier := keeper.NewCustomQuerier(s.wasmStorageKeeper)
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			res, err := querier(s.ctx, json.RawMessage(tc.request))
+			if tc.expErrMsg != "" {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+			expected, err := json.Marshal(tc.expected)
+			s.Require().NoError(err)
+			s.Require().JSONEq(string(expected), string(res))
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"encoding/hex"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sedaprotocol/seda-chain/x/wasm-storage/types"
+)
+
+// SetWasmUpload stores a pending chunked upload.
+func (k Keeper) SetWasmUpload(ctx sdk.Context, upload types.WasmUpload) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&upload)
+	store.Set(types.GetWasmUploadKey(sdk.MustAccAddressFromBech32(upload.Uploader), upload.Hash), bz)
+}
+
+// GetWasmUpload returns a pending chunked upload given its uploader
+// and hash or nil if there is none.
+func (k Keeper) GetWasmUpload(ctx sdk.Context, uploader sdk.AccAddress, hash []byte) *types.WasmUpload {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetWasmUploadKey(uploader, hash))
+	if bz == nil {
+		return nil
+	}
+	var upload types.WasmUpload
+	k.cdc.MustUnmarshal(bz, &upload)
+	return &upload
+}
+
+// BeginWasmUpload stores a new pending chunked upload and queues it for
+// expiry.
+func (k Keeper) BeginWasmUpload(ctx sdk.Context, upload types.WasmUpload) {
+	k.SetWasmUpload(ctx, upload)
+
+	store := ctx.KVStore(k.storeKey)
+	uploader := sdk.MustAccAddressFromBech32(upload.Uploader)
+	store.Set(types.GetWasmUploadQueueKey(upload.ExpirationHeight, uploader, upload.Hash), types.GetWasmUploadKey(uploader, upload.Hash))
+}
+
+// AppendWasmUploadChunk stores the next chunk of a pending chunked
+// upload and updates its progress.
+func (k Keeper) AppendWasmUploadChunk(ctx sdk.Context, upload *types.WasmUpload, chunk []byte) {
+	store := ctx.KVStore(k.storeKey)
+	uploader := sdk.MustAccAddressFromBech32(upload.Uploader)
+	store.Set(types.GetWasmUploadChunkKey(uploader, upload.Hash, upload.Chunks), chunk)
+
+	upload.Chunks++
+	upload.Received += uint64(len(chunk))
+	k.SetWasmUpload(ctx, *upload)
+}
+
+// GetWasmUploadBytes returns the concatenated chunks of a pending
+// chunked upload.
+func (k Keeper) GetWasmUploadBytes(ctx sdk.Context, upload *types.WasmUpload) []byte {
+	store := ctx.KVStore(k.storeKey)
+	uploader := sdk.MustAccAddressFromBech32(upload.Uploader)
+	iterator := storetypes.KVStorePrefixIterator(store, types.GetWasmUploadChunkPrefix(uploader, upload.Hash))
+	defer iterator.Close()
+
+	bz := make([]byte, 0, upload.Received)
+	for ; iterator.Valid(); iterator.Next() {
+		bz = append(bz, iterator.Value()...)
+	}
+	return bz
+}
+
+// RemoveWasmUpload removes a pending chunked upload along with its
+// chunks and its item in the expiry queue.
+func (k Keeper) RemoveWasmUpload(ctx sdk.Context, upload *types.WasmUpload) {
+	store := ctx.KVStore(k.storeKey)
+	uploader := sdk.MustAccAddressFromBech32(upload.Uploader)
+
+	for i := uint64(0); i < upload.Chunks; i++ {
+		store.Delete(types.GetWasmUploadChunkKey(uploader, upload.Hash, i))
+	}
+	store.Delete(types.GetWasmUploadQueueKey(upload.ExpirationHeight, uploader, upload.Hash))
+	store.Delete(types.GetWasmUploadKey(uploader, upload.Hash))
+}
+
+// ExpireWasmUploads discards the pending chunked uploads whose
+// expiration height has been reached.
+func (k Keeper) ExpireWasmUploads(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(
+		types.KeyPrefixWasmUploadQueue,
+		types.GetWasmUploadQueuePrefix(ctx.BlockHeight()+1),
+	)
+
+	var expired []types.WasmUpload
+	for ; iterator.Valid(); iterator.Next() {
+		// The value of a queue item is the key of the upload.
+		var upload types.WasmUpload
+		k.cdc.MustUnmarshal(store.Get(iterator.Value()), &upload)
+		expired = append(expired, upload)
+	}
+	iterator.Close()
+
+	for i := range expired {
+		k.RemoveWasmUpload(ctx, &expired[i])
+		err := ctx.EventManager().EmitTypedEvent(
+			&types.EventExpireWasmUpload{
+				Hash:     hex.EncodeToString(expired[i].Hash),
+				Uploader: expired[i].Uploader,
+			})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
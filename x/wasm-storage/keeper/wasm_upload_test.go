@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+
+	"github.com/ethereum/go-ethereum/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sedaprotocol/seda-chain/x/wasm-storage/types"
+)
+
+func (s *KeeperTestSuite) TestExpireWasmUploads() {
+	s.SetupTest()
+	uploader := "cosmos16wfryel63g7axeamw68630wglalcnk3l0zuadc"
+	uploaderAddr := sdk.MustAccAddressFromBech32(uploader)
+	first := hex.EncodeToString(crypto.Keccak256(mockedByteArray))
+	second := hex.EncodeToString(crypto.Keccak256(mockedByteArray2))
+
+	s.ctx = s.ctx.WithBlockHeight(1)
+	_, err := s.msgSrvr.BeginWasmUpload(s.ctx, &types.MsgBeginWasmUpload{
+		Sender:     uploader,
+		Hash:       first,
+		UploadSize: uint64(len(mockedByteArray)),
+		WasmType:   types.WasmTypeDataRequest,
+	})
+	s.Require().NoError(err)
+	_, err = s.msgSrvr.UploadWasmChunk(s.ctx, &types.MsgUploadWasmChunk{
+		Sender: uploader,
+		Hash:   first,
+		Chunk:  mockedByteArray,
+	})
+	s.Require().NoError(err)
+
+	s.ctx = s.ctx.WithBlockHeight(2)
+	_, err = s.msgSrvr.BeginWasmUpload(s.ctx, &types.MsgBeginWasmUpload{
+		Sender:     uploader,
+		Hash:       second,
+		UploadSize: uint64(len(mockedByteArray2)),
+		WasmType:   types.WasmTypeDataRequest,
+	})
+	s.Require().NoError(err)
+
+	s.ctx = s.ctx.WithBlockHeight(types.WasmUploadExpiryBlocks)
+	s.Require().NoError(s.wasmStorageKeeper.ExpireWasmUploads(s.ctx))
+	s.Require().NotNil(s.wasmStorageKeeper.GetWasmUpload(s.ctx, uploaderAddr, crypto.Keccak256(mockedByteArray)))
+	s.Require().NotNil(s.wasmStorageKeeper.GetWasmUpload(s.ctx, uploaderAddr, crypto.Keccak256(mockedByteArray2)))
+
+	s.ctx = s.ctx.WithBlockHeight(1 + types.WasmUploadExpiryBlocks)
+	s.Require().NoError(s.wasmStorageKeeper.ExpireWasmUploads(s.ctx))
+	s.Require().Nil(s.wasmStorageKeeper.GetWasmUpload(s.ctx, uploaderAddr, crypto.Keccak256(mockedByteArray)))
+	s.Require().NotNil(s.wasmStorageKeeper.GetWasmUpload(s.ctx, uploaderAddr, crypto.Keccak256(mockedByteArray2)))
+
+	// The expired upload can be started over.
+	_, err = s.msgSrvr.BeginWasmUpload(s.ctx, &types.MsgBeginWasmUpload{
+		Sender:     uploader,
+		Hash:       first,
+		UploadSize: uint64(len(mockedByteArray)),
+		WasmType:   types.WasmTypeDataRequest,
+	})
+	s.Require().NoError(err)
+	res, err := s.msgSrvr.UploadWasmChunk(s.ctx, &types.MsgUploadWasmChunk{
+		Sender: uploader,
+		Hash:   first,
+		Chunk:  mockedByteArray[:1],
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), res.Received)
+}
@@ -22,11 +22,16 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// The msg for storing a data request wasm.
+// The msg for storing a data request wasm. The bytecode
+// itself is not included and can be fetched with the WasmBytecode query.
 type EventStoreDataRequestWasm struct {
 	Hash     string   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 	WasmType WasmType `protobuf:"varint,2,opt,name=wasm_type,json=wasmType,proto3,enum=sedachain.wasm_storage.v1.WasmType" json:"wasm_type,omitempty"`
-	Bytecode []byte   `protobuf:"bytes,3,opt,name=bytecode,proto3" json:"bytecode,omitempty"`
+	// bytecode_size is the size of the uncompressed bytecode in bytes.
+	BytecodeSize uint64     `protobuf:"varint,4,opt,name=bytecode_size,json=bytecodeSize,proto3" json:"bytecode_size,omitempty"`
+	Uploader     string     `protobuf:"bytes,5,opt,name=uploader,proto3" json:"uploader,omitempty"`
+	Height       int64      `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	Format       WasmFormat `protobuf:"varint,7,opt,name=format,proto3,enum=sedachain.wasm_storage.v1.WasmFormat" json:"format,omitempty"`
 }
 
 func (m *EventStoreDataRequestWasm) Reset()         { *m = EventStoreDataRequestWasm{} }
@@ -76,18 +81,44 @@ func (m *EventStoreDataRequestWasm) GetWasmType() WasmType {
 	return WasmTypeNil
 }
 
-func (m *EventStoreDataRequestWasm) GetBytecode() []byte {
+func (m *EventStoreDataRequestWasm) GetBytecodeSize() uint64 {
 	if m != nil {
-		return m.Bytecode
+		return m.BytecodeSize
 	}
-	return nil
+	return 0
+}
+
+func (m *EventStoreDataRequestWasm) GetUploader() string {
+	if m != nil {
+		return m.Uploader
+	}
+	return ""
+}
+
+func (m *EventStoreDataRequestWasm) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *EventStoreDataRequestWasm) GetFormat() WasmFormat {
+	if m != nil {
+		return m.Format
+	}
+	return WasmFormatNil
 }
 
-// The msg for storing a overlay wasm(i.e. relayer or executor)
+// The msg for storing a overlay wasm(i.e. relayer or executor) The bytecode
+// itself is not included and can be fetched with the WasmBytecode query.
 type EventStoreOverlayWasm struct {
 	Hash     string   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 	WasmType WasmType `protobuf:"varint,2,opt,name=wasm_type,json=wasmType,proto3,enum=sedachain.wasm_storage.v1.WasmType" json:"wasm_type,omitempty"`
-	Bytecode []byte   `protobuf:"bytes,3,opt,name=bytecode,proto3" json:"bytecode,omitempty"`
+	// bytecode_size is the size of the uncompressed bytecode in bytes.
+	BytecodeSize uint64     `protobuf:"varint,4,opt,name=bytecode_size,json=bytecodeSize,proto3" json:"bytecode_size,omitempty"`
+	Uploader     string     `protobuf:"bytes,5,opt,name=uploader,proto3" json:"uploader,omitempty"`
+	Height       int64      `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	Format       WasmFormat `protobuf:"varint,7,opt,name=format,proto3,enum=sedachain.wasm_storage.v1.WasmFormat" json:"format,omitempty"`
 }
 
 func (m *EventStoreOverlayWasm) Reset()         { *m = EventStoreOverlayWasm{} }
@@ -137,185 +168,2322 @@ func (m *EventStoreOverlayWasm) GetWasmType() WasmType {
 	return WasmTypeNil
 }
 
-func (m *EventStoreOverlayWasm) GetBytecode() []byte {
+func (m *EventStoreOverlayWasm) GetBytecodeSize() uint64 {
 	if m != nil {
-		return m.Bytecode
+		return m.BytecodeSize
 	}
-	return nil
+	return 0
 }
 
-func init() {
-	proto.RegisterType((*EventStoreDataRequestWasm)(nil), "sedachain.wasm_storage.v1.EventStoreDataRequestWasm")
-	proto.RegisterType((*EventStoreOverlayWasm)(nil), "sedachain.wasm_storage.v1.EventStoreOverlayWasm")
+func (m *EventStoreOverlayWasm) GetUploader() string {
+	if m != nil {
+		return m.Uploader
+	}
+	return ""
 }
 
-func init() {
-	proto.RegisterFile("sedachain/wasm_storage/v1/events.proto", fileDescriptor_90b0422555944a3a)
+func (m *EventStoreOverlayWasm) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-var fileDescriptor_90b0422555944a3a = []byte{
-	// 267 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2b, 0x4e, 0x4d, 0x49,
-	0x4c, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x2f, 0x4f, 0x2c, 0xce, 0x8d, 0x2f, 0x2e, 0xc9, 0x2f, 0x4a,
-	0x4c, 0x4f, 0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0x29, 0xd6, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x92, 0x84, 0xab, 0xd3, 0x43, 0x56, 0xa7, 0x57, 0x66, 0x28, 0xa5, 0x83, 0xdb,
-	0x08, 0x14, 0xa5, 0x60, 0x83, 0x94, 0x7a, 0x19, 0xb9, 0x24, 0x5d, 0x41, 0x26, 0x07, 0x97, 0xe4,
-	0x17, 0xa5, 0xba, 0x24, 0x96, 0x24, 0x06, 0xa5, 0x16, 0x96, 0xa6, 0x16, 0x97, 0x84, 0x27, 0x16,
-	0xe7, 0x0a, 0x09, 0x71, 0xb1, 0x64, 0x24, 0x16, 0x67, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x06,
-	0x81, 0xd9, 0x42, 0x0e, 0x5c, 0x9c, 0x60, 0x73, 0x4a, 0x2a, 0x0b, 0x52, 0x25, 0x98, 0x14, 0x18,
-	0x35, 0xf8, 0x8c, 0x94, 0xf5, 0x70, 0x3a, 0x47, 0x0f, 0x64, 0x4e, 0x48, 0x65, 0x41, 0x6a, 0x10,
-	0x47, 0x39, 0x94, 0x25, 0x24, 0xc5, 0xc5, 0x91, 0x54, 0x59, 0x92, 0x9a, 0x9c, 0x9f, 0x92, 0x2a,
-	0xc1, 0xac, 0xc0, 0xa8, 0xc1, 0x13, 0x04, 0xe7, 0x2b, 0x75, 0x32, 0x72, 0x89, 0x22, 0xdc, 0xe3,
-	0x5f, 0x96, 0x5a, 0x94, 0x93, 0x58, 0x39, 0x30, 0x6e, 0x71, 0x0a, 0x3c, 0xf1, 0x48, 0x8e, 0xf1,
-	0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e,
-	0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xf3, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc,
-	0x5c, 0x7d, 0x90, 0x75, 0xe0, 0xb0, 0x4c, 0xce, 0xcf, 0x01, 0x73, 0x74, 0x21, 0x81, 0x5f, 0x01,
-	0x0e, 0x6e, 0x5d, 0x58, 0xf0, 0x83, 0x9c, 0x58, 0x9c, 0xc4, 0x06, 0x56, 0x69, 0x0c, 0x08, 0x00,
-	0x00, 0xff, 0xff, 0xc5, 0xe7, 0x1b, 0x54, 0xe8, 0x01, 0x00, 0x00,
+func (m *EventStoreOverlayWasm) GetFormat() WasmFormat {
+	if m != nil {
+		return m.Format
+	}
+	return WasmFormatNil
 }
 
-func (m *EventStoreDataRequestWasm) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// The msg for beginning a chunked wasm upload.
+type EventBeginWasmUpload struct {
+	Hash     string   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	WasmType WasmType `protobuf:"varint,2,opt,name=wasm_type,json=wasmType,proto3,enum=sedachain.wasm_storage.v1.WasmType" json:"wasm_type,omitempty"`
+	Uploader string   `protobuf:"bytes,3,opt,name=uploader,proto3" json:"uploader,omitempty"`
+	// upload_size is the expected total size of the uploaded chunks in bytes.
+	UploadSize       uint64 `protobuf:"varint,4,opt,name=upload_size,json=uploadSize,proto3" json:"upload_size,omitempty"`
+	ExpirationHeight int64  `protobuf:"varint,5,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+}
+
+func (m *EventBeginWasmUpload) Reset()         { *m = EventBeginWasmUpload{} }
+func (m *EventBeginWasmUpload) String() string { return proto.CompactTextString(m) }
+func (*EventBeginWasmUpload) ProtoMessage()    {}
+func (*EventBeginWasmUpload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90b0422555944a3a, []int{2}
+}
+func (m *EventBeginWasmUpload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBeginWasmUpload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBeginWasmUpload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *EventBeginWasmUpload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBeginWasmUpload.Merge(m, src)
+}
+func (m *EventBeginWasmUpload) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBeginWasmUpload) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBeginWasmUpload.DiscardUnknown(m)
 }
 
-func (m *EventStoreDataRequestWasm) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_EventBeginWasmUpload proto.InternalMessageInfo
+
+func (m *EventBeginWasmUpload) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
 }
 
-func (m *EventStoreDataRequestWasm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Bytecode) > 0 {
-		i -= len(m.Bytecode)
-		copy(dAtA[i:], m.Bytecode)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Bytecode)))
-		i--
-		dAtA[i] = 0x1a
+func (m *EventBeginWasmUpload) GetWasmType() WasmType {
+	if m != nil {
+		return m.WasmType
 	}
-	if m.WasmType != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.WasmType))
-		i--
-		dAtA[i] = 0x10
+	return WasmTypeNil
+}
+
+func (m *EventBeginWasmUpload) GetUploader() string {
+	if m != nil {
+		return m.Uploader
 	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventBeginWasmUpload) GetUploadSize() uint64 {
+	if m != nil {
+		return m.UploadSize
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventStoreOverlayWasm) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventBeginWasmUpload) GetExpirationHeight() int64 {
+	if m != nil {
+		return m.ExpirationHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventStoreOverlayWasm) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// The msg for discarding a chunked wasm upload that has not been finalized
+// before its expiration height.
+type EventExpireWasmUpload struct {
+	Hash     string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Uploader string `protobuf:"bytes,2,opt,name=uploader,proto3" json:"uploader,omitempty"`
 }
 
-func (m *EventStoreOverlayWasm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Bytecode) > 0 {
-		i -= len(m.Bytecode)
-		copy(dAtA[i:], m.Bytecode)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Bytecode)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.WasmType != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.WasmType))
-		i--
-		dAtA[i] = 0x10
+func (m *EventExpireWasmUpload) Reset()         { *m = EventExpireWasmUpload{} }
+func (m *EventExpireWasmUpload) String() string { return proto.CompactTextString(m) }
+func (*EventExpireWasmUpload) ProtoMessage()    {}
+func (*EventExpireWasmUpload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90b0422555944a3a, []int{3}
+}
+func (m *EventExpireWasmUpload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventExpireWasmUpload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventExpireWasmUpload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventExpireWasmUpload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventExpireWasmUpload.Merge(m, src)
+}
+func (m *EventExpireWasmUpload) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventExpireWasmUpload) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventExpireWasmUpload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventExpireWasmUpload proto.InternalMessageInfo
+
+func (m *EventExpireWasmUpload) GetHash() string {
+	if m != nil {
+		return m.Hash
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventExpireWasmUpload) GetUploader() string {
+	if m != nil {
+		return m.Uploader
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *EventStoreDataRequestWasm) Size() (n int) {
-	if m == nil {
-		return 0
+
+// The msg for removing a data request wasm.
+type EventRemoveDataRequestWasm struct {
+	Hash     string   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	WasmType WasmType `protobuf:"varint,2,opt,name=wasm_type,json=wasmType,proto3,enum=sedachain.wasm_storage.v1.WasmType" json:"wasm_type,omitempty"`
+}
+
+func (m *EventRemoveDataRequestWasm) Reset()         { *m = EventRemoveDataRequestWasm{} }
+func (m *EventRemoveDataRequestWasm) String() string { return proto.CompactTextString(m) }
+func (*EventRemoveDataRequestWasm) ProtoMessage()    {}
+func (*EventRemoveDataRequestWasm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90b0422555944a3a, []int{4}
+}
+func (m *EventRemoveDataRequestWasm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRemoveDataRequestWasm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRemoveDataRequestWasm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+}
+func (m *EventRemoveDataRequestWasm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRemoveDataRequestWasm.Merge(m, src)
+}
+func (m *EventRemoveDataRequestWasm) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRemoveDataRequestWasm) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRemoveDataRequestWasm.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRemoveDataRequestWasm proto.InternalMessageInfo
+
+func (m *EventRemoveDataRequestWasm) GetHash() string {
+	if m != nil {
+		return m.Hash
 	}
-	if m.WasmType != 0 {
-		n += 1 + sovEvents(uint64(m.WasmType))
+	return ""
+}
+
+func (m *EventRemoveDataRequestWasm) GetWasmType() WasmType {
+	if m != nil {
+		return m.WasmType
 	}
-	l = len(m.Bytecode)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return WasmTypeNil
+}
+
+// The msg for removing an overlay wasm(i.e. relayer or executor)
+type EventRemoveOverlayWasm struct {
+	Hash     string   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	WasmType WasmType `protobuf:"varint,2,opt,name=wasm_type,json=wasmType,proto3,enum=sedachain.wasm_storage.v1.WasmType" json:"wasm_type,omitempty"`
+}
+
+func (m *EventRemoveOverlayWasm) Reset()         { *m = EventRemoveOverlayWasm{} }
+func (m *EventRemoveOverlayWasm) String() string { return proto.CompactTextString(m) }
+func (*EventRemoveOverlayWasm) ProtoMessage()    {}
+func (*EventRemoveOverlayWasm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90b0422555944a3a, []int{5}
+}
+func (m *EventRemoveOverlayWasm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRemoveOverlayWasm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRemoveOverlayWasm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *EventRemoveOverlayWasm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRemoveOverlayWasm.Merge(m, src)
+}
+func (m *EventRemoveOverlayWasm) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRemoveOverlayWasm) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRemoveOverlayWasm.DiscardUnknown(m)
 }
 
-func (m *EventStoreOverlayWasm) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_EventRemoveOverlayWasm proto.InternalMessageInfo
+
+func (m *EventRemoveOverlayWasm) GetHash() string {
+	if m != nil {
+		return m.Hash
 	}
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return ""
+}
+
+func (m *EventRemoveOverlayWasm) GetWasmType() WasmType {
+	if m != nil {
+		return m.WasmType
 	}
-	if m.WasmType != 0 {
-		n += 1 + sovEvents(uint64(m.WasmType))
+	return WasmTypeNil
+}
+
+// The msg for scheduling the activation of an overlay wasm at a future
+// height.
+type EventScheduleOverlayWasm struct {
+	Hash             string   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	WasmType         WasmType `protobuf:"varint,2,opt,name=wasm_type,json=wasmType,proto3,enum=sedachain.wasm_storage.v1.WasmType" json:"wasm_type,omitempty"`
+	ActivationHeight int64    `protobuf:"varint,3,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+}
+
+func (m *EventScheduleOverlayWasm) Reset()         { *m = EventScheduleOverlayWasm{} }
+func (m *EventScheduleOverlayWasm) String() string { return proto.CompactTextString(m) }
+func (*EventScheduleOverlayWasm) ProtoMessage()    {}
+func (*EventScheduleOverlayWasm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90b0422555944a3a, []int{6}
+}
+func (m *EventScheduleOverlayWasm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventScheduleOverlayWasm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventScheduleOverlayWasm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Bytecode)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+}
+func (m *EventScheduleOverlayWasm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScheduleOverlayWasm.Merge(m, src)
+}
+func (m *EventScheduleOverlayWasm) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventScheduleOverlayWasm) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScheduleOverlayWasm.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventScheduleOverlayWasm proto.InternalMessageInfo
+
+func (m *EventScheduleOverlayWasm) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *EventScheduleOverlayWasm) GetWasmType() WasmType {
+	if m != nil {
+		return m.WasmType
+	}
+	return WasmTypeNil
+}
+
+func (m *EventScheduleOverlayWasm) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+// The msg for activating an overlay wasm, which overlay nodes of the given
+// type should run from now on.
+type EventActivateOverlayWasm struct {
+	Hash             string   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	WasmType         WasmType `protobuf:"varint,2,opt,name=wasm_type,json=wasmType,proto3,enum=sedachain.wasm_storage.v1.WasmType" json:"wasm_type,omitempty"`
+	ActivationHeight int64    `protobuf:"varint,3,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+}
+
+func (m *EventActivateOverlayWasm) Reset()         { *m = EventActivateOverlayWasm{} }
+func (m *EventActivateOverlayWasm) String() string { return proto.CompactTextString(m) }
+func (*EventActivateOverlayWasm) ProtoMessage()    {}
+func (*EventActivateOverlayWasm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90b0422555944a3a, []int{7}
+}
+func (m *EventActivateOverlayWasm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventActivateOverlayWasm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventActivateOverlayWasm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventActivateOverlayWasm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventActivateOverlayWasm.Merge(m, src)
+}
+func (m *EventActivateOverlayWasm) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventActivateOverlayWasm) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventActivateOverlayWasm.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventActivateOverlayWasm proto.InternalMessageInfo
+
+func (m *EventActivateOverlayWasm) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *EventActivateOverlayWasm) GetWasmType() WasmType {
+	if m != nil {
+		return m.WasmType
+	}
+	return WasmTypeNil
+}
+
+func (m *EventActivateOverlayWasm) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+// The msg for registering a new named proxy contract.
+type EventRegisterProxyContract struct {
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *EventRegisterProxyContract) Reset()         { *m = EventRegisterProxyContract{} }
+func (m *EventRegisterProxyContract) String() string { return proto.CompactTextString(m) }
+func (*EventRegisterProxyContract) ProtoMessage()    {}
+func (*EventRegisterProxyContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90b0422555944a3a, []int{8}
+}
+func (m *EventRegisterProxyContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRegisterProxyContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRegisterProxyContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRegisterProxyContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRegisterProxyContract.Merge(m, src)
+}
+func (m *EventRegisterProxyContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRegisterProxyContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRegisterProxyContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRegisterProxyContract proto.InternalMessageInfo
+
+func (m *EventRegisterProxyContract) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventRegisterProxyContract) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// The msg for changing the address of a named proxy contract.
+type EventUpdateProxyContract struct {
+	Name            string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address         string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	PreviousAddress string `protobuf:"bytes,3,opt,name=previous_address,json=previousAddress,proto3" json:"previous_address,omitempty"`
+}
+
+func (m *EventUpdateProxyContract) Reset()         { *m = EventUpdateProxyContract{} }
+func (m *EventUpdateProxyContract) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateProxyContract) ProtoMessage()    {}
+func (*EventUpdateProxyContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90b0422555944a3a, []int{9}
+}
+func (m *EventUpdateProxyContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateProxyContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateProxyContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUpdateProxyContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateProxyContract.Merge(m, src)
+}
+func (m *EventUpdateProxyContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateProxyContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateProxyContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUpdateProxyContract proto.InternalMessageInfo
+
+func (m *EventUpdateProxyContract) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventUpdateProxyContract) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventUpdateProxyContract) GetPreviousAddress() string {
+	if m != nil {
+		return m.PreviousAddress
+	}
+	return ""
+}
+
+// The msg for removing a named proxy contract.
+type EventRemoveProxyContract struct {
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *EventRemoveProxyContract) Reset()         { *m = EventRemoveProxyContract{} }
+func (m *EventRemoveProxyContract) String() string { return proto.CompactTextString(m) }
+func (*EventRemoveProxyContract) ProtoMessage()    {}
+func (*EventRemoveProxyContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90b0422555944a3a, []int{10}
+}
+func (m *EventRemoveProxyContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRemoveProxyContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRemoveProxyContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRemoveProxyContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRemoveProxyContract.Merge(m, src)
+}
+func (m *EventRemoveProxyContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRemoveProxyContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRemoveProxyContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRemoveProxyContract proto.InternalMessageInfo
+
+func (m *EventRemoveProxyContract) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventRemoveProxyContract) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*EventStoreDataRequestWasm)(nil), "sedachain.wasm_storage.v1.EventStoreDataRequestWasm")
+	proto.RegisterType((*EventStoreOverlayWasm)(nil), "sedachain.wasm_storage.v1.EventStoreOverlayWasm")
+	proto.RegisterType((*EventBeginWasmUpload)(nil), "sedachain.wasm_storage.v1.EventBeginWasmUpload")
+	proto.RegisterType((*EventExpireWasmUpload)(nil), "sedachain.wasm_storage.v1.EventExpireWasmUpload")
+	proto.RegisterType((*EventRemoveDataRequestWasm)(nil), "sedachain.wasm_storage.v1.EventRemoveDataRequestWasm")
+	proto.RegisterType((*EventRemoveOverlayWasm)(nil), "sedachain.wasm_storage.v1.EventRemoveOverlayWasm")
+	proto.RegisterType((*EventScheduleOverlayWasm)(nil), "sedachain.wasm_storage.v1.EventScheduleOverlayWasm")
+	proto.RegisterType((*EventActivateOverlayWasm)(nil), "sedachain.wasm_storage.v1.EventActivateOverlayWasm")
+	proto.RegisterType((*EventRegisterProxyContract)(nil), "sedachain.wasm_storage.v1.EventRegisterProxyContract")
+	proto.RegisterType((*EventUpdateProxyContract)(nil), "sedachain.wasm_storage.v1.EventUpdateProxyContract")
+	proto.RegisterType((*EventRemoveProxyContract)(nil), "sedachain.wasm_storage.v1.EventRemoveProxyContract")
+}
+
+func init() {
+	proto.RegisterFile("sedachain/wasm_storage/v1/events.proto", fileDescriptor_90b0422555944a3a)
+}
+
+var fileDescriptor_90b0422555944a3a = []byte{
+	// 560 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x55, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x8e, 0x93, 0x34, 0x4d, 0x87, 0xbf, 0xd4, 0x82, 0xca, 0xcd, 0xc1, 0x44, 0xae, 0x40, 0x41,
+	0xa5, 0x8e, 0x0a, 0x07, 0x4e, 0x48, 0xb4, 0x50, 0xa8, 0x7a, 0x01, 0x5c, 0x2a, 0x24, 0x2e, 0xd1,
+	0xc6, 0x1e, 0xe2, 0x95, 0x62, 0xaf, 0xd9, 0xdd, 0xb8, 0x49, 0x9f, 0x82, 0x0b, 0x07, 0x0e, 0xbc,
+	0x0f, 0xc7, 0x9e, 0x10, 0x47, 0x94, 0xbc, 0x08, 0xf2, 0xda, 0x4e, 0x1c, 0xa4, 0xaa, 0x87, 0xaa,
+	0x15, 0x87, 0xde, 0x76, 0x7e, 0x76, 0xe6, 0x9b, 0x6f, 0x66, 0x34, 0xf0, 0x50, 0xa0, 0x47, 0x5c,
+	0x9f, 0xd0, 0xb0, 0x73, 0x4c, 0x44, 0xd0, 0x15, 0x92, 0x71, 0xd2, 0xc7, 0x4e, 0xbc, 0xdd, 0xc1,
+	0x18, 0x43, 0x29, 0xec, 0x88, 0x33, 0xc9, 0xf4, 0xf5, 0x99, 0x9f, 0x5d, 0xf4, 0xb3, 0xe3, 0xed,
+	0xe6, 0xe3, 0xb3, 0x43, 0x2c, 0xb8, 0xaa, 0x40, 0xd6, 0xf7, 0x32, 0xac, 0xef, 0x25, 0x91, 0x0f,
+	0x25, 0xe3, 0xf8, 0x8a, 0x48, 0xe2, 0xe0, 0x97, 0x21, 0x0a, 0xf9, 0x91, 0x88, 0x40, 0xd7, 0xa1,
+	0xea, 0x13, 0xe1, 0x1b, 0x5a, 0x4b, 0x6b, 0xaf, 0x38, 0xea, 0xad, 0xbf, 0x80, 0x15, 0x15, 0x47,
+	0x8e, 0x23, 0x34, 0xca, 0x2d, 0xad, 0x7d, 0xfb, 0xc9, 0x86, 0x7d, 0x26, 0x1c, 0x3b, 0x89, 0xf3,
+	0x61, 0x1c, 0xa1, 0x53, 0x3f, 0xce, 0x5e, 0xfa, 0x06, 0xdc, 0xea, 0x8d, 0x25, 0xba, 0xcc, 0xc3,
+	0xae, 0xa0, 0x27, 0x68, 0x54, 0x5b, 0x5a, 0xbb, 0xea, 0xdc, 0xcc, 0x95, 0x87, 0xf4, 0x04, 0xf5,
+	0x26, 0xd4, 0x87, 0xd1, 0x80, 0x11, 0x0f, 0xb9, 0xb1, 0xa4, 0xd2, 0xcf, 0x64, 0x7d, 0x0d, 0x6a,
+	0x3e, 0xd2, 0xbe, 0x2f, 0x8d, 0x5a, 0x4b, 0x6b, 0x57, 0x9c, 0x4c, 0xd2, 0x9f, 0x43, 0xed, 0x33,
+	0xe3, 0x01, 0x91, 0xc6, 0xb2, 0xc2, 0xf5, 0xe0, 0x1c, 0x5c, 0xaf, 0x95, 0xb3, 0x93, 0x7d, 0x3a,
+	0xa8, 0xd6, 0x2b, 0x8d, 0xaa, 0x53, 0xcf, 0x61, 0x58, 0xdf, 0xca, 0x70, 0x6f, 0xce, 0xcd, 0xdb,
+	0x18, 0xf9, 0x80, 0x8c, 0xaf, 0x79, 0xb1, 0x7e, 0x69, 0x70, 0x57, 0xf1, 0xb2, 0x8b, 0x7d, 0x1a,
+	0x26, 0x1f, 0x8e, 0x14, 0x82, 0x4b, 0xa2, 0xa5, 0x58, 0x71, 0xe5, 0x9f, 0x8a, 0xef, 0xc3, 0x8d,
+	0xf4, 0x5d, 0x24, 0x0c, 0x52, 0x95, 0xa2, 0x6b, 0x13, 0x56, 0x71, 0x14, 0x51, 0x4e, 0x24, 0x65,
+	0x61, 0x37, 0x63, 0x67, 0x49, 0xb1, 0xd3, 0x98, 0x1b, 0xf6, 0x95, 0xde, 0x7a, 0x93, 0xf5, 0x7b,
+	0x2f, 0x31, 0xe0, 0x39, 0x85, 0x15, 0x61, 0x95, 0x17, 0x61, 0x59, 0x1c, 0x9a, 0x2a, 0x90, 0x83,
+	0x01, 0x8b, 0xaf, 0x66, 0xab, 0xac, 0x10, 0xd6, 0x0a, 0x39, 0x2f, 0x7d, 0x5a, 0xad, 0x1f, 0x1a,
+	0x18, 0xe9, 0x76, 0xb8, 0x3e, 0x7a, 0xc3, 0xc1, 0x15, 0x2c, 0xc8, 0x26, 0xac, 0x12, 0x57, 0xd2,
+	0x78, 0xa1, 0x99, 0x95, 0xb4, 0x99, 0x73, 0x43, 0xd6, 0xcc, 0x19, 0xbe, 0x9d, 0xd4, 0xf2, 0xbf,
+	0xe1, 0x3b, 0x98, 0xcd, 0x48, 0x9f, 0x0a, 0x89, 0xfc, 0x1d, 0x67, 0xa3, 0xf1, 0x4b, 0x16, 0x4a,
+	0x4e, 0x5c, 0x99, 0x00, 0x0c, 0x49, 0x80, 0x39, 0xc0, 0xe4, 0xad, 0x1b, 0xb0, 0x4c, 0x3c, 0x8f,
+	0xa3, 0x10, 0xd9, 0xc0, 0xe5, 0xa2, 0x25, 0xb2, 0x52, 0x8f, 0x22, 0x8f, 0x48, 0xbc, 0x40, 0x24,
+	0xfd, 0x11, 0x34, 0x22, 0x8e, 0x31, 0x65, 0x43, 0xd1, 0xcd, 0x5d, 0xd2, 0xa5, 0xbb, 0x93, 0xeb,
+	0x77, 0xb2, 0xa4, 0xfb, 0x60, 0x14, 0x06, 0xee, 0x02, 0x49, 0x77, 0xdf, 0xff, 0x9c, 0x98, 0xda,
+	0xe9, 0xc4, 0xd4, 0xfe, 0x4c, 0x4c, 0xed, 0xeb, 0xd4, 0x2c, 0x9d, 0x4e, 0xcd, 0xd2, 0xef, 0xa9,
+	0x59, 0xfa, 0xf4, 0xac, 0x4f, 0xa5, 0x3f, 0xec, 0xd9, 0x2e, 0x0b, 0x3a, 0x49, 0x2b, 0xd4, 0xd1,
+	0x72, 0xd9, 0x40, 0x09, 0x5b, 0xe9, 0x95, 0x1b, 0xa9, 0xbb, 0xb6, 0x95, 0xdf, 0xb9, 0xa4, 0x7d,
+	0xa2, 0x57, 0x53, 0x9e, 0x4f, 0xff, 0x0e, 0x00, 0xbe, 0x9e, 0xe5, 0x96, 0x51, 0x07, 0x00, 0x00,
+}
+
+func (m *EventStoreDataRequestWasm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventStoreDataRequestWasm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventStoreDataRequestWasm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Format != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Format))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Height != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Uploader) > 0 {
+		i -= len(m.Uploader)
+		copy(dAtA[i:], m.Uploader)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Uploader)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BytecodeSize != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BytecodeSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WasmType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.WasmType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventStoreOverlayWasm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventStoreOverlayWasm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventStoreOverlayWasm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Format != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Format))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Height != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Uploader) > 0 {
+		i -= len(m.Uploader)
+		copy(dAtA[i:], m.Uploader)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Uploader)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BytecodeSize != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BytecodeSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WasmType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.WasmType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBeginWasmUpload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBeginWasmUpload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBeginWasmUpload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UploadSize != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.UploadSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Uploader) > 0 {
+		i -= len(m.Uploader)
+		copy(dAtA[i:], m.Uploader)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Uploader)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WasmType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.WasmType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventExpireWasmUpload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventExpireWasmUpload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventExpireWasmUpload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Uploader) > 0 {
+		i -= len(m.Uploader)
+		copy(dAtA[i:], m.Uploader)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Uploader)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRemoveDataRequestWasm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRemoveDataRequestWasm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRemoveDataRequestWasm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WasmType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.WasmType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRemoveOverlayWasm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRemoveOverlayWasm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRemoveOverlayWasm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WasmType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.WasmType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventScheduleOverlayWasm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScheduleOverlayWasm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScheduleOverlayWasm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WasmType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.WasmType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventActivateOverlayWasm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventActivateOverlayWasm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventActivateOverlayWasm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WasmType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.WasmType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRegisterProxyContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRegisterProxyContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRegisterProxyContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpdateProxyContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateProxyContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateProxyContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PreviousAddress) > 0 {
+		i -= len(m.PreviousAddress)
+		copy(dAtA[i:], m.PreviousAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PreviousAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRemoveProxyContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRemoveProxyContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRemoveProxyContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventStoreDataRequestWasm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.WasmType != 0 {
+		n += 1 + sovEvents(uint64(m.WasmType))
+	}
+	if m.BytecodeSize != 0 {
+		n += 1 + sovEvents(uint64(m.BytecodeSize))
+	}
+	l = len(m.Uploader)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovEvents(uint64(m.Height))
+	}
+	if m.Format != 0 {
+		n += 1 + sovEvents(uint64(m.Format))
+	}
+	return n
+}
+
+func (m *EventStoreOverlayWasm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.WasmType != 0 {
+		n += 1 + sovEvents(uint64(m.WasmType))
+	}
+	if m.BytecodeSize != 0 {
+		n += 1 + sovEvents(uint64(m.BytecodeSize))
+	}
+	l = len(m.Uploader)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovEvents(uint64(m.Height))
+	}
+	if m.Format != 0 {
+		n += 1 + sovEvents(uint64(m.Format))
+	}
+	return n
+}
+
+func (m *EventBeginWasmUpload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.WasmType != 0 {
+		n += 1 + sovEvents(uint64(m.WasmType))
+	}
+	l = len(m.Uploader)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.UploadSize != 0 {
+		n += 1 + sovEvents(uint64(m.UploadSize))
+	}
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovEvents(uint64(m.ExpirationHeight))
+	}
+	return n
+}
+
+func (m *EventExpireWasmUpload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Uploader)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRemoveDataRequestWasm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.WasmType != 0 {
+		n += 1 + sovEvents(uint64(m.WasmType))
+	}
+	return n
+}
+
+func (m *EventRemoveOverlayWasm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.WasmType != 0 {
+		n += 1 + sovEvents(uint64(m.WasmType))
+	}
+	return n
+}
+
+func (m *EventScheduleOverlayWasm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.WasmType != 0 {
+		n += 1 + sovEvents(uint64(m.WasmType))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovEvents(uint64(m.ActivationHeight))
+	}
+	return n
+}
+
+func (m *EventActivateOverlayWasm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.WasmType != 0 {
+		n += 1 + sovEvents(uint64(m.WasmType))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovEvents(uint64(m.ActivationHeight))
+	}
+	return n
+}
+
+func (m *EventRegisterProxyContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventUpdateProxyContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PreviousAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRemoveProxyContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventStoreDataRequestWasm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventStoreDataRequestWasm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventStoreDataRequestWasm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WasmType", wireType)
+			}
+			m.WasmType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WasmType |= WasmType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytecodeSize", wireType)
+			}
+			m.BytecodeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytecodeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uploader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uploader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			m.Format = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Format |= WasmFormat(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventStoreOverlayWasm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventStoreOverlayWasm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventStoreOverlayWasm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WasmType", wireType)
+			}
+			m.WasmType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WasmType |= WasmType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytecodeSize", wireType)
+			}
+			m.BytecodeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytecodeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uploader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uploader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			m.Format = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Format |= WasmFormat(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBeginWasmUpload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBeginWasmUpload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBeginWasmUpload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WasmType", wireType)
+			}
+			m.WasmType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WasmType |= WasmType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uploader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uploader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadSize", wireType)
+			}
+			m.UploadSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+			}
+			m.ExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventExpireWasmUpload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventExpireWasmUpload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventExpireWasmUpload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uploader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uploader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRemoveDataRequestWasm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRemoveDataRequestWasm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRemoveDataRequestWasm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WasmType", wireType)
+			}
+			m.WasmType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WasmType |= WasmType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRemoveOverlayWasm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRemoveOverlayWasm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRemoveOverlayWasm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WasmType", wireType)
+			}
+			m.WasmType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WasmType |= WasmType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScheduleOverlayWasm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScheduleOverlayWasm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScheduleOverlayWasm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WasmType", wireType)
+			}
+			m.WasmType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WasmType |= WasmType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *EventStoreDataRequestWasm) Unmarshal(dAtA []byte) error {
+func (m *EventActivateOverlayWasm) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -338,10 +2506,10 @@ func (m *EventStoreDataRequestWasm) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventStoreDataRequestWasm: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventActivateOverlayWasm: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventStoreDataRequestWasm: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventActivateOverlayWasm: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -396,10 +2564,79 @@ func (m *EventStoreDataRequestWasm) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRegisterProxyContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRegisterProxyContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRegisterProxyContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bytecode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -409,25 +2646,55 @@ func (m *EventStoreDataRequestWasm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bytecode = append(m.Bytecode[:0], dAtA[iNdEx:postIndex]...)
-			if m.Bytecode == nil {
-				m.Bytecode = []byte{}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -450,7 +2717,7 @@ func (m *EventStoreDataRequestWasm) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventStoreOverlayWasm) Unmarshal(dAtA []byte) error {
+func (m *EventUpdateProxyContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -473,15 +2740,15 @@ func (m *EventStoreOverlayWasm) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventStoreOverlayWasm: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUpdateProxyContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventStoreOverlayWasm: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUpdateProxyContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -509,13 +2776,13 @@ func (m *EventStoreOverlayWasm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WasmType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.WasmType = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -525,16 +2792,111 @@ func (m *EventStoreOverlayWasm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WasmType |= WasmType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bytecode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRemoveProxyContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRemoveProxyContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRemoveProxyContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -544,25 +2906,55 @@ func (m *EventStoreOverlayWasm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bytecode = append(m.Bytecode[:0], dAtA[iNdEx:postIndex]...)
-			if m.Bytecode == nil {
-				m.Bytecode = []byte{}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
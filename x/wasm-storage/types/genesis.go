@@ -1,32 +1,178 @@
 package types
 
 import (
+	"bytes"
+	"encoding/hex"
 	fmt "fmt"
 
+	"github.com/ethereum/go-ethereum/crypto"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewGenesisState constructs a GenesisState object.
-func NewGenesisState(wasms []Wasm, proxyAddr string) GenesisState {
+func NewGenesisState(params Params, dataRequestWasms, overlayWasms []Wasm, proxyContracts []ProxyContract) GenesisState {
 	return GenesisState{
-		Wasms:                 wasms,
-		ProxyContractRegistry: proxyAddr,
+		Params:           params,
+		DataRequestWasms: dataRequestWasms,
+		OverlayWasms:     overlayWasms,
+		ProxyContracts:   proxyContracts,
 	}
 }
 
 // DefaultGenesisState creates a default GenesisState object.
 func DefaultGenesisState() *GenesisState {
-	state := NewGenesisState(nil, "")
+	state := NewGenesisState(DefaultParams(), nil, nil, nil)
 	return &state
 }
 
-// ValidateGenesis validates wasm-storage genesis data.
+// ParamsOrDefault returns the params of the genesis state or the default
+// params if none are set, as in genesis files that predate params.
+func (gs GenesisState) ParamsOrDefault() Params {
+	if gs.Params.MaxWasmSize == 0 && len(gs.Params.ProxyContractCodeIDs) == 0 && gs.Params.ProxyContractAdmin == "" {
+		return DefaultParams()
+	}
+	return gs.Params
+}
+
+// ValidateGenesis validates wasm-storage genesis data. Missing params are
+// replaced by the default params.
 func ValidateGenesis(gs GenesisState) error {
+	gs.Params = gs.ParamsOrDefault()
+	if err := gs.Params.ValidateBasic(); err != nil {
+		return err
+	}
+	if err := validateGenesisWasms(gs); err != nil {
+		return err
+	}
+	if err := validateGenesisOverlays(gs); err != nil {
+		return err
+	}
 	if gs.ProxyContractRegistry != "" {
 		_, err := sdk.AccAddressFromBech32(gs.ProxyContractRegistry)
 		if err != nil {
 			return fmt.Errorf("invalid Proxy contract address %w", err)
 		}
 	}
+	names := make(map[string]bool)
+	for _, contract := range gs.ProxyContracts {
+		if err := ValidateProxyContractName(contract.Name); err != nil {
+			return err
+		}
+		if names[contract.Name] {
+			return fmt.Errorf("duplicate proxy contract name %s", contract.Name)
+		}
+		names[contract.Name] = true
+		if _, err := sdk.AccAddressFromBech32(contract.Address); err != nil {
+			return fmt.Errorf("invalid address of proxy contract %s: %w", contract.Name, err)
+		}
+	}
+	for _, change := range gs.ProxyContractHistory {
+		if err := ValidateProxyContractName(change.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateGenesisWasms checks that the hash of each Wasm matches its
+// bytecode, that its type belongs to the store it is listed under, that
+// its size is within params, and that no Wasm appears twice in the same
+// store. Wasms in the combined legacy list are assigned to a store by
+// their type.
+func validateGenesisWasms(gs GenesisState) error {
+	dataRequestWasms := make(map[string]bool)
+	overlayWasms := make(map[string]bool)
+	validate := func(wasm Wasm, seen map[string]bool) error {
+		hash := hex.EncodeToString(wasm.Hash)
+		if !bytes.Equal(wasm.Hash, crypto.Keccak256(wasm.Bytecode)) {
+			return fmt.Errorf("hash of Wasm %s does not match its bytecode", hash)
+		}
+		if err := validateWasmCode(wasm.Bytecode); err != nil {
+			return fmt.Errorf("invalid bytecode of Wasm %s: %w", hash, err)
+		}
+		if uint64(len(wasm.Bytecode)) > gs.Params.MaxWasmSize {
+			return fmt.Errorf("bytecode of Wasm %s exceeds max Wasm size of %d bytes", hash, gs.Params.MaxWasmSize)
+		}
+		if seen[hash] {
+			return fmt.Errorf("duplicate Wasm %s", hash)
+		}
+		seen[hash] = true
+		return nil
+	}
+
+	for _, wasm := range gs.DataRequestWasms {
+		if !wasm.WasmType.IsDataRequest() {
+			return fmt.Errorf("invalid type %s of Data Request Wasm %s", wasm.WasmType, hex.EncodeToString(wasm.Hash))
+		}
+		if err := validate(wasm, dataRequestWasms); err != nil {
+			return err
+		}
+	}
+	for _, wasm := range gs.OverlayWasms {
+		if !wasm.WasmType.IsOverlay() {
+			return fmt.Errorf("invalid type %s of Overlay Wasm %s", wasm.WasmType, hex.EncodeToString(wasm.Hash))
+		}
+		if err := validate(wasm, overlayWasms); err != nil {
+			return err
+		}
+	}
+	for _, wasm := range gs.Wasms {
+		var err error
+		switch {
+		case wasm.WasmType.IsDataRequest():
+			err = validate(wasm, dataRequestWasms)
+		case wasm.WasmType.IsOverlay():
+			err = validate(wasm, overlayWasms)
+		default:
+			err = fmt.Errorf("invalid type %s of Wasm %s", wasm.WasmType, hex.EncodeToString(wasm.Hash))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateGenesisOverlays checks that the active, scheduled, and past
+// versions of Overlay Wasms refer to Overlay Wasms in the genesis state
+// of the same type.
+func validateGenesisOverlays(gs GenesisState) error {
+	overlayTypes := make(map[string]WasmType)
+	for _, wasm := range gs.OverlayWasms {
+		overlayTypes[hex.EncodeToString(wasm.Hash)] = wasm.WasmType
+	}
+	for _, wasm := range gs.Wasms {
+		if wasm.WasmType.IsOverlay() {
+			overlayTypes[hex.EncodeToString(wasm.Hash)] = wasm.WasmType
+		}
+	}
+
+	validate := func(kind string, version OverlayVersion) error {
+		hash := hex.EncodeToString(version.Hash)
+		wasmType, ok := overlayTypes[hash]
+		if !ok {
+			return fmt.Errorf("%s version of %s refers to missing Overlay Wasm %s", kind, version.WasmType, hash)
+		}
+		if wasmType != version.WasmType {
+			return fmt.Errorf("%s version of %s refers to Overlay Wasm %s of type %s", kind, version.WasmType, hash, wasmType)
+		}
+		return nil
+	}
+	for _, version := range gs.ActiveOverlays {
+		if err := validate("active", version); err != nil {
+			return err
+		}
+	}
+	for _, version := range gs.ScheduledOverlays {
+		if err := validate("scheduled", version); err != nil {
+			return err
+		}
+	}
+	for _, version := range gs.OverlayHistory {
+		if err := validate("past", version); err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -24,10 +24,24 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the wasm module's genesis state(i.e wasms stored at
-// genesis.)
+// genesis.) Pending chunked uploads are not part of it and do not survive
+// a genesis export and import.
 type GenesisState struct {
-	Wasms                 []Wasm `protobuf:"bytes,1,rep,name=wasms,proto3" json:"wasms"`
-	ProxyContractRegistry string `protobuf:"bytes,2,opt,name=proxy_contract_registry,json=proxyContractRegistry,proto3" json:"proxy_contract_registry,omitempty"`
+	// wasms is the combined list of data request and overlay wasms. It is
+	// superseded by data_request_wasms and overlay_wasms and only read on
+	// import, where each wasm is routed to a store by its type.
+	Wasms []Wasm `protobuf:"bytes,1,rep,name=wasms,proto3" json:"wasms"`
+	// proxy_contract_registry is the address of the data request Proxy
+	// Contract. It is superseded by proxy_contracts and only read on import.
+	ProxyContractRegistry string                `protobuf:"bytes,2,opt,name=proxy_contract_registry,json=proxyContractRegistry,proto3" json:"proxy_contract_registry,omitempty"`
+	ActiveOverlays        []OverlayVersion      `protobuf:"bytes,3,rep,name=active_overlays,json=activeOverlays,proto3" json:"active_overlays"`
+	ScheduledOverlays     []OverlayVersion      `protobuf:"bytes,4,rep,name=scheduled_overlays,json=scheduledOverlays,proto3" json:"scheduled_overlays"`
+	OverlayHistory        []OverlayVersion      `protobuf:"bytes,5,rep,name=overlay_history,json=overlayHistory,proto3" json:"overlay_history"`
+	ProxyContracts        []ProxyContract       `protobuf:"bytes,6,rep,name=proxy_contracts,json=proxyContracts,proto3" json:"proxy_contracts"`
+	ProxyContractHistory  []ProxyContractChange `protobuf:"bytes,7,rep,name=proxy_contract_history,json=proxyContractHistory,proto3" json:"proxy_contract_history"`
+	DataRequestWasms      []Wasm                `protobuf:"bytes,8,rep,name=data_request_wasms,json=dataRequestWasms,proto3" json:"data_request_wasms"`
+	OverlayWasms          []Wasm                `protobuf:"bytes,9,rep,name=overlay_wasms,json=overlayWasms,proto3" json:"overlay_wasms"`
+	Params                Params                `protobuf:"bytes,10,opt,name=params,proto3" json:"params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,6 +91,62 @@ func (m *GenesisState) GetProxyContractRegistry() string {
 	return ""
 }
 
+func (m *GenesisState) GetActiveOverlays() []OverlayVersion {
+	if m != nil {
+		return m.ActiveOverlays
+	}
+	return nil
+}
+
+func (m *GenesisState) GetScheduledOverlays() []OverlayVersion {
+	if m != nil {
+		return m.ScheduledOverlays
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOverlayHistory() []OverlayVersion {
+	if m != nil {
+		return m.OverlayHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProxyContracts() []ProxyContract {
+	if m != nil {
+		return m.ProxyContracts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProxyContractHistory() []ProxyContractChange {
+	if m != nil {
+		return m.ProxyContractHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDataRequestWasms() []Wasm {
+	if m != nil {
+		return m.DataRequestWasms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOverlayWasms() []Wasm {
+	if m != nil {
+		return m.OverlayWasms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "sedachain.wasm_storage.v1.GenesisState")
 }
@@ -86,23 +156,36 @@ func init() {
 }
 
 var fileDescriptor_f7dee811c18d199f = []byte{
-	// 254 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2f, 0x4e, 0x4d, 0x49,
-	0x4c, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x2f, 0x4f, 0x2c, 0xce, 0x8d, 0x2f, 0x2e, 0xc9, 0x2f, 0x4a,
-	0x4c, 0x4f, 0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28,
-	0xca, 0x2f, 0xc9, 0x17, 0x92, 0x84, 0x2b, 0xd4, 0x43, 0x56, 0xa8, 0x57, 0x66, 0x28, 0x25, 0x92,
-	0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa5, 0x0f, 0x62, 0x41, 0x34, 0x48, 0xe9, 0xe0, 0x36, 0x19, 0xc5,
-	0x00, 0xb0, 0x6a, 0xa5, 0x66, 0x46, 0x2e, 0x1e, 0x77, 0x88, 0x85, 0xc1, 0x25, 0x89, 0x25, 0xa9,
-	0x42, 0xd6, 0x5c, 0xac, 0x20, 0x65, 0xc5, 0x12, 0x8c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46, 0xf2, 0x7a,
-	0x38, 0xed, 0xd7, 0x0b, 0x4f, 0x2c, 0xce, 0x75, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21, 0x08, 0xa2,
-	0x47, 0xc8, 0x8c, 0x4b, 0xbc, 0xa0, 0x28, 0xbf, 0xa2, 0x32, 0x3e, 0x39, 0x3f, 0xaf, 0xa4, 0x28,
-	0x31, 0xb9, 0x24, 0xbe, 0x28, 0x35, 0x3d, 0xb3, 0xb8, 0xa4, 0xa8, 0x52, 0x82, 0x49, 0x81, 0x51,
-	0x83, 0x33, 0x48, 0x14, 0x2c, 0xed, 0x0c, 0x95, 0x0d, 0x82, 0x4a, 0x3a, 0x05, 0x9e, 0x78, 0x24,
-	0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78,
-	0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x79, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e,
-	0x72, 0x7e, 0xae, 0x3e, 0xc8, 0x25, 0x60, 0x57, 0x27, 0xe7, 0xe7, 0x80, 0x39, 0xba, 0x10, 0x6f,
-	0x56, 0x80, 0x3d, 0xa6, 0x0b, 0xf3, 0x68, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x58, 0xa5,
-	0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xd1, 0x92, 0x9b, 0xff, 0x69, 0x01, 0x00, 0x00,
+	// 454 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0x6d, 0xda, 0x1a, 0xba, 0x2d, 0x04, 0x56, 0x05, 0x4c, 0x0f, 0x6e, 0xe0, 0x82, 0x91,
+	0xa8, 0xad, 0x16, 0x09, 0x0e, 0x1c, 0x90, 0xda, 0x03, 0x88, 0x0b, 0xe0, 0x4a, 0xa4, 0xe2, 0x80,
+	0xb5, 0xb5, 0x57, 0xb6, 0x51, 0xec, 0x35, 0x3b, 0x1b, 0x53, 0xbf, 0x05, 0x4f, 0xc4, 0xb9, 0xc7,
+	0x1c, 0x39, 0x21, 0x94, 0xbc, 0x08, 0xf2, 0xee, 0x3a, 0xc4, 0x48, 0x89, 0x48, 0x6e, 0x89, 0xe6,
+	0xff, 0xbf, 0x7f, 0x76, 0x3c, 0x83, 0x1e, 0x03, 0x8d, 0x49, 0x94, 0x92, 0xac, 0xf0, 0xbf, 0x11,
+	0xc8, 0x43, 0x10, 0x8c, 0x93, 0x84, 0xfa, 0xd5, 0x91, 0x9f, 0xd0, 0x82, 0x42, 0x06, 0x5e, 0xc9,
+	0x99, 0x60, 0xf8, 0xc1, 0x4c, 0xe8, 0xcd, 0x0b, 0xbd, 0xea, 0x68, 0x7f, 0x2f, 0x61, 0x09, 0x93,
+	0x2a, 0xbf, 0xf9, 0xa5, 0x0c, 0xfb, 0x4f, 0x17, 0x93, 0x3b, 0x00, 0xa9, 0x7e, 0xf4, 0xc3, 0x42,
+	0xbb, 0xaf, 0x55, 0xe0, 0x99, 0x20, 0x82, 0xe2, 0x97, 0x68, 0xab, 0x91, 0x81, 0x6d, 0xf6, 0x37,
+	0xdc, 0x9d, 0xe3, 0x03, 0x6f, 0x61, 0xbe, 0x37, 0x20, 0x90, 0x9f, 0x6c, 0x5e, 0xfd, 0x3a, 0x30,
+	0x02, 0xe5, 0xc1, 0xcf, 0xd1, 0xfd, 0x92, 0xb3, 0xcb, 0x3a, 0x8c, 0x58, 0x21, 0x38, 0x89, 0x44,
+	0xc8, 0x69, 0x92, 0x81, 0xe0, 0xb5, 0x7d, 0xad, 0x6f, 0xba, 0xdb, 0xc1, 0x5d, 0x59, 0x3e, 0xd5,
+	0xd5, 0x40, 0x17, 0xf1, 0x39, 0xea, 0x91, 0x48, 0x64, 0x15, 0x0d, 0x59, 0x45, 0xf9, 0x90, 0xd4,
+	0x60, 0x6f, 0xc8, 0xf8, 0x27, 0x4b, 0xe2, 0xdf, 0x29, 0xe9, 0x47, 0xca, 0x21, 0x63, 0x85, 0x6e,
+	0xe4, 0x96, 0xe2, 0xe8, 0x1a, 0xe0, 0xcf, 0x08, 0x43, 0x94, 0xd2, 0x78, 0x34, 0xa4, 0xf1, 0x5f,
+	0xf8, 0xe6, 0x7a, 0xf0, 0x3b, 0x33, 0xd4, 0x8c, 0x7f, 0x8e, 0x7a, 0x9a, 0x1a, 0xa6, 0x59, 0xe3,
+	0xaf, 0xed, 0xad, 0x35, 0x3b, 0xd7, 0x9c, 0x37, 0x0a, 0x83, 0x07, 0xa8, 0xd7, 0x9d, 0x25, 0xd8,
+	0x96, 0x24, 0xbb, 0x4b, 0xc8, 0xef, 0xe7, 0xc7, 0xdb, 0x82, 0x3b, 0x33, 0x07, 0xfc, 0x05, 0xdd,
+	0xfb, 0xe7, 0x23, 0xb5, 0x9d, 0x5f, 0x97, 0x7c, 0xef, 0x7f, 0xf9, 0xa7, 0x29, 0x29, 0x12, 0xaa,
+	0x53, 0xf6, 0x3a, 0x29, 0xed, 0x23, 0xce, 0x10, 0x8e, 0x89, 0x20, 0x21, 0xa7, 0x5f, 0x47, 0x14,
+	0x44, 0xa8, 0x56, 0xeb, 0xc6, 0x2a, 0xab, 0x75, 0xbb, 0x01, 0x04, 0xca, 0x3f, 0x90, 0x5b, 0xf6,
+	0x16, 0xdd, 0x6c, 0x67, 0xae, 0x78, 0xdb, 0xab, 0xf0, 0x76, 0xb5, 0x57, 0xb1, 0x5e, 0x21, 0xab,
+	0x24, 0x9c, 0xe4, 0x60, 0xa3, 0xbe, 0xe9, 0xee, 0x1c, 0x3f, 0x5c, 0xf6, 0x78, 0x29, 0xd4, 0x18,
+	0x6d, 0x3b, 0xf9, 0x70, 0x35, 0x71, 0xcc, 0xf1, 0xc4, 0x31, 0x7f, 0x4f, 0x1c, 0xf3, 0xfb, 0xd4,
+	0x31, 0xc6, 0x53, 0xc7, 0xf8, 0x39, 0x75, 0x8c, 0x4f, 0x2f, 0x92, 0x4c, 0xa4, 0xa3, 0x0b, 0x2f,
+	0x62, 0xb9, 0xdf, 0x40, 0xe5, 0xc1, 0x45, 0x6c, 0x28, 0xff, 0x1c, 0xaa, 0x0b, 0xbd, 0x94, 0x37,
+	0x79, 0xd8, 0xde, 0xa8, 0xa8, 0x4b, 0x0a, 0x17, 0x96, 0x54, 0x3e, 0xfb, 0x33, 0x00, 0xd6, 0x8c,
+	0x3a, 0xd7, 0x24, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -125,6 +208,114 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.OverlayWasms) > 0 {
+		for iNdEx := len(m.OverlayWasms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OverlayWasms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.DataRequestWasms) > 0 {
+		for iNdEx := len(m.DataRequestWasms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DataRequestWasms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ProxyContractHistory) > 0 {
+		for iNdEx := len(m.ProxyContractHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProxyContractHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ProxyContracts) > 0 {
+		for iNdEx := len(m.ProxyContracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProxyContracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.OverlayHistory) > 0 {
+		for iNdEx := len(m.OverlayHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OverlayHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ScheduledOverlays) > 0 {
+		for iNdEx := len(m.ScheduledOverlays) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledOverlays[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ActiveOverlays) > 0 {
+		for iNdEx := len(m.ActiveOverlays) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActiveOverlays[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.ProxyContractRegistry) > 0 {
 		i -= len(m.ProxyContractRegistry)
 		copy(dAtA[i:], m.ProxyContractRegistry)
@@ -176,6 +367,50 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.ActiveOverlays) > 0 {
+		for _, e := range m.ActiveOverlays {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScheduledOverlays) > 0 {
+		for _, e := range m.ScheduledOverlays {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OverlayHistory) > 0 {
+		for _, e := range m.OverlayHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProxyContracts) > 0 {
+		for _, e := range m.ProxyContracts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProxyContractHistory) > 0 {
+		for _, e := range m.ProxyContractHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DataRequestWasms) > 0 {
+		for _, e := range m.DataRequestWasms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OverlayWasms) > 0 {
+		for _, e := range m.OverlayWasms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -280,6 +515,277 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.ProxyContractRegistry = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveOverlays", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActiveOverlays = append(m.ActiveOverlays, OverlayVersion{})
+			if err := m.ActiveOverlays[len(m.ActiveOverlays)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledOverlays", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledOverlays = append(m.ScheduledOverlays, OverlayVersion{})
+			if err := m.ScheduledOverlays[len(m.ScheduledOverlays)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverlayHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OverlayHistory = append(m.OverlayHistory, OverlayVersion{})
+			if err := m.OverlayHistory[len(m.OverlayHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyContracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProxyContracts = append(m.ProxyContracts, ProxyContract{})
+			if err := m.ProxyContracts[len(m.ProxyContracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyContractHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProxyContractHistory = append(m.ProxyContractHistory, ProxyContractChange{})
+			if err := m.ProxyContractHistory[len(m.ProxyContractHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRequestWasms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataRequestWasms = append(m.DataRequestWasms, Wasm{})
+			if err := m.DataRequestWasms[len(m.DataRequestWasms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverlayWasms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OverlayWasms = append(m.OverlayWasms, Wasm{})
+			if err := m.OverlayWasms[len(m.OverlayWasms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
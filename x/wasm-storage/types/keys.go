@@ -4,6 +4,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -31,9 +32,49 @@ var (
 	// the hashes of Data Request Wasm binaries.
 	KeyPrefixDataRequestQueue = []byte{0x02}
 
-	// KeyPrefixProxyContractRegistry defines prefix to store address of
-	// Proxy Contract.
+	// KeyPrefixProxyContractRegistry defines prefix that stored the
+	// address of the single Proxy Contract prior to the named registry.
+	// It is only read by the store migration.
 	KeyPrefixProxyContractRegistry = []byte{0x03}
+
+	// KeyPrefixActiveOverlay defines prefix to store the active version
+	// of each Overlay Wasm type.
+	KeyPrefixActiveOverlay = []byte{0x04}
+
+	// KeyPrefixScheduledOverlay defines prefix to store the version of
+	// each Overlay Wasm type that is scheduled for activation.
+	KeyPrefixScheduledOverlay = []byte{0x05}
+
+	// KeyPrefixOverlayHistory defines prefix to store previously active
+	// versions of each Overlay Wasm type.
+	KeyPrefixOverlayHistory = []byte{0x06}
+
+	// KeyPrefixProxyContract defines prefix to store the addresses of
+	// named Proxy Contracts.
+	KeyPrefixProxyContract = []byte{0x07}
+
+	// KeyPrefixProxyContractHistory defines prefix to store the changes
+	// made to named Proxy Contracts.
+	KeyPrefixProxyContractHistory = []byte{0x08}
+
+	// KeyPrefixWasmUpload defines prefix to store pending chunked uploads.
+	KeyPrefixWasmUpload = []byte{0x09}
+
+	// KeyPrefixWasmUploadChunk defines prefix to store the chunks of
+	// pending chunked uploads.
+	KeyPrefixWasmUploadChunk = []byte{0x0a}
+
+	// KeyPrefixWasmUploadQueue defines prefix to store the queue that
+	// contains pending chunked uploads by their expiration height.
+	KeyPrefixWasmUploadQueue = []byte{0x0b}
+
+	// KeyPrefixBytecode defines prefix to store Wasm bytecode shared by
+	// the Data Request and Overlay Wasm stores.
+	KeyPrefixBytecode = []byte{0x0c}
+
+	// KeyPrefixBytecodeRefCount defines prefix to store the number of
+	// Data Request and Overlay Wasms referencing each bytecode.
+	KeyPrefixBytecodeRefCount = []byte{0x0d}
 )
 
 func GetDataRequestWasmKey(hash []byte) []byte {
@@ -44,6 +85,94 @@ func GetOverlayWasmKey(hash []byte) []byte {
 	return append(KeyPrefixOverlay, hash...)
 }
 
+// GetBytecodeKey gets the key for the bytecode with a given hash.
+func GetBytecodeKey(hash []byte) []byte {
+	return append(KeyPrefixBytecode, hash...)
+}
+
+// GetBytecodeRefCountKey gets the key for the reference count of the
+// bytecode with a given hash.
+func GetBytecodeRefCountKey(hash []byte) []byte {
+	return append(KeyPrefixBytecodeRefCount, hash...)
+}
+
+// GetActiveOverlayKey gets the key for the active version of a given
+// Overlay Wasm type.
+func GetActiveOverlayKey(wasmType WasmType) []byte {
+	return append(KeyPrefixActiveOverlay, sdk.Uint64ToBigEndian(uint64(wasmType))...)
+}
+
+// GetScheduledOverlayKey gets the key for the scheduled version of a
+// given Overlay Wasm type.
+func GetScheduledOverlayKey(wasmType WasmType) []byte {
+	return append(KeyPrefixScheduledOverlay, sdk.Uint64ToBigEndian(uint64(wasmType))...)
+}
+
+// GetOverlayHistoryPrefix gets the prefix for the previously active
+// versions of a given Overlay Wasm type.
+func GetOverlayHistoryPrefix(wasmType WasmType) []byte {
+	return append(KeyPrefixOverlayHistory, sdk.Uint64ToBigEndian(uint64(wasmType))...)
+}
+
+// GetOverlayHistoryKey gets the key for a previously active version of
+// an Overlay Wasm type. This key is the sequence number of the version,
+// since several versions may be activated at the same height.
+func GetOverlayHistoryKey(wasmType WasmType, seq uint64) []byte {
+	return append(GetOverlayHistoryPrefix(wasmType), sdk.Uint64ToBigEndian(seq)...)
+}
+
+// GetProxyContractKey gets the key for a named Proxy Contract.
+func GetProxyContractKey(name string) []byte {
+	return append(KeyPrefixProxyContract, []byte(name)...)
+}
+
+// GetProxyContractHistoryPrefix gets the prefix for the changes made
+// to a named Proxy Contract.
+func GetProxyContractHistoryPrefix(name string) []byte {
+	return append(KeyPrefixProxyContractHistory, address.MustLengthPrefix([]byte(name))...)
+}
+
+// GetProxyContractHistoryKey gets the key for a change made to a named
+// Proxy Contract. This key is the sequence number of the change.
+func GetProxyContractHistoryKey(name string, seq uint64) []byte {
+	return append(GetProxyContractHistoryPrefix(name), sdk.Uint64ToBigEndian(seq)...)
+}
+
+// getWasmUploadSuffix gets the part of the keys of a pending chunked
+// upload that identifies it by uploader and hash.
+func getWasmUploadSuffix(uploader sdk.AccAddress, hash []byte) []byte {
+	return append(address.MustLengthPrefix(uploader), hash...)
+}
+
+// GetWasmUploadKey gets the key for a pending chunked upload.
+func GetWasmUploadKey(uploader sdk.AccAddress, hash []byte) []byte {
+	return append(KeyPrefixWasmUpload, getWasmUploadSuffix(uploader, hash)...)
+}
+
+// GetWasmUploadChunkPrefix gets the prefix for the chunks of a pending
+// chunked upload.
+func GetWasmUploadChunkPrefix(uploader sdk.AccAddress, hash []byte) []byte {
+	return append(KeyPrefixWasmUploadChunk, getWasmUploadSuffix(uploader, hash)...)
+}
+
+// GetWasmUploadChunkKey gets the key for a chunk of a pending chunked
+// upload. This key is the index of the chunk.
+func GetWasmUploadChunkKey(uploader sdk.AccAddress, hash []byte, index uint64) []byte {
+	return append(GetWasmUploadChunkPrefix(uploader, hash), sdk.Uint64ToBigEndian(index)...)
+}
+
+// GetWasmUploadQueuePrefix gets the prefix for the pending chunked
+// uploads that expire at a given height.
+func GetWasmUploadQueuePrefix(expirationHeight int64) []byte {
+	return append(KeyPrefixWasmUploadQueue, sdk.Uint64ToBigEndian(uint64(expirationHeight))...)
+}
+
+// GetWasmUploadQueueKey gets the key for an item in the chunked upload
+// queue. This key is the expiration height of the upload.
+func GetWasmUploadQueueKey(expirationHeight int64, uploader sdk.AccAddress, hash []byte) []byte {
+	return append(GetWasmUploadQueuePrefix(expirationHeight), getWasmUploadSuffix(uploader, hash)...)
+}
+
 // GetDataRequestTimeKey gets the key for an item in Data Request Queue. This key
 // is the timestamp of when the Data Request Wasm was stored.
 func GetDataRequestTimeKey(timestamp time.Time) []byte {
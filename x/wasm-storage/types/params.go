@@ -2,6 +2,8 @@ package types
 
 import (
 	fmt "fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const DefaultMaxWasmSize uint64 = 800 * 1024
@@ -16,8 +18,13 @@ func DefaultParams() Params {
 // ValidateBasic performs basic validation on wasm-storage
 // module parameters.
 func (p Params) ValidateBasic() error {
-	err := validateMaxWasmSize(p.MaxWasmSize)
-	return err
+	if err := validateMaxWasmSize(p.MaxWasmSize); err != nil {
+		return err
+	}
+	if err := validateProxyContractCodeIDs(p.ProxyContractCodeIDs); err != nil {
+		return err
+	}
+	return validateProxyContractAdmin(p.ProxyContractAdmin)
 }
 
 func validateMaxWasmSize(i interface{}) error {
@@ -30,3 +37,27 @@ func validateMaxWasmSize(i interface{}) error {
 	}
 	return nil
 }
+
+func validateProxyContractCodeIDs(codeIDs []uint64) error {
+	seen := make(map[uint64]bool)
+	for _, codeID := range codeIDs {
+		if codeID == 0 {
+			return fmt.Errorf("invalid proxy contract code ID: %d", codeID)
+		}
+		if seen[codeID] {
+			return fmt.Errorf("duplicate proxy contract code ID: %d", codeID)
+		}
+		seen[codeID] = true
+	}
+	return nil
+}
+
+func validateProxyContractAdmin(admin string) error {
+	if admin == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(admin); err != nil {
+		return fmt.Errorf("invalid proxy contract admin: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	// DefaultProxyContractName is the name of the Proxy Contract
+	// registry entry for the data request Proxy Contract.
+	DefaultProxyContractName = "data-request"
+
+	// MaxProxyContractNameLength is the maximum length of the name of
+	// a Proxy Contract registry entry.
+	MaxProxyContractNameLength = 64
+)
+
+// ValidateProxyContractName checks that a given Proxy Contract registry
+// entry name is non-empty, not too long, and consists of lowercase
+// letters, digits, hyphens, and underscores.
+func ValidateProxyContractName(name string) error {
+	if name == "" {
+		return fmt.Errorf("empty proxy contract name")
+	}
+	if len(name) > MaxProxyContractNameLength {
+		return fmt.Errorf("proxy contract name cannot be longer than %d characters", MaxProxyContractNameLength)
+	}
+	for _, c := range name {
+		if (c < 'a' || c > 'z') && (c < '0' || c > '9') && c != '-' && c != '_' {
+			return fmt.Errorf("invalid character %q in proxy contract name %s", c, name)
+		}
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -372,6 +373,245 @@ func (m *QueryOverlayWasmsResponse) GetHashTypePairs() []string {
 	return nil
 }
 
+// The request message for QueryWasmBytecode RPC.
+type QueryWasmBytecodeRequest struct {
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// offset is the position in the bytecode from which to read.
+	Offset uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// limit is the maximum number of bytes to return. If zero or larger than
+	// the maximum chunk size, the maximum chunk size is used.
+	Limit uint64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *QueryWasmBytecodeRequest) Reset()         { *m = QueryWasmBytecodeRequest{} }
+func (m *QueryWasmBytecodeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWasmBytecodeRequest) ProtoMessage()    {}
+func (*QueryWasmBytecodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e3a991a3b0319b3, []int{8}
+}
+func (m *QueryWasmBytecodeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWasmBytecodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWasmBytecodeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWasmBytecodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWasmBytecodeRequest.Merge(m, src)
+}
+func (m *QueryWasmBytecodeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWasmBytecodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWasmBytecodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWasmBytecodeRequest proto.InternalMessageInfo
+
+func (m *QueryWasmBytecodeRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *QueryWasmBytecodeRequest) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *QueryWasmBytecodeRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// The response message for QueryWasmBytecode RPC.
+type QueryWasmBytecodeResponse struct {
+	Chunk     []byte   `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	Offset    uint64   `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	TotalSize uint64   `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	WasmType  WasmType `protobuf:"varint,4,opt,name=wasm_type,json=wasmType,proto3,enum=sedachain.wasm_storage.v1.WasmType" json:"wasm_type,omitempty"`
+}
+
+func (m *QueryWasmBytecodeResponse) Reset()         { *m = QueryWasmBytecodeResponse{} }
+func (m *QueryWasmBytecodeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWasmBytecodeResponse) ProtoMessage()    {}
+func (*QueryWasmBytecodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e3a991a3b0319b3, []int{9}
+}
+func (m *QueryWasmBytecodeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWasmBytecodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWasmBytecodeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWasmBytecodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWasmBytecodeResponse.Merge(m, src)
+}
+func (m *QueryWasmBytecodeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWasmBytecodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWasmBytecodeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWasmBytecodeResponse proto.InternalMessageInfo
+
+func (m *QueryWasmBytecodeResponse) GetChunk() []byte {
+	if m != nil {
+		return m.Chunk
+	}
+	return nil
+}
+
+func (m *QueryWasmBytecodeResponse) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *QueryWasmBytecodeResponse) GetTotalSize() uint64 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+func (m *QueryWasmBytecodeResponse) GetWasmType() WasmType {
+	if m != nil {
+		return m.WasmType
+	}
+	return WasmTypeNil
+}
+
+// The request message for QueryActiveOverlay RPC.
+type QueryActiveOverlayRequest struct {
+	WasmType WasmType `protobuf:"varint,1,opt,name=wasm_type,json=wasmType,proto3,enum=sedachain.wasm_storage.v1.WasmType" json:"wasm_type,omitempty"`
+}
+
+func (m *QueryActiveOverlayRequest) Reset()         { *m = QueryActiveOverlayRequest{} }
+func (m *QueryActiveOverlayRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryActiveOverlayRequest) ProtoMessage()    {}
+func (*QueryActiveOverlayRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e3a991a3b0319b3, []int{10}
+}
+func (m *QueryActiveOverlayRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActiveOverlayRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActiveOverlayRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryActiveOverlayRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActiveOverlayRequest.Merge(m, src)
+}
+func (m *QueryActiveOverlayRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActiveOverlayRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActiveOverlayRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryActiveOverlayRequest proto.InternalMessageInfo
+
+func (m *QueryActiveOverlayRequest) GetWasmType() WasmType {
+	if m != nil {
+		return m.WasmType
+	}
+	return WasmTypeNil
+}
+
+// The response message for QueryActiveOverlay RPC.
+type QueryActiveOverlayResponse struct {
+	Active    *OverlayVersion  `protobuf:"bytes,1,opt,name=active,proto3" json:"active,omitempty"`
+	Scheduled *OverlayVersion  `protobuf:"bytes,2,opt,name=scheduled,proto3" json:"scheduled,omitempty"`
+	History   []OverlayVersion `protobuf:"bytes,3,rep,name=history,proto3" json:"history"`
+}
+
+func (m *QueryActiveOverlayResponse) Reset()         { *m = QueryActiveOverlayResponse{} }
+func (m *QueryActiveOverlayResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryActiveOverlayResponse) ProtoMessage()    {}
+func (*QueryActiveOverlayResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e3a991a3b0319b3, []int{11}
+}
+func (m *QueryActiveOverlayResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActiveOverlayResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActiveOverlayResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryActiveOverlayResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActiveOverlayResponse.Merge(m, src)
+}
+func (m *QueryActiveOverlayResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActiveOverlayResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActiveOverlayResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryActiveOverlayResponse proto.InternalMessageInfo
+
+func (m *QueryActiveOverlayResponse) GetActive() *OverlayVersion {
+	if m != nil {
+		return m.Active
+	}
+	return nil
+}
+
+func (m *QueryActiveOverlayResponse) GetScheduled() *OverlayVersion {
+	if m != nil {
+		return m.Scheduled
+	}
+	return nil
+}
+
+func (m *QueryActiveOverlayResponse) GetHistory() []OverlayVersion {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
 // The request message for QueryProxyContractRegistry RPC.
 type QueryProxyContractRegistryRequest struct {
 }
@@ -380,7 +620,7 @@ func (m *QueryProxyContractRegistryRequest) Reset()         { *m = QueryProxyCon
 func (m *QueryProxyContractRegistryRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryProxyContractRegistryRequest) ProtoMessage()    {}
 func (*QueryProxyContractRegistryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e3a991a3b0319b3, []int{8}
+	return fileDescriptor_0e3a991a3b0319b3, []int{12}
 }
 func (m *QueryProxyContractRegistryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -418,7 +658,7 @@ func (m *QueryProxyContractRegistryResponse) Reset()         { *m = QueryProxyCo
 func (m *QueryProxyContractRegistryResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryProxyContractRegistryResponse) ProtoMessage()    {}
 func (*QueryProxyContractRegistryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e3a991a3b0319b3, []int{9}
+	return fileDescriptor_0e3a991a3b0319b3, []int{13}
 }
 func (m *QueryProxyContractRegistryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -454,6 +694,178 @@ func (m *QueryProxyContractRegistryResponse) GetAddress() string {
 	return ""
 }
 
+// The request message for QueryProxyContracts RPC.
+type QueryProxyContractsRequest struct {
+}
+
+func (m *QueryProxyContractsRequest) Reset()         { *m = QueryProxyContractsRequest{} }
+func (m *QueryProxyContractsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProxyContractsRequest) ProtoMessage()    {}
+func (*QueryProxyContractsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e3a991a3b0319b3, []int{14}
+}
+func (m *QueryProxyContractsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProxyContractsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProxyContractsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProxyContractsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProxyContractsRequest.Merge(m, src)
+}
+func (m *QueryProxyContractsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProxyContractsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProxyContractsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProxyContractsRequest proto.InternalMessageInfo
+
+// The response message for QueryProxyContracts RPC.
+type QueryProxyContractsResponse struct {
+	ProxyContracts []ProxyContract `protobuf:"bytes,1,rep,name=proxy_contracts,json=proxyContracts,proto3" json:"proxy_contracts"`
+}
+
+func (m *QueryProxyContractsResponse) Reset()         { *m = QueryProxyContractsResponse{} }
+func (m *QueryProxyContractsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProxyContractsResponse) ProtoMessage()    {}
+func (*QueryProxyContractsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e3a991a3b0319b3, []int{15}
+}
+func (m *QueryProxyContractsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProxyContractsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProxyContractsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProxyContractsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProxyContractsResponse.Merge(m, src)
+}
+func (m *QueryProxyContractsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProxyContractsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProxyContractsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProxyContractsResponse proto.InternalMessageInfo
+
+func (m *QueryProxyContractsResponse) GetProxyContracts() []ProxyContract {
+	if m != nil {
+		return m.ProxyContracts
+	}
+	return nil
+}
+
+// The request message for QueryProxyContractHistory RPC.
+type QueryProxyContractHistoryRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *QueryProxyContractHistoryRequest) Reset()         { *m = QueryProxyContractHistoryRequest{} }
+func (m *QueryProxyContractHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProxyContractHistoryRequest) ProtoMessage()    {}
+func (*QueryProxyContractHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e3a991a3b0319b3, []int{16}
+}
+func (m *QueryProxyContractHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProxyContractHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProxyContractHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProxyContractHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProxyContractHistoryRequest.Merge(m, src)
+}
+func (m *QueryProxyContractHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProxyContractHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProxyContractHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProxyContractHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryProxyContractHistoryRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// The response message for QueryProxyContractHistory RPC.
+type QueryProxyContractHistoryResponse struct {
+	History []ProxyContractChange `protobuf:"bytes,1,rep,name=history,proto3" json:"history"`
+}
+
+func (m *QueryProxyContractHistoryResponse) Reset()         { *m = QueryProxyContractHistoryResponse{} }
+func (m *QueryProxyContractHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProxyContractHistoryResponse) ProtoMessage()    {}
+func (*QueryProxyContractHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e3a991a3b0319b3, []int{17}
+}
+func (m *QueryProxyContractHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProxyContractHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProxyContractHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProxyContractHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProxyContractHistoryResponse.Merge(m, src)
+}
+func (m *QueryProxyContractHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProxyContractHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProxyContractHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProxyContractHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryProxyContractHistoryResponse) GetHistory() []ProxyContractChange {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryDataRequestWasmRequest)(nil), "sedachain.wasm_storage.v1.QueryDataRequestWasmRequest")
 	proto.RegisterType((*QueryDataRequestWasmResponse)(nil), "sedachain.wasm_storage.v1.QueryDataRequestWasmResponse")
@@ -463,8 +875,16 @@ func init() {
 	proto.RegisterType((*QueryOverlayWasmResponse)(nil), "sedachain.wasm_storage.v1.QueryOverlayWasmResponse")
 	proto.RegisterType((*QueryOverlayWasmsRequest)(nil), "sedachain.wasm_storage.v1.QueryOverlayWasmsRequest")
 	proto.RegisterType((*QueryOverlayWasmsResponse)(nil), "sedachain.wasm_storage.v1.QueryOverlayWasmsResponse")
+	proto.RegisterType((*QueryWasmBytecodeRequest)(nil), "sedachain.wasm_storage.v1.QueryWasmBytecodeRequest")
+	proto.RegisterType((*QueryWasmBytecodeResponse)(nil), "sedachain.wasm_storage.v1.QueryWasmBytecodeResponse")
+	proto.RegisterType((*QueryActiveOverlayRequest)(nil), "sedachain.wasm_storage.v1.QueryActiveOverlayRequest")
+	proto.RegisterType((*QueryActiveOverlayResponse)(nil), "sedachain.wasm_storage.v1.QueryActiveOverlayResponse")
 	proto.RegisterType((*QueryProxyContractRegistryRequest)(nil), "sedachain.wasm_storage.v1.QueryProxyContractRegistryRequest")
 	proto.RegisterType((*QueryProxyContractRegistryResponse)(nil), "sedachain.wasm_storage.v1.QueryProxyContractRegistryResponse")
+	proto.RegisterType((*QueryProxyContractsRequest)(nil), "sedachain.wasm_storage.v1.QueryProxyContractsRequest")
+	proto.RegisterType((*QueryProxyContractsResponse)(nil), "sedachain.wasm_storage.v1.QueryProxyContractsResponse")
+	proto.RegisterType((*QueryProxyContractHistoryRequest)(nil), "sedachain.wasm_storage.v1.QueryProxyContractHistoryRequest")
+	proto.RegisterType((*QueryProxyContractHistoryResponse)(nil), "sedachain.wasm_storage.v1.QueryProxyContractHistoryResponse")
 }
 
 func init() {
@@ -472,43 +892,69 @@ func init() {
 }
 
 var fileDescriptor_0e3a991a3b0319b3 = []byte{
-	// 561 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4f, 0x6b, 0x13, 0x41,
-	0x14, 0xcf, 0x68, 0xb5, 0xf4, 0x55, 0xa9, 0x0c, 0x88, 0xe9, 0x5a, 0xd7, 0xba, 0x62, 0x09, 0xd8,
-	0xec, 0x9a, 0xa4, 0xd8, 0x0a, 0xea, 0xc1, 0x0a, 0x1e, 0xdb, 0x46, 0x41, 0xf0, 0xb2, 0x4c, 0x93,
-	0x61, 0xb3, 0x90, 0xec, 0x6c, 0x67, 0x26, 0xb1, 0x8b, 0x78, 0xf1, 0x13, 0x08, 0x7e, 0x0a, 0xc1,
-	0x83, 0x67, 0xc1, 0xbb, 0x27, 0x29, 0x78, 0xf1, 0x28, 0x89, 0x1f, 0x44, 0x66, 0x32, 0x89, 0x49,
-	0x93, 0x4d, 0xba, 0x7a, 0x9b, 0xcc, 0xbc, 0xdf, 0xbf, 0x37, 0x6f, 0xb2, 0x70, 0x47, 0xd0, 0x3a,
-	0xa9, 0x35, 0x48, 0x18, 0x79, 0xaf, 0x89, 0x68, 0xf9, 0x42, 0x32, 0x4e, 0x02, 0xea, 0x75, 0x4a,
-	0xde, 0x51, 0x9b, 0xf2, 0xc4, 0x8d, 0x39, 0x93, 0x0c, 0xaf, 0x0e, 0xcb, 0xdc, 0xd1, 0x32, 0xb7,
-	0x53, 0xb2, 0xd6, 0x02, 0xc6, 0x82, 0x26, 0xf5, 0x48, 0x1c, 0x7a, 0x24, 0x8a, 0x98, 0x24, 0x32,
-	0x64, 0x91, 0xe8, 0x03, 0xad, 0xcd, 0x74, 0xfe, 0x31, 0x22, 0x5d, 0xed, 0x94, 0xe0, 0xfa, 0x81,
-	0x52, 0x7d, 0x4a, 0x24, 0xa9, 0xd2, 0xa3, 0x36, 0x15, 0xf2, 0x25, 0x11, 0x2d, 0xb3, 0xc4, 0x18,
-	0x16, 0x1a, 0x44, 0x34, 0xf2, 0x68, 0x1d, 0x15, 0x96, 0xaa, 0x7a, 0xed, 0x3c, 0x87, 0xb5, 0xe9,
-	0x10, 0x11, 0xb3, 0x48, 0x50, 0x5c, 0x81, 0x05, 0x25, 0xa4, 0x31, 0xcb, 0xe5, 0x9b, 0x6e, 0x6a,
-	0x10, 0x57, 0xc3, 0x74, 0xb1, 0x63, 0x4f, 0x27, 0x15, 0x66, 0xed, 0x3c, 0x83, 0x1b, 0x29, 0xe7,
-	0x46, 0x75, 0x03, 0x56, 0x94, 0x3b, 0x5f, 0x26, 0x31, 0xf5, 0x63, 0x12, 0x72, 0x91, 0x47, 0xeb,
-	0xe7, 0x0b, 0x4b, 0xd5, 0xcb, 0x6a, 0xfb, 0x45, 0x12, 0xd3, 0x7d, 0xb5, 0xe9, 0x14, 0xe1, 0x9a,
-	0x26, 0xda, 0xeb, 0x50, 0xde, 0x24, 0xc9, 0xbc, 0xb0, 0x7b, 0x90, 0x9f, 0x2c, 0xff, 0x9f, 0xa0,
-	0xd6, 0x24, 0xe1, 0x30, 0xe4, 0x2e, 0xac, 0x4e, 0x39, 0xcb, 0x18, 0xf0, 0x36, 0xdc, 0xd2, 0x24,
-	0xfb, 0x9c, 0x1d, 0x27, 0xbb, 0x2c, 0x92, 0x9c, 0xd4, 0x64, 0x95, 0x06, 0xa1, 0x90, 0x3c, 0x19,
-	0x28, 0x3d, 0x06, 0x67, 0x56, 0x91, 0x91, 0xcc, 0xc3, 0x22, 0xa9, 0xd7, 0x39, 0x15, 0xc2, 0xf4,
-	0x64, 0xf0, 0xb3, 0xfc, 0x79, 0x11, 0x2e, 0x68, 0x02, 0xfc, 0x15, 0xc1, 0xca, 0xa9, 0x4b, 0xc1,
-	0xf7, 0x67, 0xb4, 0x62, 0xc6, 0xb4, 0x59, 0xdb, 0x99, 0x71, 0x7d, 0xa3, 0xce, 0x83, 0x77, 0x3f,
-	0x7e, 0x7f, 0x38, 0x57, 0xc1, 0x25, 0x4f, 0x11, 0x14, 0xff, 0x4e, 0x7f, 0x71, 0x30, 0xfd, 0x75,
-	0x22, 0x89, 0xcf, 0xfb, 0x50, 0x5f, 0x9d, 0x78, 0x6f, 0x54, 0xdb, 0xde, 0xe2, 0x2f, 0x08, 0xae,
-	0x9c, 0x1e, 0x2a, 0x9c, 0xd5, 0xc8, 0xe0, 0x06, 0xad, 0x9d, 0xec, 0x40, 0x13, 0xa1, 0xa2, 0x23,
-	0x14, 0xf1, 0xdd, 0xb3, 0x47, 0x10, 0xf8, 0x13, 0x82, 0xe5, 0x91, 0x61, 0xc1, 0xe5, 0x79, 0xf2,
-	0x93, 0x53, 0x6f, 0x55, 0x32, 0x61, 0x8c, 0xdb, 0x2d, 0xed, 0xd6, 0xc5, 0x9b, 0xa9, 0x6e, 0x59,
-	0x1f, 0x35, 0xd6, 0xeb, 0x8f, 0x08, 0x2e, 0x8d, 0xce, 0x36, 0xce, 0xa2, 0x3d, 0xec, 0xf1, 0x56,
-	0x36, 0x90, 0x71, 0xec, 0x6a, 0xc7, 0x05, 0xbc, 0x71, 0x26, 0xc7, 0x02, 0x7f, 0x47, 0x70, 0x75,
-	0xea, 0xeb, 0xc0, 0x0f, 0xe7, 0xe9, 0xcf, 0x7a, 0x79, 0xd6, 0xa3, 0x7f, 0x44, 0x9b, 0x18, 0x3b,
-	0x3a, 0x46, 0x19, 0xdf, 0x4b, 0x8d, 0x11, 0x2b, 0xbc, 0x5f, 0x33, 0x04, 0x3e, 0x37, 0x0c, 0x4f,
-	0x0e, 0xbe, 0x75, 0x6d, 0x74, 0xd2, 0xb5, 0xd1, 0xaf, 0xae, 0x8d, 0xde, 0xf7, 0xec, 0xdc, 0x49,
-	0xcf, 0xce, 0xfd, 0xec, 0xd9, 0xb9, 0x57, 0xdb, 0x41, 0x28, 0x1b, 0xed, 0x43, 0xb7, 0xc6, 0x5a,
-	0x9a, 0x55, 0x7f, 0x19, 0x6a, 0xac, 0x39, 0x2a, 0x71, 0x3c, 0x2e, 0xa2, 0xfe, 0x84, 0xc4, 0xe1,
-	0x45, 0x5d, 0x59, 0xf9, 0x13, 0x00, 0x00, 0xff, 0xff, 0x4d, 0x03, 0x35, 0xcc, 0xd3, 0x06, 0x00,
-	0x00,
+	// 980 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xce, 0x34, 0x4e, 0x8a, 0xdf, 0x7c, 0xa1, 0x51, 0x80, 0xcd, 0x36, 0x75, 0xdd, 0xad, 0xa8,
+	0x0c, 0xd4, 0xbb, 0x8d, 0x9d, 0xa4, 0x41, 0x2d, 0x15, 0x4d, 0x90, 0x0a, 0x17, 0xda, 0x6e, 0x11,
+	0x95, 0x10, 0x68, 0x35, 0x59, 0x4f, 0xed, 0x15, 0xf6, 0x8e, 0xbb, 0x33, 0x76, 0xeb, 0x46, 0xb9,
+	0xf0, 0x0b, 0x90, 0xf8, 0x11, 0x08, 0xc4, 0x01, 0x8e, 0x20, 0x71, 0xef, 0x09, 0x15, 0x71, 0xe1,
+	0x84, 0x50, 0xc2, 0x3f, 0xe0, 0x0f, 0xa0, 0x9d, 0x9d, 0x75, 0xbc, 0xf1, 0xfa, 0x63, 0xc3, 0x6d,
+	0x76, 0xe7, 0x7d, 0x9e, 0xf7, 0x79, 0x5e, 0xcf, 0xce, 0x23, 0xc3, 0x9b, 0x9c, 0xd6, 0x88, 0xdb,
+	0x20, 0x9e, 0x6f, 0x3d, 0x25, 0xbc, 0xe5, 0x70, 0xc1, 0x02, 0x52, 0xa7, 0x56, 0x77, 0xc3, 0x7a,
+	0xd2, 0xa1, 0x41, 0xcf, 0x6c, 0x07, 0x4c, 0x30, 0xbc, 0xd6, 0x2f, 0x33, 0x07, 0xcb, 0xcc, 0xee,
+	0x86, 0xbe, 0x5a, 0x67, 0x75, 0x26, 0xab, 0xac, 0x70, 0x15, 0x01, 0xf4, 0xf5, 0x3a, 0x63, 0xf5,
+	0x26, 0xb5, 0x48, 0xdb, 0xb3, 0x88, 0xef, 0x33, 0x41, 0x84, 0xc7, 0x7c, 0xae, 0x76, 0xaf, 0x8d,
+	0xee, 0x9a, 0xa0, 0x97, 0xd5, 0xc6, 0x06, 0x5c, 0x78, 0x10, 0x6a, 0xf9, 0x80, 0x08, 0x62, 0xd3,
+	0x27, 0x1d, 0xca, 0xc5, 0x23, 0xc2, 0x5b, 0x6a, 0x89, 0x31, 0xe4, 0x1a, 0x84, 0x37, 0x34, 0x54,
+	0x44, 0xa5, 0xbc, 0x2d, 0xd7, 0xc6, 0x43, 0x58, 0x4f, 0x87, 0xf0, 0x36, 0xf3, 0x39, 0xc5, 0x55,
+	0xc8, 0x85, 0x8d, 0x24, 0x66, 0xa1, 0x72, 0xc9, 0x1c, 0x69, 0xcf, 0x94, 0x30, 0x59, 0x6c, 0x14,
+	0xd2, 0x49, 0xb9, 0x5a, 0x1b, 0x77, 0xe1, 0xe2, 0x88, 0x7d, 0xd5, 0xf5, 0x2a, 0xac, 0x84, 0xea,
+	0x1c, 0xd1, 0x6b, 0x53, 0xa7, 0x4d, 0xbc, 0x80, 0x6b, 0xa8, 0x38, 0x5b, 0xca, 0xdb, 0x4b, 0xe1,
+	0xeb, 0x4f, 0x7a, 0x6d, 0x7a, 0x3f, 0x7c, 0x69, 0x94, 0xe1, 0x0d, 0x49, 0x74, 0xaf, 0x4b, 0x83,
+	0x26, 0xe9, 0x4d, 0x32, 0x7b, 0x0f, 0xb4, 0xe1, 0xf2, 0xff, 0x63, 0x54, 0x1f, 0x26, 0xec, 0x9b,
+	0xdc, 0x83, 0xb5, 0x94, 0xbd, 0x8c, 0x06, 0x3f, 0x57, 0x0d, 0x42, 0xf4, 0x6e, 0x4f, 0x50, 0x97,
+	0xd5, 0xe8, 0x18, 0x87, 0xf8, 0x75, 0x98, 0x67, 0x8f, 0x1f, 0x73, 0x2a, 0xb4, 0x73, 0x45, 0x54,
+	0xca, 0xd9, 0xea, 0x09, 0xaf, 0xc2, 0x5c, 0xd3, 0x6b, 0x79, 0x42, 0x9b, 0x95, 0xaf, 0xa3, 0x07,
+	0xe3, 0x7b, 0x04, 0x6b, 0x29, 0xf4, 0x4a, 0xe3, 0x2a, 0xcc, 0xb9, 0x8d, 0x8e, 0xff, 0xa5, 0x6c,
+	0xb0, 0x68, 0x47, 0x0f, 0x23, 0x3b, 0x5c, 0x04, 0x10, 0x4c, 0x90, 0xa6, 0xc3, 0xbd, 0xe7, 0x54,
+	0xb5, 0xc9, 0xcb, 0x37, 0x0f, 0xbd, 0xe7, 0x14, 0xbf, 0x0f, 0x79, 0x39, 0xc7, 0xd0, 0xb0, 0x96,
+	0x2b, 0xa2, 0xd2, 0x72, 0xe5, 0xca, 0x84, 0x19, 0x87, 0x53, 0xb0, 0x5f, 0x79, 0xaa, 0x56, 0xc6,
+	0x17, 0x4a, 0xeb, 0x1d, 0x57, 0x78, 0x5d, 0xaa, 0xa6, 0x1a, 0xcf, 0x22, 0x41, 0x8f, 0xce, 0x42,
+	0xff, 0x2f, 0x02, 0x3d, 0x8d, 0x5f, 0x0d, 0xe3, 0x0e, 0xcc, 0x13, 0xb9, 0xa1, 0x0e, 0xc8, 0x5b,
+	0x63, 0xd8, 0x15, 0xf6, 0x53, 0x1a, 0x70, 0x8f, 0xf9, 0xb6, 0x02, 0xe2, 0xbb, 0x90, 0xe7, 0x6e,
+	0x83, 0xd6, 0x3a, 0x4d, 0x5a, 0xd3, 0xce, 0x65, 0x65, 0x39, 0xc1, 0xe2, 0x8f, 0xe0, 0x7c, 0xc3,
+	0x0b, 0x2b, 0x7b, 0xda, 0x6c, 0x71, 0x36, 0x13, 0xcd, 0x6e, 0xee, 0xc5, 0x5f, 0x97, 0x66, 0xec,
+	0x18, 0x6f, 0x5c, 0x81, 0xcb, 0xd2, 0xf4, 0xfd, 0x80, 0x3d, 0xeb, 0xed, 0x31, 0x5f, 0x04, 0xc4,
+	0x15, 0x36, 0xad, 0x7b, 0x5c, 0x04, 0xf1, 0x70, 0x8d, 0xdb, 0x60, 0x8c, 0x2b, 0x52, 0x13, 0xd2,
+	0xe0, 0x3c, 0xa9, 0xd5, 0x02, 0xca, 0xb9, 0x3a, 0x91, 0xf1, 0xa3, 0xb1, 0x0e, 0xfa, 0x30, 0xbe,
+	0xff, 0x9d, 0x74, 0xe1, 0x42, 0xea, 0xae, 0xa2, 0x7d, 0x04, 0x2b, 0xed, 0x70, 0xc7, 0x71, 0xe3,
+	0x2d, 0xf9, 0xa5, 0x2c, 0x54, 0x4a, 0x63, 0x4c, 0x27, 0xb8, 0x94, 0xe7, 0xe5, 0x76, 0xa2, 0x81,
+	0xb1, 0x0d, 0xc5, 0xe1, 0xbe, 0x1f, 0x46, 0x73, 0x19, 0xf8, 0xc4, 0x7c, 0xd2, 0xa2, 0xf1, 0x27,
+	0x16, 0xae, 0x0d, 0x0e, 0x97, 0xc7, 0xe0, 0x94, 0xea, 0x8f, 0x4f, 0x7e, 0xa2, 0x48, 0xad, 0x39,
+	0xad, 0xda, 0xbd, 0x06, 0xf1, 0xeb, 0xf4, 0xd4, 0xef, 0x54, 0xf9, 0x76, 0x09, 0xe6, 0x64, 0x57,
+	0xfc, 0x2b, 0x82, 0x95, 0x53, 0xf7, 0x26, 0xde, 0x1e, 0x43, 0x3e, 0x26, 0x10, 0xf4, 0x1b, 0x99,
+	0x71, 0x91, 0x3d, 0xe3, 0xdd, 0xaf, 0xfe, 0xf8, 0xe7, 0x9b, 0x73, 0x55, 0xbc, 0x61, 0x85, 0x04,
+	0xe5, 0x93, 0x80, 0x2a, 0xc7, 0x01, 0x55, 0x23, 0x82, 0x38, 0x41, 0x04, 0x75, 0xc2, 0x1d, 0xeb,
+	0x20, 0xbc, 0xa0, 0x0e, 0xf1, 0x2f, 0x08, 0x5e, 0x3d, 0x45, 0xcb, 0x71, 0x56, 0x21, 0xf1, 0xe1,
+	0xd1, 0x77, 0xb2, 0x03, 0x95, 0x85, 0xaa, 0xb4, 0x50, 0xc6, 0xef, 0x4c, 0x6f, 0x81, 0xe3, 0x1f,
+	0x10, 0x2c, 0x0c, 0xdc, 0xe7, 0xb8, 0x32, 0xa9, 0xfd, 0x70, 0x30, 0xe9, 0xd5, 0x4c, 0x18, 0xa5,
+	0x76, 0x53, 0xaa, 0x35, 0xf1, 0xb5, 0x91, 0x6a, 0x59, 0x84, 0x4a, 0xcc, 0xfa, 0x3b, 0x04, 0x8b,
+	0x03, 0x6c, 0x1c, 0x67, 0xe9, 0xdd, 0x9f, 0xf1, 0x66, 0x36, 0x90, 0x52, 0x6c, 0x4a, 0xc5, 0x25,
+	0x7c, 0x75, 0x2a, 0xc5, 0x1c, 0xff, 0x88, 0x60, 0x71, 0x30, 0x86, 0x26, 0x6b, 0x4d, 0xc9, 0x44,
+	0x7d, 0x33, 0x1b, 0x48, 0x69, 0xdd, 0x92, 0x5a, 0x2d, 0x5c, 0x1e, 0xa9, 0x55, 0x72, 0xed, 0x2b,
+	0x5c, 0x3c, 0xde, 0x9f, 0x11, 0x2c, 0x25, 0xd2, 0x02, 0x4f, 0x6c, 0x9f, 0x16, 0x5e, 0xfa, 0x56,
+	0x46, 0x94, 0x52, 0x7d, 0x53, 0xaa, 0xde, 0xc2, 0xd5, 0x91, 0xaa, 0xa3, 0xe0, 0x71, 0xd4, 0xa0,
+	0xad, 0x83, 0x7e, 0x44, 0x1e, 0xe2, 0xdf, 0x10, 0xbc, 0x96, 0x7a, 0x9f, 0xe3, 0x5b, 0x93, 0xd4,
+	0x8c, 0xcb, 0x0a, 0xfd, 0xbd, 0x33, 0xa2, 0x95, 0xa7, 0x1d, 0xe9, 0xa9, 0x82, 0xaf, 0x8f, 0xf4,
+	0x94, 0x0c, 0x03, 0x27, 0x88, 0x65, 0xff, 0x84, 0x60, 0x39, 0xc1, 0xcd, 0xf1, 0x56, 0x26, 0x2d,
+	0xfd, 0xf3, 0xbe, 0x9d, 0x15, 0xa6, 0xb4, 0x5f, 0x97, 0xda, 0xdf, 0xc6, 0xa5, 0x29, 0xb5, 0x73,
+	0xfc, 0x3b, 0x82, 0xd5, 0xb4, 0x18, 0xc1, 0x37, 0x33, 0x49, 0x48, 0x86, 0x96, 0x7e, 0xeb, 0x6c,
+	0x60, 0xe5, 0xe2, 0xb6, 0x74, 0xb1, 0x83, 0xb7, 0xa7, 0xfd, 0x05, 0x54, 0x44, 0x59, 0x07, 0x61,
+	0x3a, 0x1e, 0xee, 0x3e, 0x78, 0x71, 0x54, 0x40, 0x2f, 0x8f, 0x0a, 0xe8, 0xef, 0xa3, 0x02, 0xfa,
+	0xfa, 0xb8, 0x30, 0xf3, 0xf2, 0xb8, 0x30, 0xf3, 0xe7, 0x71, 0x61, 0xe6, 0xb3, 0x1b, 0x75, 0x4f,
+	0x34, 0x3a, 0xfb, 0xa6, 0xcb, 0x5a, 0x92, 0x5b, 0xfe, 0x67, 0x71, 0x59, 0x73, 0xb0, 0xd1, 0xb3,
+	0x64, 0xab, 0xf0, 0xac, 0xf2, 0xfd, 0x79, 0x59, 0x59, 0xfd, 0x6f, 0x00, 0x2c, 0x2e, 0xee, 0x05,
+	0x83, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -531,8 +977,21 @@ type QueryClient interface {
 	OverlayWasm(ctx context.Context, in *QueryOverlayWasmRequest, opts ...grpc.CallOption) (*QueryOverlayWasmResponse, error)
 	// OverlayWasms returns all Overlay Wasms.
 	OverlayWasms(ctx context.Context, in *QueryOverlayWasmsRequest, opts ...grpc.CallOption) (*QueryOverlayWasmsResponse, error)
-	// ProxyContractRegistry returns the Proxy Contract Registry address.
+	// WasmBytecode returns a chunk of the bytecode of a Data Request Wasm or
+	// an Overlay Wasm given its hash. Clients can stream the entire bytecode
+	// by advancing the offset until it reaches the total size.
+	WasmBytecode(ctx context.Context, in *QueryWasmBytecodeRequest, opts ...grpc.CallOption) (*QueryWasmBytecodeResponse, error)
+	// ActiveOverlay returns the active, scheduled, and previously active
+	// versions of a given Overlay Wasm type.
+	ActiveOverlay(ctx context.Context, in *QueryActiveOverlayRequest, opts ...grpc.CallOption) (*QueryActiveOverlayResponse, error)
+	// ProxyContractRegistry returns the address of the data request Proxy
+	// Contract.
 	ProxyContractRegistry(ctx context.Context, in *QueryProxyContractRegistryRequest, opts ...grpc.CallOption) (*QueryProxyContractRegistryResponse, error)
+	// ProxyContracts returns all entries of the Proxy Contract registry.
+	ProxyContracts(ctx context.Context, in *QueryProxyContractsRequest, opts ...grpc.CallOption) (*QueryProxyContractsResponse, error)
+	// ProxyContractHistory returns the changes made to a given entry of the
+	// Proxy Contract registry, starting from the most recent one.
+	ProxyContractHistory(ctx context.Context, in *QueryProxyContractHistoryRequest, opts ...grpc.CallOption) (*QueryProxyContractHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -579,6 +1038,24 @@ func (c *queryClient) OverlayWasms(ctx context.Context, in *QueryOverlayWasmsReq
 	return out, nil
 }
 
+func (c *queryClient) WasmBytecode(ctx context.Context, in *QueryWasmBytecodeRequest, opts ...grpc.CallOption) (*QueryWasmBytecodeResponse, error) {
+	out := new(QueryWasmBytecodeResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Query/WasmBytecode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ActiveOverlay(ctx context.Context, in *QueryActiveOverlayRequest, opts ...grpc.CallOption) (*QueryActiveOverlayResponse, error) {
+	out := new(QueryActiveOverlayResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Query/ActiveOverlay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ProxyContractRegistry(ctx context.Context, in *QueryProxyContractRegistryRequest, opts ...grpc.CallOption) (*QueryProxyContractRegistryResponse, error) {
 	out := new(QueryProxyContractRegistryResponse)
 	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Query/ProxyContractRegistry", in, out, opts...)
@@ -588,18 +1065,49 @@ func (c *queryClient) ProxyContractRegistry(ctx context.Context, in *QueryProxyC
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// DataRequestWasm returns Data Request Wasm given its hash.
-	DataRequestWasm(context.Context, *QueryDataRequestWasmRequest) (*QueryDataRequestWasmResponse, error)
-	// DataRequestWasms returns all Data Request Wasms.
-	DataRequestWasms(context.Context, *QueryDataRequestWasmsRequest) (*QueryDataRequestWasmsResponse, error)
+func (c *queryClient) ProxyContracts(ctx context.Context, in *QueryProxyContractsRequest, opts ...grpc.CallOption) (*QueryProxyContractsResponse, error) {
+	out := new(QueryProxyContractsResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Query/ProxyContracts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProxyContractHistory(ctx context.Context, in *QueryProxyContractHistoryRequest, opts ...grpc.CallOption) (*QueryProxyContractHistoryResponse, error) {
+	out := new(QueryProxyContractHistoryResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Query/ProxyContractHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// DataRequestWasm returns Data Request Wasm given its hash.
+	DataRequestWasm(context.Context, *QueryDataRequestWasmRequest) (*QueryDataRequestWasmResponse, error)
+	// DataRequestWasms returns all Data Request Wasms.
+	DataRequestWasms(context.Context, *QueryDataRequestWasmsRequest) (*QueryDataRequestWasmsResponse, error)
 	// OverlayWasm returns Overlay Wasm given its hash.
 	OverlayWasm(context.Context, *QueryOverlayWasmRequest) (*QueryOverlayWasmResponse, error)
 	// OverlayWasms returns all Overlay Wasms.
 	OverlayWasms(context.Context, *QueryOverlayWasmsRequest) (*QueryOverlayWasmsResponse, error)
-	// ProxyContractRegistry returns the Proxy Contract Registry address.
+	// WasmBytecode returns a chunk of the bytecode of a Data Request Wasm or
+	// an Overlay Wasm given its hash. Clients can stream the entire bytecode
+	// by advancing the offset until it reaches the total size.
+	WasmBytecode(context.Context, *QueryWasmBytecodeRequest) (*QueryWasmBytecodeResponse, error)
+	// ActiveOverlay returns the active, scheduled, and previously active
+	// versions of a given Overlay Wasm type.
+	ActiveOverlay(context.Context, *QueryActiveOverlayRequest) (*QueryActiveOverlayResponse, error)
+	// ProxyContractRegistry returns the address of the data request Proxy
+	// Contract.
 	ProxyContractRegistry(context.Context, *QueryProxyContractRegistryRequest) (*QueryProxyContractRegistryResponse, error)
+	// ProxyContracts returns all entries of the Proxy Contract registry.
+	ProxyContracts(context.Context, *QueryProxyContractsRequest) (*QueryProxyContractsResponse, error)
+	// ProxyContractHistory returns the changes made to a given entry of the
+	// Proxy Contract registry, starting from the most recent one.
+	ProxyContractHistory(context.Context, *QueryProxyContractHistoryRequest) (*QueryProxyContractHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -618,9 +1126,21 @@ func (*UnimplementedQueryServer) OverlayWasm(ctx context.Context, req *QueryOver
 func (*UnimplementedQueryServer) OverlayWasms(ctx context.Context, req *QueryOverlayWasmsRequest) (*QueryOverlayWasmsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OverlayWasms not implemented")
 }
+func (*UnimplementedQueryServer) WasmBytecode(ctx context.Context, req *QueryWasmBytecodeRequest) (*QueryWasmBytecodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WasmBytecode not implemented")
+}
+func (*UnimplementedQueryServer) ActiveOverlay(ctx context.Context, req *QueryActiveOverlayRequest) (*QueryActiveOverlayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActiveOverlay not implemented")
+}
 func (*UnimplementedQueryServer) ProxyContractRegistry(ctx context.Context, req *QueryProxyContractRegistryRequest) (*QueryProxyContractRegistryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProxyContractRegistry not implemented")
 }
+func (*UnimplementedQueryServer) ProxyContracts(ctx context.Context, req *QueryProxyContractsRequest) (*QueryProxyContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProxyContracts not implemented")
+}
+func (*UnimplementedQueryServer) ProxyContractHistory(ctx context.Context, req *QueryProxyContractHistoryRequest) (*QueryProxyContractHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProxyContractHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -698,6 +1218,42 @@ func _Query_OverlayWasms_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_WasmBytecode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWasmBytecodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WasmBytecode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.wasm_storage.v1.Query/WasmBytecode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WasmBytecode(ctx, req.(*QueryWasmBytecodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ActiveOverlay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActiveOverlayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ActiveOverlay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.wasm_storage.v1.Query/ActiveOverlay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActiveOverlay(ctx, req.(*QueryActiveOverlayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ProxyContractRegistry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryProxyContractRegistryRequest)
 	if err := dec(in); err != nil {
@@ -716,6 +1272,42 @@ func _Query_ProxyContractRegistry_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProxyContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProxyContractsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProxyContracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.wasm_storage.v1.Query/ProxyContracts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProxyContracts(ctx, req.(*QueryProxyContractsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProxyContractHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProxyContractHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProxyContractHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.wasm_storage.v1.Query/ProxyContractHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProxyContractHistory(ctx, req.(*QueryProxyContractHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "sedachain.wasm_storage.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -736,10 +1328,26 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "OverlayWasms",
 			Handler:    _Query_OverlayWasms_Handler,
 		},
+		{
+			MethodName: "WasmBytecode",
+			Handler:    _Query_WasmBytecode_Handler,
+		},
+		{
+			MethodName: "ActiveOverlay",
+			Handler:    _Query_ActiveOverlay_Handler,
+		},
 		{
 			MethodName: "ProxyContractRegistry",
 			Handler:    _Query_ProxyContractRegistry_Handler,
 		},
+		{
+			MethodName: "ProxyContracts",
+			Handler:    _Query_ProxyContracts_Handler,
+		},
+		{
+			MethodName: "ProxyContractHistory",
+			Handler:    _Query_ProxyContractHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sedachain/wasm_storage/v1/query.proto",
@@ -985,7 +1593,7 @@ func (m *QueryOverlayWasmsResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryProxyContractRegistryRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryWasmBytecodeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -995,20 +1603,37 @@ func (m *QueryProxyContractRegistryRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryProxyContractRegistryRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryWasmBytecodeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryProxyContractRegistryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryWasmBytecodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Offset != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryProxyContractRegistryResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryWasmBytecodeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1018,166 +1643,1178 @@ func (m *QueryProxyContractRegistryResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryProxyContractRegistryResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryWasmBytecodeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryProxyContractRegistryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryWasmBytecodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+	if m.WasmType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WasmType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TotalSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Offset != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Chunk) > 0 {
+		i -= len(m.Chunk)
+		copy(dAtA[i:], m.Chunk)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Chunk)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryActiveOverlayRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryDataRequestWasmRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryActiveOverlayRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDataRequestWasmResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryActiveOverlayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Wasm != nil {
-		l = m.Wasm.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.WasmType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WasmType))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDataRequestWasmsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryActiveOverlayResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDataRequestWasmsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryActiveOverlayResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveOverlayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.HashTypePairs) > 0 {
-		for _, s := range m.HashTypePairs {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	return n
-}
-
-func (m *QueryOverlayWasmRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Scheduled != nil {
+		{
+			size, err := m.Scheduled.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Active != nil {
+		{
+			size, err := m.Active.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryOverlayWasmResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Wasm != nil {
-		l = m.Wasm.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryProxyContractRegistryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryOverlayWasmsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryProxyContractRegistryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryOverlayWasmsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryProxyContractRegistryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.HashTypePairs) > 0 {
-		for _, s := range m.HashTypePairs {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryProxyContractRegistryRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+func (m *QueryProxyContractRegistryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProxyContractRegistryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProxyContractRegistryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProxyContractsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProxyContractsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProxyContractsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProxyContractsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProxyContractsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProxyContractsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProxyContracts) > 0 {
+		for iNdEx := len(m.ProxyContracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProxyContracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProxyContractHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProxyContractHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProxyContractHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProxyContractHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProxyContractHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProxyContractHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryDataRequestWasmRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDataRequestWasmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
+	if m.Wasm != nil {
+		l = m.Wasm.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryProxyContractRegistryResponse) Size() (n int) {
+func (m *QueryDataRequestWasmsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDataRequestWasmsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HashTypePairs) > 0 {
+		for _, s := range m.HashTypePairs {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryOverlayWasmRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOverlayWasmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Wasm != nil {
+		l = m.Wasm.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOverlayWasmsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryOverlayWasmsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HashTypePairs) > 0 {
+		for _, s := range m.HashTypePairs {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryWasmBytecodeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovQuery(uint64(m.Offset))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *QueryWasmBytecodeResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	var l int
+	_ = l
+	l = len(m.Chunk)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovQuery(uint64(m.Offset))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovQuery(uint64(m.TotalSize))
+	}
+	if m.WasmType != 0 {
+		n += 1 + sovQuery(uint64(m.WasmType))
+	}
+	return n
+}
+
+func (m *QueryActiveOverlayRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WasmType != 0 {
+		n += 1 + sovQuery(uint64(m.WasmType))
+	}
+	return n
+}
+
+func (m *QueryActiveOverlayResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Active != nil {
+		l = m.Active.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Scheduled != nil {
+		l = m.Scheduled.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryProxyContractRegistryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryProxyContractRegistryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProxyContractsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryProxyContractsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProxyContracts) > 0 {
+		for _, e := range m.ProxyContracts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryProxyContractHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProxyContractHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryDataRequestWasmRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataRequestWasmRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataRequestWasmRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDataRequestWasmResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataRequestWasmResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataRequestWasmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wasm", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Wasm == nil {
+				m.Wasm = &Wasm{}
+			}
+			if err := m.Wasm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDataRequestWasmsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataRequestWasmsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataRequestWasmsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDataRequestWasmsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataRequestWasmsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataRequestWasmsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashTypePairs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HashTypePairs = append(m.HashTypePairs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOverlayWasmRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOverlayWasmRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOverlayWasmRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOverlayWasmResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOverlayWasmResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOverlayWasmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wasm", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Wasm == nil {
+				m.Wasm = &Wasm{}
+			}
+			if err := m.Wasm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *QueryOverlayWasmsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOverlayWasmsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOverlayWasmsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryOverlayWasmsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOverlayWasmsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOverlayWasmsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashTypePairs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HashTypePairs = append(m.HashTypePairs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryDataRequestWasmRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWasmBytecodeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1200,10 +2837,10 @@ func (m *QueryDataRequestWasmRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDataRequestWasmRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWasmBytecodeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDataRequestWasmRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWasmBytecodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1238,6 +2875,44 @@ func (m *QueryDataRequestWasmRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1259,7 +2934,7 @@ func (m *QueryDataRequestWasmRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDataRequestWasmResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWasmBytecodeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1282,17 +2957,89 @@ func (m *QueryDataRequestWasmResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDataRequestWasmResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWasmBytecodeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDataRequestWasmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWasmBytecodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wasm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chunk = append(m.Chunk[:0], dAtA[iNdEx:postIndex]...)
+			if m.Chunk == nil {
+				m.Chunk = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			}
+			m.TotalSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var msglen int
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WasmType", wireType)
+			}
+			m.WasmType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1302,28 +3049,11 @@ func (m *QueryDataRequestWasmResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.WasmType |= WasmType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Wasm == nil {
-				m.Wasm = &Wasm{}
-			}
-			if err := m.Wasm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1345,7 +3075,7 @@ func (m *QueryDataRequestWasmResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDataRequestWasmsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryActiveOverlayRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1368,12 +3098,31 @@ func (m *QueryDataRequestWasmsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDataRequestWasmsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryActiveOverlayRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDataRequestWasmsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryActiveOverlayRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WasmType", wireType)
+			}
+			m.WasmType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WasmType |= WasmType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1395,7 +3144,7 @@ func (m *QueryDataRequestWasmsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDataRequestWasmsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryActiveOverlayResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1418,17 +3167,17 @@ func (m *QueryDataRequestWasmsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDataRequestWasmsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryActiveOverlayResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDataRequestWasmsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryActiveOverlayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HashTypePairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1438,23 +3187,97 @@ func (m *QueryDataRequestWasmsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HashTypePairs = append(m.HashTypePairs, string(dAtA[iNdEx:postIndex]))
+			if m.Active == nil {
+				m.Active = &OverlayVersion{}
+			}
+			if err := m.Active.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheduled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Scheduled == nil {
+				m.Scheduled = &OverlayVersion{}
+			}
+			if err := m.Scheduled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, OverlayVersion{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1477,7 +3300,7 @@ func (m *QueryDataRequestWasmsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOverlayWasmRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProxyContractRegistryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1500,44 +3323,12 @@ func (m *QueryOverlayWasmRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOverlayWasmRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProxyContractRegistryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOverlayWasmRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProxyContractRegistryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1559,7 +3350,7 @@ func (m *QueryOverlayWasmRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOverlayWasmResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProxyContractRegistryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1582,17 +3373,17 @@ func (m *QueryOverlayWasmResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOverlayWasmResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProxyContractRegistryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOverlayWasmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProxyContractRegistryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wasm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1602,27 +3393,23 @@ func (m *QueryOverlayWasmResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Wasm == nil {
-				m.Wasm = &Wasm{}
-			}
-			if err := m.Wasm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1645,7 +3432,7 @@ func (m *QueryOverlayWasmResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOverlayWasmsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProxyContractsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1668,10 +3455,10 @@ func (m *QueryOverlayWasmsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOverlayWasmsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProxyContractsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOverlayWasmsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProxyContractsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1695,7 +3482,7 @@ func (m *QueryOverlayWasmsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOverlayWasmsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProxyContractsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1718,17 +3505,17 @@ func (m *QueryOverlayWasmsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOverlayWasmsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProxyContractsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOverlayWasmsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProxyContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HashTypePairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyContracts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1738,23 +3525,25 @@ func (m *QueryOverlayWasmsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HashTypePairs = append(m.HashTypePairs, string(dAtA[iNdEx:postIndex]))
+			m.ProxyContracts = append(m.ProxyContracts, ProxyContract{})
+			if err := m.ProxyContracts[len(m.ProxyContracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1777,7 +3566,7 @@ func (m *QueryOverlayWasmsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProxyContractRegistryRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProxyContractHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1800,12 +3589,44 @@ func (m *QueryProxyContractRegistryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProxyContractRegistryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProxyContractHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProxyContractRegistryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProxyContractHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1827,7 +3648,7 @@ func (m *QueryProxyContractRegistryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProxyContractRegistryResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProxyContractHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1850,17 +3671,17 @@ func (m *QueryProxyContractRegistryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProxyContractRegistryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProxyContractHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProxyContractRegistryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProxyContractHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1870,23 +3691,25 @@ func (m *QueryProxyContractRegistryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.History = append(m.History, ProxyContractChange{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -49,6 +49,69 @@ func (msg MsgStoreOverlayWasm) ValidateBasic() error {
 	return nil
 }
 
+func (msg MsgBeginWasmUpload) Route() string {
+	return RouterKey
+}
+
+func (msg MsgBeginWasmUpload) Type() string {
+	return "begin-wasm-upload"
+}
+
+func (msg MsgBeginWasmUpload) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return err
+	}
+	if err := validateWasmHash(msg.Hash); err != nil {
+		return err
+	}
+	if msg.UploadSize == 0 {
+		return fmt.Errorf("upload size cannot be zero")
+	}
+	if !msg.WasmType.IsDataRequest() && !msg.WasmType.IsOverlay() {
+		return fmt.Errorf("invalid Wasm type %s", msg.WasmType)
+	}
+	return nil
+}
+
+func (msg MsgUploadWasmChunk) Route() string {
+	return RouterKey
+}
+
+func (msg MsgUploadWasmChunk) Type() string {
+	return "upload-wasm-chunk"
+}
+
+func (msg MsgUploadWasmChunk) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return err
+	}
+	if err := validateWasmHash(msg.Hash); err != nil {
+		return err
+	}
+	if len(msg.Chunk) == 0 {
+		return fmt.Errorf("empty chunk")
+	}
+	if len(msg.Chunk) > MaxWasmUploadChunkSize {
+		return fmt.Errorf("chunk cannot be larger than %d bytes", MaxWasmUploadChunkSize)
+	}
+	return nil
+}
+
+func (msg MsgFinalizeWasmUpload) Route() string {
+	return RouterKey
+}
+
+func (msg MsgFinalizeWasmUpload) Type() string {
+	return "finalize-wasm-upload"
+}
+
+func (msg MsgFinalizeWasmUpload) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return err
+	}
+	return validateWasmHash(msg.Hash)
+}
+
 func (msg MsgInstantiateAndRegisterProxyContract) Route() string {
 	return RouterKey
 }
@@ -85,5 +148,142 @@ func (msg MsgInstantiateAndRegisterProxyContract) ValidateBasic() error {
 	if err := wasmtypes.ValidateSalt(msg.Salt); err != nil {
 		return fmt.Errorf("invalid salt: %s", err)
 	}
+	if msg.Name != "" {
+		if err := ValidateProxyContractName(msg.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (msg MsgRegisterProxyContract) Route() string {
+	return RouterKey
+}
+
+func (msg MsgRegisterProxyContract) Type() string {
+	return "register-proxy-contract"
+}
+
+func (msg MsgRegisterProxyContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return fmt.Errorf("invalid authority: %s", err)
+	}
+	if err := ValidateProxyContractName(msg.Name); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return fmt.Errorf("invalid proxy contract address: %s", err)
+	}
+	return nil
+}
+
+func (msg MsgUpdateProxyContract) Route() string {
+	return RouterKey
+}
+
+func (msg MsgUpdateProxyContract) Type() string {
+	return "update-proxy-contract"
+}
+
+func (msg MsgUpdateProxyContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return fmt.Errorf("invalid authority: %s", err)
+	}
+	if err := ValidateProxyContractName(msg.Name); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return fmt.Errorf("invalid proxy contract address: %s", err)
+	}
+	return nil
+}
+
+func (msg MsgRemoveProxyContract) Route() string {
+	return RouterKey
+}
+
+func (msg MsgRemoveProxyContract) Type() string {
+	return "remove-proxy-contract"
+}
+
+func (msg MsgRemoveProxyContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return fmt.Errorf("invalid authority: %s", err)
+	}
+	return ValidateProxyContractName(msg.Name)
+}
+
+func (msg MsgRemoveDataRequestWasm) Route() string {
+	return RouterKey
+}
+
+func (msg MsgRemoveDataRequestWasm) Type() string {
+	return "remove-data-request-wasm"
+}
+
+func (msg MsgRemoveDataRequestWasm) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return fmt.Errorf("invalid authority: %s", err)
+	}
+	if err := validateWasmHash(msg.Hash); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (msg MsgRemoveOverlayWasm) Route() string {
+	return RouterKey
+}
+
+func (msg MsgRemoveOverlayWasm) Type() string {
+	return "remove-overlay-wasm"
+}
+
+func (msg MsgRemoveOverlayWasm) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return fmt.Errorf("invalid authority: %s", err)
+	}
+	if err := validateWasmHash(msg.Hash); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (msg MsgActivateOverlayWasm) Route() string {
+	return RouterKey
+}
+
+func (msg MsgActivateOverlayWasm) Type() string {
+	return "activate-overlay-wasm"
+}
+
+func (msg MsgActivateOverlayWasm) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return fmt.Errorf("invalid authority: %s", err)
+	}
+	if err := validateWasmHash(msg.Hash); err != nil {
+		return err
+	}
+	if msg.ActivationHeight < 0 {
+		return fmt.Errorf("activation height cannot be negative")
+	}
+	return nil
+}
+
+func (msg MsgRollbackOverlayWasm) Route() string {
+	return RouterKey
+}
+
+func (msg MsgRollbackOverlayWasm) Type() string {
+	return "rollback-overlay-wasm"
+}
+
+func (msg MsgRollbackOverlayWasm) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return fmt.Errorf("invalid authority: %s", err)
+	}
+	if msg.WasmType != WasmTypeDataRequestExecutor && msg.WasmType != WasmTypeRelayer {
+		return fmt.Errorf("overlay Wasm type must be data-request-executor or relayer")
+	}
 	return nil
 }
@@ -33,7 +33,8 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// The request message for the StoreDataRequestWasm method.
+// The request message for the StoreDataRequestWasm method. The wasm may
+// be uncompressed or compressed with gzip or zstd.
 type MsgStoreDataRequestWasm struct {
 	Sender   string   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	Wasm     []byte   `protobuf:"bytes,2,opt,name=wasm,proto3" json:"wasm,omitempty"`
@@ -97,6 +98,8 @@ func (m *MsgStoreDataRequestWasm) GetWasmType() WasmType {
 // The response message for the StoreDataRequestWasm method.
 type MsgStoreDataRequestWasmResponse struct {
 	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// format is the detected encoding of the uploaded wasm.
+	Format WasmFormat `protobuf:"varint,2,opt,name=format,proto3,enum=sedachain.wasm_storage.v1.WasmFormat" json:"format,omitempty"`
 }
 
 func (m *MsgStoreDataRequestWasmResponse) Reset()         { *m = MsgStoreDataRequestWasmResponse{} }
@@ -139,7 +142,15 @@ func (m *MsgStoreDataRequestWasmResponse) GetHash() string {
 	return ""
 }
 
-// The request message for the StoreOverlayWasm method.
+func (m *MsgStoreDataRequestWasmResponse) GetFormat() WasmFormat {
+	if m != nil {
+		return m.Format
+	}
+	return WasmFormatNil
+}
+
+// The request message for the StoreOverlayWasm method. The wasm may be
+// uncompressed or compressed with gzip or zstd.
 type MsgStoreOverlayWasm struct {
 	Sender   string   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	Wasm     []byte   `protobuf:"bytes,2,opt,name=wasm,proto3" json:"wasm,omitempty"`
@@ -203,6 +214,8 @@ func (m *MsgStoreOverlayWasm) GetWasmType() WasmType {
 // The response message for the StoreOverlayWasm method.
 type MsgStoreOverlayWasmResponse struct {
 	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// format is the detected encoding of the uploaded wasm.
+	Format WasmFormat `protobuf:"varint,2,opt,name=format,proto3,enum=sedachain.wasm_storage.v1.WasmFormat" json:"format,omitempty"`
 }
 
 func (m *MsgStoreOverlayWasmResponse) Reset()         { *m = MsgStoreOverlayWasmResponse{} }
@@ -245,6 +258,359 @@ func (m *MsgStoreOverlayWasmResponse) GetHash() string {
 	return ""
 }
 
+func (m *MsgStoreOverlayWasmResponse) GetFormat() WasmFormat {
+	if m != nil {
+		return m.Format
+	}
+	return WasmFormatNil
+}
+
+// The request message for the BeginWasmUpload method. Overlay wasm types
+// can only be uploaded by the module authority.
+type MsgBeginWasmUpload struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// hash is the hex-encoded keccak256 hash of the uncompressed wasm.
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// upload_size is the total size in bytes of the chunks to be uploaded. The
+	// concatenated chunks may be uncompressed or compressed with gzip or zstd.
+	UploadSize uint64   `protobuf:"varint,3,opt,name=upload_size,json=uploadSize,proto3" json:"upload_size,omitempty"`
+	WasmType   WasmType `protobuf:"varint,4,opt,name=wasm_type,json=wasmType,proto3,enum=sedachain.wasm_storage.v1.WasmType" json:"wasm_type,omitempty"`
+}
+
+func (m *MsgBeginWasmUpload) Reset()         { *m = MsgBeginWasmUpload{} }
+func (m *MsgBeginWasmUpload) String() string { return proto.CompactTextString(m) }
+func (*MsgBeginWasmUpload) ProtoMessage()    {}
+func (*MsgBeginWasmUpload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10a76e8135c0d000, []int{4}
+}
+func (m *MsgBeginWasmUpload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBeginWasmUpload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBeginWasmUpload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBeginWasmUpload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBeginWasmUpload.Merge(m, src)
+}
+func (m *MsgBeginWasmUpload) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBeginWasmUpload) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBeginWasmUpload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBeginWasmUpload proto.InternalMessageInfo
+
+func (m *MsgBeginWasmUpload) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgBeginWasmUpload) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *MsgBeginWasmUpload) GetUploadSize() uint64 {
+	if m != nil {
+		return m.UploadSize
+	}
+	return 0
+}
+
+func (m *MsgBeginWasmUpload) GetWasmType() WasmType {
+	if m != nil {
+		return m.WasmType
+	}
+	return WasmTypeNil
+}
+
+// The response message for the BeginWasmUpload method.
+type MsgBeginWasmUploadResponse struct {
+	// expiration_height is the block height at which the upload is discarded
+	// unless it has been finalized.
+	ExpirationHeight int64 `protobuf:"varint,1,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+}
+
+func (m *MsgBeginWasmUploadResponse) Reset()         { *m = MsgBeginWasmUploadResponse{} }
+func (m *MsgBeginWasmUploadResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBeginWasmUploadResponse) ProtoMessage()    {}
+func (*MsgBeginWasmUploadResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10a76e8135c0d000, []int{5}
+}
+func (m *MsgBeginWasmUploadResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBeginWasmUploadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBeginWasmUploadResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBeginWasmUploadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBeginWasmUploadResponse.Merge(m, src)
+}
+func (m *MsgBeginWasmUploadResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBeginWasmUploadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBeginWasmUploadResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBeginWasmUploadResponse proto.InternalMessageInfo
+
+func (m *MsgBeginWasmUploadResponse) GetExpirationHeight() int64 {
+	if m != nil {
+		return m.ExpirationHeight
+	}
+	return 0
+}
+
+// The request message for the UploadWasmChunk method.
+type MsgUploadWasmChunk struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// hash is the hex-encoded hash given when the upload was begun.
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// index is the position of the chunk, starting from zero. Chunks must be
+	// uploaded in order.
+	Index uint64 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Chunk []byte `protobuf:"bytes,4,opt,name=chunk,proto3" json:"chunk,omitempty"`
+}
+
+func (m *MsgUploadWasmChunk) Reset()         { *m = MsgUploadWasmChunk{} }
+func (m *MsgUploadWasmChunk) String() string { return proto.CompactTextString(m) }
+func (*MsgUploadWasmChunk) ProtoMessage()    {}
+func (*MsgUploadWasmChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10a76e8135c0d000, []int{6}
+}
+func (m *MsgUploadWasmChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUploadWasmChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUploadWasmChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUploadWasmChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUploadWasmChunk.Merge(m, src)
+}
+func (m *MsgUploadWasmChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUploadWasmChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUploadWasmChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUploadWasmChunk proto.InternalMessageInfo
+
+func (m *MsgUploadWasmChunk) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgUploadWasmChunk) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *MsgUploadWasmChunk) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *MsgUploadWasmChunk) GetChunk() []byte {
+	if m != nil {
+		return m.Chunk
+	}
+	return nil
+}
+
+// The response message for the UploadWasmChunk method.
+type MsgUploadWasmChunkResponse struct {
+	// received is the total size of the chunks received so far in bytes.
+	Received uint64 `protobuf:"varint,1,opt,name=received,proto3" json:"received,omitempty"`
+}
+
+func (m *MsgUploadWasmChunkResponse) Reset()         { *m = MsgUploadWasmChunkResponse{} }
+func (m *MsgUploadWasmChunkResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUploadWasmChunkResponse) ProtoMessage()    {}
+func (*MsgUploadWasmChunkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10a76e8135c0d000, []int{7}
+}
+func (m *MsgUploadWasmChunkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUploadWasmChunkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUploadWasmChunkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUploadWasmChunkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUploadWasmChunkResponse.Merge(m, src)
+}
+func (m *MsgUploadWasmChunkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUploadWasmChunkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUploadWasmChunkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUploadWasmChunkResponse proto.InternalMessageInfo
+
+func (m *MsgUploadWasmChunkResponse) GetReceived() uint64 {
+	if m != nil {
+		return m.Received
+	}
+	return 0
+}
+
+// The request message for the FinalizeWasmUpload method.
+type MsgFinalizeWasmUpload struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// hash is the hex-encoded hash given when the upload was begun.
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *MsgFinalizeWasmUpload) Reset()         { *m = MsgFinalizeWasmUpload{} }
+func (m *MsgFinalizeWasmUpload) String() string { return proto.CompactTextString(m) }
+func (*MsgFinalizeWasmUpload) ProtoMessage()    {}
+func (*MsgFinalizeWasmUpload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10a76e8135c0d000, []int{8}
+}
+func (m *MsgFinalizeWasmUpload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFinalizeWasmUpload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFinalizeWasmUpload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFinalizeWasmUpload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFinalizeWasmUpload.Merge(m, src)
+}
+func (m *MsgFinalizeWasmUpload) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFinalizeWasmUpload) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFinalizeWasmUpload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFinalizeWasmUpload proto.InternalMessageInfo
+
+func (m *MsgFinalizeWasmUpload) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgFinalizeWasmUpload) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+// The response message for the FinalizeWasmUpload method.
+type MsgFinalizeWasmUploadResponse struct {
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// format is the detected encoding of the uploaded wasm.
+	Format WasmFormat `protobuf:"varint,2,opt,name=format,proto3,enum=sedachain.wasm_storage.v1.WasmFormat" json:"format,omitempty"`
+}
+
+func (m *MsgFinalizeWasmUploadResponse) Reset()         { *m = MsgFinalizeWasmUploadResponse{} }
+func (m *MsgFinalizeWasmUploadResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFinalizeWasmUploadResponse) ProtoMessage()    {}
+func (*MsgFinalizeWasmUploadResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10a76e8135c0d000, []int{9}
+}
+func (m *MsgFinalizeWasmUploadResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFinalizeWasmUploadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFinalizeWasmUploadResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFinalizeWasmUploadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFinalizeWasmUploadResponse.Merge(m, src)
+}
+func (m *MsgFinalizeWasmUploadResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFinalizeWasmUploadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFinalizeWasmUploadResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFinalizeWasmUploadResponse proto.InternalMessageInfo
+
+func (m *MsgFinalizeWasmUploadResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *MsgFinalizeWasmUploadResponse) GetFormat() WasmFormat {
+	if m != nil {
+		return m.Format
+	}
+	return WasmFormatNil
+}
+
 // The request message for the InstantiateAndRegisterProxyContract method.
 type MsgInstantiateAndRegisterProxyContract struct {
 	Sender string                                                    `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
@@ -255,6 +621,9 @@ type MsgInstantiateAndRegisterProxyContract struct {
 	Funds  github_com_cosmos_cosmos_sdk_types.Coins                  `protobuf:"bytes,6,rep,name=funds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"funds"`
 	Salt   []byte                                                    `protobuf:"bytes,7,opt,name=salt,proto3" json:"salt,omitempty"`
 	FixMsg bool                                                      `protobuf:"varint,8,opt,name=fix_msg,json=fixMsg,proto3" json:"fix_msg,omitempty"`
+	// name is the proxy contract registry entry under which the instantiated
+	// contract is registered. Defaults to the data request proxy contract.
+	Name string `protobuf:"bytes,9,opt,name=name,proto3" json:"name,omitempty"`
 }
 
 func (m *MsgInstantiateAndRegisterProxyContract) Reset() {
@@ -263,7 +632,7 @@ func (m *MsgInstantiateAndRegisterProxyContract) Reset() {
 func (m *MsgInstantiateAndRegisterProxyContract) String() string { return proto.CompactTextString(m) }
 func (*MsgInstantiateAndRegisterProxyContract) ProtoMessage()    {}
 func (*MsgInstantiateAndRegisterProxyContract) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10a76e8135c0d000, []int{4}
+	return fileDescriptor_10a76e8135c0d000, []int{10}
 }
 func (m *MsgInstantiateAndRegisterProxyContract) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -348,6 +717,13 @@ func (m *MsgInstantiateAndRegisterProxyContract) GetFixMsg() bool {
 	return false
 }
 
+func (m *MsgInstantiateAndRegisterProxyContract) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
 // The response message for the InstantiateAndRegisterProxyContract method.
 type MsgInstantiateAndRegisterProxyContractResponse struct {
 	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
@@ -361,7 +737,7 @@ func (m *MsgInstantiateAndRegisterProxyContractResponse) String() string {
 }
 func (*MsgInstantiateAndRegisterProxyContractResponse) ProtoMessage() {}
 func (*MsgInstantiateAndRegisterProxyContractResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10a76e8135c0d000, []int{5}
+	return fileDescriptor_10a76e8135c0d000, []int{11}
 }
 func (m *MsgInstantiateAndRegisterProxyContractResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -397,26 +773,29 @@ func (m *MsgInstantiateAndRegisterProxyContractResponse) GetContractAddress() st
 	return ""
 }
 
-// The request message for the UpdateParams method.
-type MsgUpdateParams struct {
+// The request message for the RegisterProxyContract method.
+type MsgRegisterProxyContract struct {
 	// authority is the address that controls the module (defaults to x/gov unless
 	// overwritten).
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	Params    Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// name is the name of the new registry entry.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// address is the address of the proxy contract.
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
-func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParams) ProtoMessage()    {}
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10a76e8135c0d000, []int{6}
+func (m *MsgRegisterProxyContract) Reset()         { *m = MsgRegisterProxyContract{} }
+func (m *MsgRegisterProxyContract) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterProxyContract) ProtoMessage()    {}
+func (*MsgRegisterProxyContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10a76e8135c0d000, []int{12}
 }
-func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+func (m *MsgRegisterProxyContract) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRegisterProxyContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRegisterProxyContract.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -426,48 +805,55 @@ func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+func (m *MsgRegisterProxyContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterProxyContract.Merge(m, src)
 }
-func (m *MsgUpdateParams) XXX_Size() int {
+func (m *MsgRegisterProxyContract) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+func (m *MsgRegisterProxyContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterProxyContract.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+var xxx_messageInfo_MsgRegisterProxyContract proto.InternalMessageInfo
 
-func (m *MsgUpdateParams) GetAuthority() string {
+func (m *MsgRegisterProxyContract) GetAuthority() string {
 	if m != nil {
 		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgUpdateParams) GetParams() Params {
+func (m *MsgRegisterProxyContract) GetName() string {
 	if m != nil {
-		return m.Params
+		return m.Name
 	}
-	return Params{}
+	return ""
 }
 
-// no data needs to be returned
-type MsgUpdateParamsResponse struct {
+func (m *MsgRegisterProxyContract) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
-func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParamsResponse) ProtoMessage()    {}
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10a76e8135c0d000, []int{7}
+// The response message for the RegisterProxyContract method.
+type MsgRegisterProxyContractResponse struct {
 }
-func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgRegisterProxyContractResponse) Reset()         { *m = MsgRegisterProxyContractResponse{} }
+func (m *MsgRegisterProxyContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterProxyContractResponse) ProtoMessage()    {}
+func (*MsgRegisterProxyContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10a76e8135c0d000, []int{13}
+}
+func (m *MsgRegisterProxyContractResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRegisterProxyContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRegisterProxyContractResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -477,774 +863,5015 @@ func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+func (m *MsgRegisterProxyContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterProxyContractResponse.Merge(m, src)
 }
-func (m *MsgUpdateParamsResponse) XXX_Size() int {
+func (m *MsgRegisterProxyContractResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+func (m *MsgRegisterProxyContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterProxyContractResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgRegisterProxyContractResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgStoreDataRequestWasm)(nil), "sedachain.wasm_storage.v1.MsgStoreDataRequestWasm")
-	proto.RegisterType((*MsgStoreDataRequestWasmResponse)(nil), "sedachain.wasm_storage.v1.MsgStoreDataRequestWasmResponse")
-	proto.RegisterType((*MsgStoreOverlayWasm)(nil), "sedachain.wasm_storage.v1.MsgStoreOverlayWasm")
-	proto.RegisterType((*MsgStoreOverlayWasmResponse)(nil), "sedachain.wasm_storage.v1.MsgStoreOverlayWasmResponse")
-	proto.RegisterType((*MsgInstantiateAndRegisterProxyContract)(nil), "sedachain.wasm_storage.v1.MsgInstantiateAndRegisterProxyContract")
-	proto.RegisterType((*MsgInstantiateAndRegisterProxyContractResponse)(nil), "sedachain.wasm_storage.v1.MsgInstantiateAndRegisterProxyContractResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "sedachain.wasm_storage.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "sedachain.wasm_storage.v1.MsgUpdateParamsResponse")
+// The request message for the UpdateProxyContract method.
+type MsgUpdateProxyContract struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// name is the name of the registry entry to be updated.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// address is the new address of the proxy contract.
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("sedachain/wasm_storage/v1/tx.proto", fileDescriptor_10a76e8135c0d000)
+func (m *MsgUpdateProxyContract) Reset()         { *m = MsgUpdateProxyContract{} }
+func (m *MsgUpdateProxyContract) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateProxyContract) ProtoMessage()    {}
+func (*MsgUpdateProxyContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10a76e8135c0d000, []int{14}
 }
-
-var fileDescriptor_10a76e8135c0d000 = []byte{
-	// 783 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x4f, 0x4f, 0xdb, 0x48,
-	0x14, 0x8f, 0x37, 0x7f, 0x80, 0x01, 0x01, 0xf2, 0x46, 0xc2, 0xc9, 0x4a, 0x49, 0x36, 0x48, 0xab,
-	0x08, 0x6d, 0x6c, 0x92, 0xd5, 0xb2, 0x5a, 0xa4, 0xd5, 0x96, 0x84, 0x4b, 0x0e, 0x11, 0xd4, 0xb4,
-	0xaa, 0xd4, 0x4b, 0x34, 0xb1, 0x07, 0xc7, 0x6a, 0xec, 0x49, 0xfd, 0x26, 0x90, 0xf4, 0xd8, 0x43,
-	0xcf, 0x3d, 0xf7, 0x23, 0x70, 0xa8, 0x90, 0xe8, 0x87, 0xe0, 0x88, 0x7a, 0xea, 0x89, 0x56, 0xe1,
-	0xd0, 0xef, 0xd0, 0x53, 0x35, 0xe3, 0x49, 0x08, 0x2a, 0x09, 0xa1, 0xbd, 0xf4, 0xe4, 0xe7, 0x99,
-	0xdf, 0xfb, 0xfd, 0xde, 0x9f, 0x79, 0x33, 0x28, 0x0f, 0xc4, 0xc6, 0x56, 0x0b, 0xbb, 0xbe, 0x71,
-	0x8c, 0xc1, 0x6b, 0x00, 0xa3, 0x01, 0x76, 0x88, 0x71, 0x54, 0x32, 0x58, 0x4f, 0xef, 0x04, 0x94,
-	0x51, 0x35, 0x35, 0xc2, 0xe8, 0xe3, 0x18, 0xfd, 0xa8, 0x94, 0xce, 0x58, 0x14, 0x3c, 0x0a, 0x46,
-	0x13, 0x03, 0xf7, 0x69, 0x12, 0x86, 0x4b, 0x86, 0x45, 0x5d, 0x3f, 0x74, 0x4d, 0xaf, 0xc9, 0x7d,
-	0x0f, 0x1c, 0x4e, 0xe9, 0x81, 0x23, 0x37, 0x92, 0x0e, 0x75, 0xa8, 0x30, 0x0d, 0x6e, 0xc9, 0xd5,
-	0x54, 0x08, 0x6f, 0x84, 0x1b, 0xe1, 0x8f, 0xdc, 0xfa, 0x73, 0x72, 0xa0, 0x37, 0x82, 0x12, 0xe8,
-	0xfc, 0x5b, 0x05, 0xad, 0xd5, 0xc1, 0x39, 0x60, 0x34, 0x20, 0xbb, 0x98, 0x61, 0x93, 0x3c, 0xef,
-	0x12, 0x60, 0x4f, 0x30, 0x78, 0xea, 0x26, 0x4a, 0x00, 0xf1, 0x6d, 0x12, 0x68, 0x4a, 0x4e, 0x29,
-	0x2c, 0x54, 0xb4, 0xf7, 0xef, 0x8a, 0x49, 0xa9, 0xb5, 0x63, 0xdb, 0x01, 0x01, 0x38, 0x60, 0x81,
-	0xeb, 0x3b, 0xa6, 0xc4, 0xa9, 0x2a, 0x8a, 0x71, 0x0d, 0xed, 0x97, 0x9c, 0x52, 0x58, 0x32, 0x85,
-	0xad, 0x3e, 0x40, 0x0b, 0x42, 0x97, 0xf5, 0x3b, 0x44, 0x8b, 0xe6, 0x94, 0xc2, 0x72, 0x79, 0x5d,
-	0x9f, 0x58, 0x28, 0x9d, 0x2b, 0x3f, 0xea, 0x77, 0x88, 0x39, 0x7f, 0x2c, 0xad, 0xed, 0xc5, 0x97,
-	0x9f, 0x4f, 0x37, 0xa4, 0x44, 0xfe, 0x6f, 0x94, 0x9d, 0x10, 0xaf, 0x49, 0xa0, 0x43, 0x7d, 0x20,
-	0x3c, 0x8a, 0x16, 0x86, 0x56, 0x18, 0xb5, 0x29, 0xec, 0xfc, 0x89, 0x82, 0x7e, 0x1d, 0xfa, 0xed,
-	0x1d, 0x91, 0xa0, 0x8d, 0xfb, 0x3f, 0x6f, 0x8e, 0x25, 0xf4, 0xdb, 0x2d, 0xb1, 0x4e, 0xcd, 0xef,
-	0x2c, 0x8a, 0xfe, 0xa8, 0x83, 0x53, 0xf3, 0x81, 0x61, 0x9f, 0xb9, 0x98, 0x91, 0x1d, 0xdf, 0x36,
-	0x89, 0xe3, 0x02, 0x23, 0xc1, 0x7e, 0x40, 0x7b, 0xfd, 0x2a, 0xf5, 0x59, 0x80, 0x2d, 0xf6, 0x1d,
-	0x29, 0xeb, 0x28, 0x8e, 0x6d, 0xcf, 0xf5, 0x45, 0xce, 0xd3, 0x1c, 0x42, 0x98, 0xba, 0x8e, 0xe6,
-	0x2c, 0x6a, 0x93, 0x86, 0x6b, 0x8b, 0x62, 0xc4, 0x2a, 0x68, 0x70, 0x99, 0x4d, 0x54, 0xa9, 0x4d,
-	0x6a, 0xbb, 0x66, 0x82, 0x6f, 0xd5, 0x6c, 0x35, 0x89, 0xe2, 0x6d, 0xdc, 0x24, 0x6d, 0x2d, 0x26,
-	0xd2, 0x08, 0x7f, 0xd4, 0x3d, 0x14, 0xf5, 0xc0, 0xd1, 0xe2, 0xbc, 0xb8, 0x95, 0xff, 0xbe, 0x5c,
-	0x66, 0xff, 0x75, 0x5c, 0xd6, 0xea, 0x36, 0x75, 0x8b, 0x7a, 0x46, 0x95, 0x82, 0xc7, 0x2b, 0x21,
-	0x0e, 0xb2, 0x6d, 0xf4, 0xc4, 0xd7, 0xe0, 0x45, 0x07, 0xdd, 0xc4, 0xc7, 0xc3, 0x0c, 0xeb, 0x04,
-	0x00, 0x3b, 0xc4, 0xe4, 0x4c, 0x2a, 0x46, 0xf1, 0xc3, 0xae, 0x6f, 0x83, 0x96, 0xc8, 0x45, 0x0b,
-	0x8b, 0xe5, 0x94, 0x2e, 0x03, 0xe7, 0x83, 0xa8, 0xcb, 0x41, 0xd4, 0xab, 0xd4, 0xf5, 0x2b, 0x9b,
-	0xe7, 0x97, 0xd9, 0xc8, 0xc9, 0xc7, 0x6c, 0x61, 0x4c, 0x51, 0x4e, 0x65, 0xf8, 0x29, 0x82, 0xfd,
-	0x4c, 0xaa, 0x71, 0x07, 0x30, 0x43, 0x66, 0xde, 0x0f, 0xc0, 0x6d, 0xa6, 0xcd, 0x85, 0x27, 0x82,
-	0xdb, 0xea, 0x1a, 0x9a, 0x3b, 0x74, 0x7b, 0x0d, 0x9e, 0xcb, 0x7c, 0x4e, 0x29, 0xcc, 0x9b, 0x89,
-	0x43, 0xb7, 0x57, 0x07, 0xe7, 0x66, 0xa3, 0xbb, 0x48, 0x9f, 0xad, 0x69, 0xa3, 0xde, 0x57, 0xd1,
-	0xaa, 0x25, 0xd7, 0x1a, 0x38, 0xac, 0xfd, 0x9d, 0x6d, 0x5c, 0x19, 0x7a, 0xc8, 0xe5, 0xfc, 0x1b,
-	0x05, 0xad, 0xd4, 0xc1, 0x79, 0xdc, 0xb1, 0x31, 0x23, 0xfb, 0x38, 0xc0, 0x1e, 0xa8, 0x5b, 0x68,
-	0x01, 0x77, 0x59, 0x8b, 0x06, 0x2e, 0xeb, 0xdf, 0xc9, 0x78, 0x0d, 0x55, 0xff, 0x47, 0x89, 0x8e,
-	0x60, 0x10, 0x87, 0x63, 0xb1, 0xfc, 0xfb, 0x94, 0x73, 0x1f, 0x4a, 0x55, 0x62, 0xbc, 0xd0, 0xa6,
-	0x74, 0xdb, 0x5e, 0xe6, 0x05, 0xb9, 0x26, 0xcc, 0xa7, 0xc4, 0x85, 0x34, 0x1e, 0xdb, 0x30, 0xf9,
-	0xf2, 0x69, 0x0c, 0x45, 0xeb, 0xe0, 0xa8, 0xaf, 0x14, 0x94, 0xbc, 0xf5, 0xc6, 0x2a, 0x4f, 0x11,
-	0x9f, 0x70, 0x6b, 0xa4, 0xb7, 0xef, 0xef, 0x33, 0xea, 0xc6, 0x0b, 0xb4, 0xfa, 0xcd, 0x8d, 0xa2,
-	0xcf, 0xc0, 0x37, 0x86, 0x4f, 0x6f, 0xdd, 0x0f, 0x3f, 0xd2, 0x3e, 0x53, 0xd0, 0xfa, 0x2c, 0xe3,
-	0xbe, 0x33, 0x9d, 0x7f, 0x06, 0x8a, 0x74, 0xed, 0x87, 0x29, 0x46, 0x51, 0xfb, 0x68, 0xe9, 0xc6,
-	0xb1, 0xdb, 0x98, 0x4e, 0x3d, 0x8e, 0x4d, 0x97, 0x67, 0xc7, 0x0e, 0xf5, 0x2a, 0x0f, 0xcf, 0x07,
-	0x19, 0xe5, 0x62, 0x90, 0x51, 0x3e, 0x0d, 0x32, 0xca, 0xeb, 0xab, 0x4c, 0xe4, 0xe2, 0x2a, 0x13,
-	0xf9, 0x70, 0x95, 0x89, 0x3c, 0xfd, 0x67, 0x6c, 0xcc, 0x39, 0xaf, 0x78, 0x0f, 0x2d, 0xda, 0x16,
-	0x3f, 0xc5, 0xf0, 0x01, 0x0d, 0x6f, 0x98, 0xe2, 0xf0, 0x09, 0x15, 0xb3, 0xdf, 0x4c, 0x08, 0xe4,
-	0x5f, 0x5f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x61, 0x15, 0x08, 0x55, 0x12, 0x08, 0x00, 0x00,
+func (m *MsgUpdateProxyContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateProxyContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateProxyContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateProxyContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateProxyContract.Merge(m, src)
+}
+func (m *MsgUpdateProxyContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateProxyContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateProxyContract.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgUpdateProxyContract proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgUpdateProxyContract) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// The StoreDataRequestWasm method stores a dr wasm in the wasm-storage
-	// module.
-	StoreDataRequestWasm(ctx context.Context, in *MsgStoreDataRequestWasm, opts ...grpc.CallOption) (*MsgStoreDataRequestWasmResponse, error)
-	// The StoreOverlayWasm method stores an overlay wasm in the wasm-storage
-	// module.
-	StoreOverlayWasm(ctx context.Context, in *MsgStoreOverlayWasm, opts ...grpc.CallOption) (*MsgStoreOverlayWasmResponse, error)
-	// The InstantiateAndRegisterProxyContract method instantiates the proxy
-	// contract and registers it's address.
-	InstantiateAndRegisterProxyContract(ctx context.Context, in *MsgInstantiateAndRegisterProxyContract, opts ...grpc.CallOption) (*MsgInstantiateAndRegisterProxyContractResponse, error)
-	// The UpdateParams method updates the module's parameters.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (m *MsgUpdateProxyContract) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgUpdateProxyContract) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+// The response message for the UpdateProxyContract method.
+type MsgUpdateProxyContractResponse struct {
 }
 
-func (c *msgClient) StoreDataRequestWasm(ctx context.Context, in *MsgStoreDataRequestWasm, opts ...grpc.CallOption) (*MsgStoreDataRequestWasmResponse, error) {
-	out := new(MsgStoreDataRequestWasmResponse)
-	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Msg/StoreDataRequestWasm", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateProxyContractResponse) Reset()         { *m = MsgUpdateProxyContractResponse{} }
+func (m *MsgUpdateProxyContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateProxyContractResponse) ProtoMessage()    {}
+func (*MsgUpdateProxyContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10a76e8135c0d000, []int{15}
+}
+func (m *MsgUpdateProxyContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateProxyContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateProxyContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUpdateProxyContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateProxyContractResponse.Merge(m, src)
+}
+func (m *MsgUpdateProxyContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateProxyContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateProxyContractResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) StoreOverlayWasm(ctx context.Context, in *MsgStoreOverlayWasm, opts ...grpc.CallOption) (*MsgStoreOverlayWasmResponse, error) {
-	out := new(MsgStoreOverlayWasmResponse)
-	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Msg/StoreOverlayWasm", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgUpdateProxyContractResponse proto.InternalMessageInfo
+
+// The request message for the RemoveProxyContract method.
+type MsgRemoveProxyContract struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// name is the name of the registry entry to be removed.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (c *msgClient) InstantiateAndRegisterProxyContract(ctx context.Context, in *MsgInstantiateAndRegisterProxyContract, opts ...grpc.CallOption) (*MsgInstantiateAndRegisterProxyContractResponse, error) {
-	out := new(MsgInstantiateAndRegisterProxyContractResponse)
-	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Msg/InstantiateAndRegisterProxyContract", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveProxyContract) Reset()         { *m = MsgRemoveProxyContract{} }
+func (m *MsgRemoveProxyContract) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveProxyContract) ProtoMessage()    {}
+func (*MsgRemoveProxyContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10a76e8135c0d000, []int{16}
+}
+func (m *MsgRemoveProxyContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveProxyContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveProxyContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRemoveProxyContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveProxyContract.Merge(m, src)
+}
+func (m *MsgRemoveProxyContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveProxyContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveProxyContract.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRemoveProxyContract proto.InternalMessageInfo
+
+func (m *MsgRemoveProxyContract) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// The StoreDataRequestWasm method stores a dr wasm in the wasm-storage
-	// module.
-	StoreDataRequestWasm(context.Context, *MsgStoreDataRequestWasm) (*MsgStoreDataRequestWasmResponse, error)
-	// The StoreOverlayWasm method stores an overlay wasm in the wasm-storage
-	// module.
-	StoreOverlayWasm(context.Context, *MsgStoreOverlayWasm) (*MsgStoreOverlayWasmResponse, error)
-	// The InstantiateAndRegisterProxyContract method instantiates the proxy
-	// contract and registers it's address.
-	InstantiateAndRegisterProxyContract(context.Context, *MsgInstantiateAndRegisterProxyContract) (*MsgInstantiateAndRegisterProxyContractResponse, error)
-	// The UpdateParams method updates the module's parameters.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+func (m *MsgRemoveProxyContract) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// The response message for the RemoveProxyContract method.
+type MsgRemoveProxyContractResponse struct {
 }
 
-func (*UnimplementedMsgServer) StoreDataRequestWasm(ctx context.Context, req *MsgStoreDataRequestWasm) (*MsgStoreDataRequestWasmResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StoreDataRequestWasm not implemented")
+func (m *MsgRemoveProxyContractResponse) Reset()         { *m = MsgRemoveProxyContractResponse{} }
+func (m *MsgRemoveProxyContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveProxyContractResponse) ProtoMessage()    {}
+func (*MsgRemoveProxyContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10a76e8135c0d000, []int{17}
 }
-func (*UnimplementedMsgServer) StoreOverlayWasm(ctx context.Context, req *MsgStoreOverlayWasm) (*MsgStoreOverlayWasmResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StoreOverlayWasm not implemented")
+func (m *MsgRemoveProxyContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) InstantiateAndRegisterProxyContract(ctx context.Context, req *MsgInstantiateAndRegisterProxyContract) (*MsgInstantiateAndRegisterProxyContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InstantiateAndRegisterProxyContract not implemented")
+func (m *MsgRemoveProxyContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveProxyContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgRemoveProxyContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveProxyContractResponse.Merge(m, src)
+}
+func (m *MsgRemoveProxyContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveProxyContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveProxyContractResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgRemoveProxyContractResponse proto.InternalMessageInfo
+
+// The request message for the RemoveDataRequestWasm method.
+type MsgRemoveDataRequestWasm struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// hash is the hex-encoded hash of the wasm to be removed.
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
 }
 
-func _Msg_StoreDataRequestWasm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgStoreDataRequestWasm)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).StoreDataRequestWasm(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sedachain.wasm_storage.v1.Msg/StoreDataRequestWasm",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).StoreDataRequestWasm(ctx, req.(*MsgStoreDataRequestWasm))
+func (m *MsgRemoveDataRequestWasm) Reset()         { *m = MsgRemoveDataRequestWasm{} }
+func (m *MsgRemoveDataRequestWasm) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveDataRequestWasm) ProtoMessage()    {}
+func (*MsgRemoveDataRequestWasm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10a76e8135c0d000, []int{18}
+}
+func (m *MsgRemoveDataRequestWasm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveDataRequestWasm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveDataRequestWasm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRemoveDataRequestWasm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveDataRequestWasm.Merge(m, src)
+}
+func (m *MsgRemoveDataRequestWasm) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveDataRequestWasm) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveDataRequestWasm.DiscardUnknown(m)
 }
 
-func _Msg_StoreOverlayWasm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgStoreOverlayWasm)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).StoreOverlayWasm(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sedachain.wasm_storage.v1.Msg/StoreOverlayWasm",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).StoreOverlayWasm(ctx, req.(*MsgStoreOverlayWasm))
+var xxx_messageInfo_MsgRemoveDataRequestWasm proto.InternalMessageInfo
+
+func (m *MsgRemoveDataRequestWasm) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_InstantiateAndRegisterProxyContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgInstantiateAndRegisterProxyContract)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).InstantiateAndRegisterProxyContract(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sedachain.wasm_storage.v1.Msg/InstantiateAndRegisterProxyContract",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).InstantiateAndRegisterProxyContract(ctx, req.(*MsgInstantiateAndRegisterProxyContract))
+func (m *MsgRemoveDataRequestWasm) GetHash() string {
+	if m != nil {
+		return m.Hash
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sedachain.wasm_storage.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
-	}
-	return interceptor(ctx, in, info, handler)
+// The response message for the RemoveDataRequestWasm method.
+type MsgRemoveDataRequestWasmResponse struct {
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "sedachain.wasm_storage.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "StoreDataRequestWasm",
-			Handler:    _Msg_StoreDataRequestWasm_Handler,
-		},
-		{
-			MethodName: "StoreOverlayWasm",
-			Handler:    _Msg_StoreOverlayWasm_Handler,
-		},
-		{
-			MethodName: "InstantiateAndRegisterProxyContract",
-			Handler:    _Msg_InstantiateAndRegisterProxyContract_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "sedachain/wasm_storage/v1/tx.proto",
+func (m *MsgRemoveDataRequestWasmResponse) Reset()         { *m = MsgRemoveDataRequestWasmResponse{} }
+func (m *MsgRemoveDataRequestWasmResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveDataRequestWasmResponse) ProtoMessage()    {}
+func (*MsgRemoveDataRequestWasmResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10a76e8135c0d000, []int{19}
 }
-
-func (m *MsgStoreDataRequestWasm) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveDataRequestWasmResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveDataRequestWasmResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveDataRequestWasmResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgStoreDataRequestWasm) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemoveDataRequestWasmResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveDataRequestWasmResponse.Merge(m, src)
+}
+func (m *MsgRemoveDataRequestWasmResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveDataRequestWasmResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveDataRequestWasmResponse.DiscardUnknown(m)
 }
 
-func (m *MsgStoreDataRequestWasm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.WasmType != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.WasmType))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Wasm) > 0 {
-		i -= len(m.Wasm)
-		copy(dAtA[i:], m.Wasm)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Wasm)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgRemoveDataRequestWasmResponse proto.InternalMessageInfo
+
+// The request message for the RemoveOverlayWasm method.
+type MsgRemoveOverlayWasm struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// hash is the hex-encoded hash of the wasm to be removed.
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
 }
 
-func (m *MsgStoreDataRequestWasmResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveOverlayWasm) Reset()         { *m = MsgRemoveOverlayWasm{} }
+func (m *MsgRemoveOverlayWasm) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveOverlayWasm) ProtoMessage()    {}
+func (*MsgRemoveOverlayWasm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10a76e8135c0d000, []int{20}
+}
+func (m *MsgRemoveOverlayWasm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveOverlayWasm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveOverlayWasm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgStoreDataRequestWasmResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemoveOverlayWasm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveOverlayWasm.Merge(m, src)
+}
+func (m *MsgRemoveOverlayWasm) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveOverlayWasm) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveOverlayWasm.DiscardUnknown(m)
 }
 
-func (m *MsgStoreDataRequestWasmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgRemoveOverlayWasm proto.InternalMessageInfo
+
+func (m *MsgRemoveOverlayWasm) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgStoreOverlayWasm) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveOverlayWasm) GetHash() string {
+	if m != nil {
+		return m.Hash
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgStoreOverlayWasm) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// The response message for the RemoveOverlayWasm method.
+type MsgRemoveOverlayWasmResponse struct {
 }
 
-func (m *MsgStoreOverlayWasm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.WasmType != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.WasmType))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Wasm) > 0 {
-		i -= len(m.Wasm)
-		copy(dAtA[i:], m.Wasm)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Wasm)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgRemoveOverlayWasmResponse) Reset()         { *m = MsgRemoveOverlayWasmResponse{} }
+func (m *MsgRemoveOverlayWasmResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveOverlayWasmResponse) ProtoMessage()    {}
+func (*MsgRemoveOverlayWasmResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10a76e8135c0d000, []int{21}
+}
+func (m *MsgRemoveOverlayWasmResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveOverlayWasmResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveOverlayWasmResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRemoveOverlayWasmResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveOverlayWasmResponse.Merge(m, src)
+}
+func (m *MsgRemoveOverlayWasmResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveOverlayWasmResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveOverlayWasmResponse.DiscardUnknown(m)
 }
 
-func (m *MsgStoreOverlayWasmResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRemoveOverlayWasmResponse proto.InternalMessageInfo
+
+// The request message for the ActivateOverlayWasm method.
+type MsgActivateOverlayWasm struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// hash is the hex-encoded hash of the overlay wasm to be activated.
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// activation_height is the block height from which the overlay wasm is
+	// active. Zero activates it immediately.
+	ActivationHeight int64 `protobuf:"varint,3,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+}
+
+func (m *MsgActivateOverlayWasm) Reset()         { *m = MsgActivateOverlayWasm{} }
+func (m *MsgActivateOverlayWasm) String() string { return proto.CompactTextString(m) }
+func (*MsgActivateOverlayWasm) ProtoMessage()    {}
+func (*MsgActivateOverlayWasm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10a76e8135c0d000, []int{22}
+}
+func (m *MsgActivateOverlayWasm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgActivateOverlayWasm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgActivateOverlayWasm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgActivateOverlayWasm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgActivateOverlayWasm.Merge(m, src)
+}
+func (m *MsgActivateOverlayWasm) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgActivateOverlayWasm) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgActivateOverlayWasm.DiscardUnknown(m)
 }
 
-func (m *MsgStoreOverlayWasmResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgActivateOverlayWasm proto.InternalMessageInfo
+
+func (m *MsgActivateOverlayWasm) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-func (m *MsgStoreOverlayWasmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgActivateOverlayWasm) GetHash() string {
+	if m != nil {
+		return m.Hash
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgInstantiateAndRegisterProxyContract) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgActivateOverlayWasm) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgInstantiateAndRegisterProxyContract) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// The response message for the ActivateOverlayWasm method.
+type MsgActivateOverlayWasmResponse struct {
+	ActivationHeight int64 `protobuf:"varint,1,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
 }
 
-func (m *MsgInstantiateAndRegisterProxyContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FixMsg {
-		i--
-		if m.FixMsg {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MsgActivateOverlayWasmResponse) Reset()         { *m = MsgActivateOverlayWasmResponse{} }
+func (m *MsgActivateOverlayWasmResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgActivateOverlayWasmResponse) ProtoMessage()    {}
+func (*MsgActivateOverlayWasmResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10a76e8135c0d000, []int{23}
+}
+func (m *MsgActivateOverlayWasmResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgActivateOverlayWasmResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgActivateOverlayWasmResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x40
+		return b[:n], nil
 	}
-	if len(m.Salt) > 0 {
-		i -= len(m.Salt)
-		copy(dAtA[i:], m.Salt)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
-		i--
-		dAtA[i] = 0x3a
+}
+func (m *MsgActivateOverlayWasmResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgActivateOverlayWasmResponse.Merge(m, src)
+}
+func (m *MsgActivateOverlayWasmResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgActivateOverlayWasmResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgActivateOverlayWasmResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgActivateOverlayWasmResponse proto.InternalMessageInfo
+
+func (m *MsgActivateOverlayWasmResponse) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
 	}
-	if len(m.Funds) > 0 {
-		for iNdEx := len(m.Funds) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Funds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
+	return 0
+}
+
+// The request message for the RollbackOverlayWasm method.
+type MsgRollbackOverlayWasm struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// wasm_type is the overlay wasm type to be rolled back.
+	WasmType WasmType `protobuf:"varint,2,opt,name=wasm_type,json=wasmType,proto3,enum=sedachain.wasm_storage.v1.WasmType" json:"wasm_type,omitempty"`
+}
+
+func (m *MsgRollbackOverlayWasm) Reset()         { *m = MsgRollbackOverlayWasm{} }
+func (m *MsgRollbackOverlayWasm) String() string { return proto.CompactTextString(m) }
+func (*MsgRollbackOverlayWasm) ProtoMessage()    {}
+func (*MsgRollbackOverlayWasm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10a76e8135c0d000, []int{24}
+}
+func (m *MsgRollbackOverlayWasm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRollbackOverlayWasm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRollbackOverlayWasm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Label) > 0 {
-		i -= len(m.Label)
-		copy(dAtA[i:], m.Label)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Label)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.CodeID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CodeID))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgRollbackOverlayWasm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRollbackOverlayWasm.Merge(m, src)
+}
+func (m *MsgRollbackOverlayWasm) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRollbackOverlayWasm) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRollbackOverlayWasm.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRollbackOverlayWasm proto.InternalMessageInfo
+
+func (m *MsgRollbackOverlayWasm) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgInstantiateAndRegisterProxyContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRollbackOverlayWasm) GetWasmType() WasmType {
+	if m != nil {
+		return m.WasmType
 	}
-	return dAtA[:n], nil
+	return WasmTypeNil
 }
 
-func (m *MsgInstantiateAndRegisterProxyContractResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// The response message for the RollbackOverlayWasm method.
+type MsgRollbackOverlayWasmResponse struct {
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 }
 
-func (m *MsgInstantiateAndRegisterProxyContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgRollbackOverlayWasmResponse) Reset()         { *m = MsgRollbackOverlayWasmResponse{} }
+func (m *MsgRollbackOverlayWasmResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRollbackOverlayWasmResponse) ProtoMessage()    {}
+func (*MsgRollbackOverlayWasmResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10a76e8135c0d000, []int{25}
+}
+func (m *MsgRollbackOverlayWasmResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRollbackOverlayWasmResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRollbackOverlayWasmResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRollbackOverlayWasmResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRollbackOverlayWasmResponse.Merge(m, src)
+}
+func (m *MsgRollbackOverlayWasmResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRollbackOverlayWasmResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRollbackOverlayWasmResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRollbackOverlayWasmResponse proto.InternalMessageInfo
+
+func (m *MsgRollbackOverlayWasmResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// The request message for the UpdateParams method.
+type MsgUpdateParams struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Params    Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10a76e8135c0d000, []int{26}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// no data needs to be returned
+type MsgUpdateParamsResponse struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10a76e8135c0d000, []int{27}
 }
-func (m *MsgStoreDataRequestWasm) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Wasm)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgStoreDataRequestWasm)(nil), "sedachain.wasm_storage.v1.MsgStoreDataRequestWasm")
+	proto.RegisterType((*MsgStoreDataRequestWasmResponse)(nil), "sedachain.wasm_storage.v1.MsgStoreDataRequestWasmResponse")
+	proto.RegisterType((*MsgStoreOverlayWasm)(nil), "sedachain.wasm_storage.v1.MsgStoreOverlayWasm")
+	proto.RegisterType((*MsgStoreOverlayWasmResponse)(nil), "sedachain.wasm_storage.v1.MsgStoreOverlayWasmResponse")
+	proto.RegisterType((*MsgBeginWasmUpload)(nil), "sedachain.wasm_storage.v1.MsgBeginWasmUpload")
+	proto.RegisterType((*MsgBeginWasmUploadResponse)(nil), "sedachain.wasm_storage.v1.MsgBeginWasmUploadResponse")
+	proto.RegisterType((*MsgUploadWasmChunk)(nil), "sedachain.wasm_storage.v1.MsgUploadWasmChunk")
+	proto.RegisterType((*MsgUploadWasmChunkResponse)(nil), "sedachain.wasm_storage.v1.MsgUploadWasmChunkResponse")
+	proto.RegisterType((*MsgFinalizeWasmUpload)(nil), "sedachain.wasm_storage.v1.MsgFinalizeWasmUpload")
+	proto.RegisterType((*MsgFinalizeWasmUploadResponse)(nil), "sedachain.wasm_storage.v1.MsgFinalizeWasmUploadResponse")
+	proto.RegisterType((*MsgInstantiateAndRegisterProxyContract)(nil), "sedachain.wasm_storage.v1.MsgInstantiateAndRegisterProxyContract")
+	proto.RegisterType((*MsgInstantiateAndRegisterProxyContractResponse)(nil), "sedachain.wasm_storage.v1.MsgInstantiateAndRegisterProxyContractResponse")
+	proto.RegisterType((*MsgRegisterProxyContract)(nil), "sedachain.wasm_storage.v1.MsgRegisterProxyContract")
+	proto.RegisterType((*MsgRegisterProxyContractResponse)(nil), "sedachain.wasm_storage.v1.MsgRegisterProxyContractResponse")
+	proto.RegisterType((*MsgUpdateProxyContract)(nil), "sedachain.wasm_storage.v1.MsgUpdateProxyContract")
+	proto.RegisterType((*MsgUpdateProxyContractResponse)(nil), "sedachain.wasm_storage.v1.MsgUpdateProxyContractResponse")
+	proto.RegisterType((*MsgRemoveProxyContract)(nil), "sedachain.wasm_storage.v1.MsgRemoveProxyContract")
+	proto.RegisterType((*MsgRemoveProxyContractResponse)(nil), "sedachain.wasm_storage.v1.MsgRemoveProxyContractResponse")
+	proto.RegisterType((*MsgRemoveDataRequestWasm)(nil), "sedachain.wasm_storage.v1.MsgRemoveDataRequestWasm")
+	proto.RegisterType((*MsgRemoveDataRequestWasmResponse)(nil), "sedachain.wasm_storage.v1.MsgRemoveDataRequestWasmResponse")
+	proto.RegisterType((*MsgRemoveOverlayWasm)(nil), "sedachain.wasm_storage.v1.MsgRemoveOverlayWasm")
+	proto.RegisterType((*MsgRemoveOverlayWasmResponse)(nil), "sedachain.wasm_storage.v1.MsgRemoveOverlayWasmResponse")
+	proto.RegisterType((*MsgActivateOverlayWasm)(nil), "sedachain.wasm_storage.v1.MsgActivateOverlayWasm")
+	proto.RegisterType((*MsgActivateOverlayWasmResponse)(nil), "sedachain.wasm_storage.v1.MsgActivateOverlayWasmResponse")
+	proto.RegisterType((*MsgRollbackOverlayWasm)(nil), "sedachain.wasm_storage.v1.MsgRollbackOverlayWasm")
+	proto.RegisterType((*MsgRollbackOverlayWasmResponse)(nil), "sedachain.wasm_storage.v1.MsgRollbackOverlayWasmResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "sedachain.wasm_storage.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "sedachain.wasm_storage.v1.MsgUpdateParamsResponse")
+}
+
+func init() {
+	proto.RegisterFile("sedachain/wasm_storage/v1/tx.proto", fileDescriptor_10a76e8135c0d000)
+}
+
+var fileDescriptor_10a76e8135c0d000 = []byte{
+	// 1315 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xc6, 0x89, 0x93, 0xbc, 0x54, 0x69, 0xbb, 0x4d, 0xbf, 0x71, 0xf6, 0x0b, 0x8e, 0x71,
+	0x05, 0x8a, 0x0a, 0x59, 0x37, 0x2e, 0xfd, 0x91, 0x56, 0x15, 0xc4, 0xae, 0x2a, 0x72, 0xb0, 0x0a,
+	0x5b, 0x10, 0x12, 0x17, 0x6b, 0xbc, 0x3b, 0x59, 0x2f, 0xf5, 0xee, 0x98, 0x9d, 0xb1, 0xe3, 0x44,
+	0x42, 0x42, 0x20, 0x21, 0x71, 0x43, 0xe2, 0xc6, 0x0d, 0x4e, 0xa8, 0x07, 0x84, 0x04, 0xff, 0x03,
+	0x15, 0xa7, 0x0a, 0x71, 0xe0, 0x54, 0x50, 0x7a, 0xe0, 0x7f, 0xe0, 0x84, 0x66, 0x66, 0xbd, 0xfe,
+	0xb1, 0xeb, 0x9f, 0x4d, 0xa4, 0x9e, 0x3c, 0xbb, 0xf3, 0xde, 0xfb, 0x7c, 0x3e, 0xef, 0x8d, 0xf7,
+	0xbd, 0x5d, 0xc8, 0x52, 0x6c, 0x21, 0xb3, 0x8a, 0x1c, 0x2f, 0x77, 0x80, 0xa8, 0x5b, 0xa6, 0x8c,
+	0xf8, 0xc8, 0xc6, 0xb9, 0xe6, 0x76, 0x8e, 0xb5, 0xf4, 0xba, 0x4f, 0x18, 0x51, 0xd7, 0x43, 0x1b,
+	0xbd, 0xdb, 0x46, 0x6f, 0x6e, 0x6b, 0x69, 0x93, 0x50, 0x97, 0xd0, 0x5c, 0x05, 0x51, 0xee, 0x53,
+	0xc1, 0x0c, 0x6d, 0xe7, 0x4c, 0xe2, 0x78, 0xd2, 0x55, 0x5b, 0x0b, 0xf6, 0x5d, 0x6a, 0xf3, 0x90,
+	0x2e, 0xb5, 0x83, 0x8d, 0x55, 0x9b, 0xd8, 0x44, 0x2c, 0x73, 0x7c, 0x15, 0xdc, 0x5d, 0x97, 0xe6,
+	0x65, 0xb9, 0x21, 0x2f, 0x82, 0xad, 0x37, 0x06, 0x13, 0xed, 0x21, 0x25, 0xac, 0xb3, 0x3f, 0x2a,
+	0xb0, 0x56, 0xa2, 0xf6, 0x03, 0x46, 0x7c, 0x7c, 0x17, 0x31, 0x64, 0xe0, 0x4f, 0x1a, 0x98, 0xb2,
+	0x0f, 0x11, 0x75, 0xd5, 0x2b, 0x90, 0xa4, 0xd8, 0xb3, 0xb0, 0x9f, 0x52, 0x32, 0xca, 0xe6, 0x52,
+	0x21, 0xf5, 0xfb, 0x2f, 0x5b, 0xab, 0x01, 0xd6, 0xae, 0x65, 0xf9, 0x98, 0xd2, 0x07, 0xcc, 0x77,
+	0x3c, 0xdb, 0x08, 0xec, 0x54, 0x15, 0xe6, 0x38, 0x46, 0x6a, 0x36, 0xa3, 0x6c, 0x9e, 0x31, 0xc4,
+	0x5a, 0x7d, 0x1b, 0x96, 0x04, 0x2e, 0x3b, 0xac, 0xe3, 0x54, 0x22, 0xa3, 0x6c, 0xae, 0xe4, 0x2f,
+	0xe9, 0x03, 0x13, 0xa5, 0x73, 0xe4, 0xf7, 0x0f, 0xeb, 0xd8, 0x58, 0x3c, 0x08, 0x56, 0xb7, 0x96,
+	0x3f, 0xff, 0xe7, 0xa7, 0xcb, 0x01, 0x44, 0x96, 0xc1, 0xc6, 0x00, 0xbe, 0x06, 0xa6, 0x75, 0xe2,
+	0x51, 0xcc, 0x59, 0x54, 0x11, 0xad, 0x4a, 0xd6, 0x86, 0x58, 0xab, 0x77, 0x20, 0xb9, 0x4f, 0x7c,
+	0x17, 0x31, 0xc1, 0x6d, 0x25, 0xff, 0xea, 0x08, 0x0a, 0xf7, 0x84, 0xb1, 0x11, 0x38, 0x65, 0x1f,
+	0x29, 0x70, 0xa1, 0x0d, 0x7b, 0xbf, 0x89, 0xfd, 0x1a, 0x3a, 0x7c, 0x71, 0x53, 0x54, 0x87, 0xff,
+	0xc7, 0x70, 0x3d, 0xcd, 0xf4, 0xfc, 0xa6, 0x80, 0x5a, 0xa2, 0x76, 0x01, 0xdb, 0x8e, 0xc7, 0xb7,
+	0x3f, 0xa8, 0xd7, 0x08, 0xb2, 0xa6, 0xcb, 0x8e, 0xe0, 0x36, 0xdb, 0xc5, 0x6d, 0x03, 0x96, 0x1b,
+	0x22, 0x5e, 0x99, 0x3a, 0x47, 0x32, 0x3f, 0x73, 0x06, 0xc8, 0x5b, 0x0f, 0x9c, 0x23, 0xdc, 0x9b,
+	0xbe, 0xb9, 0xe7, 0x4e, 0xdf, 0x1e, 0x68, 0x51, 0x2d, 0x61, 0xf6, 0x5e, 0x87, 0xf3, 0xb8, 0x55,
+	0x77, 0x7c, 0xc4, 0x1c, 0xe2, 0x95, 0xab, 0xd8, 0xb1, 0xab, 0x4c, 0xc8, 0x4b, 0x18, 0xe7, 0x3a,
+	0x1b, 0xef, 0x88, 0xfb, 0xd9, 0x6f, 0x64, 0x5e, 0x64, 0x08, 0x1e, 0xac, 0x58, 0x6d, 0x78, 0x0f,
+	0x4f, 0x28, 0x2f, 0xab, 0x30, 0xef, 0x78, 0x16, 0x6e, 0x05, 0x19, 0x91, 0x17, 0xfc, 0xae, 0xc9,
+	0x41, 0x44, 0x22, 0xce, 0x18, 0xf2, 0xa2, 0x57, 0xe0, 0x4d, 0xd0, 0xa2, 0xa4, 0x42, 0x81, 0x1a,
+	0x2c, 0xfa, 0xd8, 0xc4, 0x4e, 0x13, 0x5b, 0x82, 0xde, 0x9c, 0x11, 0x5e, 0x67, 0x3f, 0x86, 0x8b,
+	0x25, 0x6a, 0xdf, 0x73, 0x3c, 0x54, 0x73, 0x8e, 0xf0, 0x49, 0x57, 0xba, 0x97, 0xa5, 0x0f, 0x2f,
+	0xc7, 0x62, 0x9d, 0xe6, 0x39, 0xfe, 0x35, 0x01, 0xaf, 0x95, 0xa8, 0xbd, 0xe7, 0x51, 0x86, 0x3c,
+	0xe6, 0x20, 0x86, 0x77, 0x3d, 0xcb, 0xc0, 0xb6, 0x43, 0x19, 0xf6, 0xdf, 0xf5, 0x49, 0xeb, 0xb0,
+	0x48, 0x3c, 0xe6, 0x23, 0x93, 0x4d, 0xa1, 0x58, 0x87, 0x79, 0x64, 0xb9, 0x8e, 0x97, 0x9a, 0x1d,
+	0xe1, 0x20, 0xcd, 0xd4, 0x4b, 0xb0, 0x60, 0x12, 0x0b, 0x97, 0x1d, 0x4b, 0x56, 0xb8, 0x00, 0xc7,
+	0x4f, 0x37, 0x92, 0x45, 0x62, 0xe1, 0xbd, 0xbb, 0x46, 0x92, 0x6f, 0xed, 0x59, 0xbc, 0xdc, 0x35,
+	0x54, 0xc1, 0x35, 0x51, 0xee, 0x25, 0x43, 0x5e, 0xa8, 0xf7, 0x21, 0xe1, 0x52, 0x3b, 0x35, 0xcf,
+	0x8f, 0x40, 0xe1, 0xce, 0xbf, 0x4f, 0x37, 0x76, 0x6c, 0x87, 0x55, 0x1b, 0x15, 0xdd, 0x24, 0x6e,
+	0xae, 0x48, 0xa8, 0xcb, 0xb5, 0x8b, 0x76, 0x60, 0xe5, 0x5a, 0xe2, 0x37, 0xc7, 0xff, 0x3c, 0x54,
+	0x37, 0xd0, 0x41, 0x5b, 0x61, 0x09, 0x53, 0x8a, 0x6c, 0x6c, 0xf0, 0x48, 0x2a, 0x82, 0xf9, 0xfd,
+	0x86, 0x67, 0xd1, 0x54, 0x32, 0x93, 0xd8, 0x5c, 0xce, 0xaf, 0xeb, 0x01, 0x71, 0xde, 0xce, 0xf4,
+	0xa0, 0x9d, 0xe9, 0x45, 0xe2, 0x78, 0x85, 0x2b, 0x8f, 0x9f, 0x6e, 0xcc, 0x3c, 0xfa, 0x6b, 0x63,
+	0xb3, 0x0b, 0x31, 0xe8, 0x6d, 0xf2, 0x67, 0x8b, 0x5a, 0x0f, 0x03, 0x34, 0xee, 0x40, 0x0d, 0x19,
+	0x99, 0x97, 0x93, 0xa2, 0x1a, 0x4b, 0x2d, 0xc8, 0x07, 0x23, 0x5f, 0xab, 0x6b, 0xb0, 0xb0, 0xef,
+	0xb4, 0xca, 0x5c, 0xcb, 0x62, 0x46, 0xd9, 0x5c, 0x34, 0x92, 0xfb, 0x4e, 0xab, 0x44, 0x6d, 0x6e,
+	0xec, 0x21, 0x17, 0xa7, 0x96, 0x64, 0xed, 0xf9, 0xba, 0xf7, 0xf4, 0x34, 0x40, 0x1f, 0xaf, 0x90,
+	0xe1, 0x71, 0x2a, 0xc2, 0x39, 0x33, 0xb8, 0x57, 0x46, 0xb2, 0x1e, 0x23, 0x4b, 0x7b, 0xb6, 0xed,
+	0x11, 0xdc, 0xe6, 0x7d, 0x22, 0x55, 0xa2, 0x76, 0xfc, 0x91, 0xb9, 0x0e, 0x4b, 0xa8, 0xc1, 0xaa,
+	0xc4, 0x77, 0xd8, 0xe1, 0xc8, 0xd0, 0x1d, 0xd3, 0x50, 0xec, 0x6c, 0x47, 0xac, 0x9a, 0x87, 0x85,
+	0x36, 0xc9, 0xc4, 0x88, 0x48, 0x6d, 0xc3, 0x5b, 0x2b, 0x3c, 0x41, 0x9d, 0xb8, 0xd9, 0x2c, 0x64,
+	0x06, 0x71, 0x6d, 0x67, 0x25, 0xfb, 0x83, 0x02, 0xff, 0x13, 0x0f, 0x0b, 0x0b, 0x31, 0xfc, 0x62,
+	0xcb, 0xc9, 0x40, 0x3a, 0x9e, 0x69, 0x28, 0x86, 0x09, 0x2d, 0x06, 0x76, 0x49, 0xf3, 0xf4, 0xb4,
+	0x0c, 0xe0, 0x15, 0x83, 0x1a, 0xf2, 0x6a, 0x42, 0x2a, 0xb4, 0xe8, 0x1f, 0xc2, 0x9e, 0x83, 0x59,
+	0xe4, 0xf9, 0x3a, 0xe8, 0x00, 0xc4, 0xe0, 0x86, 0xdc, 0x7c, 0x58, 0x0d, 0x6d, 0xba, 0x27, 0x9f,
+	0xd3, 0xe4, 0x95, 0x86, 0x97, 0xe2, 0x30, 0x43, 0x4e, 0xdf, 0xc9, 0x43, 0xb9, 0x6b, 0x32, 0xa7,
+	0x89, 0xd8, 0x69, 0xd1, 0xe2, 0xad, 0x1e, 0x49, 0x88, 0xae, 0x56, 0x9f, 0x90, 0xad, 0xbe, 0xb3,
+	0x21, 0x5b, 0x7d, 0x44, 0x43, 0x09, 0xd2, 0xf1, 0x14, 0xbb, 0x27, 0x89, 0x68, 0x78, 0x25, 0x3e,
+	0x7c, 0xf6, 0x7b, 0x29, 0xd9, 0x20, 0xb5, 0x5a, 0x05, 0x99, 0x0f, 0x4f, 0x42, 0x72, 0xcf, 0xd8,
+	0x34, 0x3b, 0xcd, 0xd8, 0xd4, 0xaf, 0xf9, 0x4d, 0x48, 0xc7, 0x73, 0x1c, 0xd6, 0xb3, 0xb3, 0xdf,
+	0x2a, 0x70, 0xb6, 0xf3, 0xc7, 0x45, 0x3e, 0x72, 0xe9, 0xd4, 0x9a, 0xde, 0x82, 0x64, 0x5d, 0x44,
+	0x10, 0x82, 0x96, 0xf3, 0xaf, 0x0c, 0x11, 0x24, 0xa1, 0x0a, 0x73, 0xbc, 0x61, 0x19, 0x81, 0x5b,
+	0x44, 0xd2, 0x3a, 0xac, 0xf5, 0x71, 0x6b, 0x6b, 0xc9, 0xff, 0xb1, 0x02, 0x09, 0xde, 0x8b, 0xbe,
+	0x54, 0x60, 0x35, 0xf6, 0xfd, 0x29, 0x3f, 0x04, 0x7c, 0xc0, 0x3b, 0x8c, 0x76, 0x6b, 0x72, 0x9f,
+	0x30, 0xb9, 0x47, 0x70, 0x2e, 0xf2, 0x82, 0xa2, 0x8f, 0x11, 0xaf, 0xcb, 0x5e, 0xbb, 0x3e, 0x99,
+	0x7d, 0x88, 0x7d, 0x00, 0x67, 0xfb, 0xa7, 0xff, 0xad, 0xe1, 0xa1, 0xfa, 0xcc, 0xb5, 0x6b, 0x13,
+	0x99, 0x77, 0x03, 0xf7, 0x8f, 0xd7, 0x23, 0x80, 0xfb, 0xcc, 0xb5, 0x6b, 0x13, 0x99, 0x87, 0xc0,
+	0x9f, 0x29, 0xa0, 0xc6, 0x4d, 0xc2, 0xc3, 0xa3, 0x45, 0x3d, 0xb4, 0x9b, 0x93, 0x7a, 0x84, 0x14,
+	0x7e, 0x56, 0xe0, 0xd2, 0x38, 0xb3, 0xea, 0xee, 0x70, 0x84, 0x31, 0x42, 0x68, 0x7b, 0xcf, 0x1d,
+	0x22, 0x64, 0xfd, 0x95, 0x02, 0x17, 0xe3, 0x79, 0x5e, 0x1d, 0x0e, 0x12, 0xcf, 0xec, 0xf6, 0x14,
+	0x4e, 0x21, 0x97, 0x2f, 0x14, 0xb8, 0x10, 0x37, 0xdb, 0x6c, 0x8f, 0x3a, 0x13, 0x11, 0x17, 0x6d,
+	0x67, 0x62, 0x97, 0x1e, 0x16, 0x71, 0x53, 0xc9, 0xf6, 0x28, 0x69, 0x11, 0x17, 0x6d, 0x67, 0x62,
+	0x97, 0xbe, 0xba, 0xc4, 0xcd, 0x20, 0x57, 0xc7, 0x09, 0xda, 0xff, 0x24, 0xbb, 0x3d, 0x85, 0x53,
+	0xc8, 0xe5, 0x53, 0x38, 0x1f, 0x1d, 0x39, 0x72, 0xe3, 0x44, 0xec, 0x7e, 0x98, 0xdd, 0x98, 0xd0,
+	0xa1, 0xa7, 0x20, 0x71, 0xd3, 0xc5, 0x88, 0x82, 0xc4, 0xb8, 0x68, 0x3b, 0x13, 0xbb, 0xf4, 0x1e,
+	0x8b, 0x98, 0x86, 0x3f, 0xea, 0x58, 0x44, 0x5d, 0xb4, 0x9d, 0x89, 0x5d, 0x42, 0x16, 0x1e, 0x9c,
+	0xe9, 0x69, 0xcd, 0x97, 0xc7, 0x3a, 0xe7, 0xc2, 0x56, 0xcb, 0x8f, 0x6f, 0xdb, 0xc6, 0x2b, 0xbc,
+	0xf7, 0xf8, 0x38, 0xad, 0x3c, 0x39, 0x4e, 0x2b, 0x7f, 0x1f, 0xa7, 0x95, 0xaf, 0x9f, 0xa5, 0x67,
+	0x9e, 0x3c, 0x4b, 0xcf, 0xfc, 0xf9, 0x2c, 0x3d, 0xf3, 0xd1, 0x8d, 0xae, 0x57, 0x4a, 0x1e, 0x57,
+	0x7c, 0xc1, 0x34, 0x49, 0x4d, 0x5c, 0x6c, 0xc9, 0x4f, 0x9e, 0xf2, 0x6d, 0x76, 0xab, 0xfd, 0xd1,
+	0x53, 0xbc, 0x67, 0x56, 0x92, 0xc2, 0xf2, 0xea, 0x7f, 0x03, 0x00, 0x9d, 0x52, 0x45, 0xf9, 0xc4,
+	0x15, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// The StoreDataRequestWasm method stores a dr wasm in the wasm-storage
+	// module.
+	StoreDataRequestWasm(ctx context.Context, in *MsgStoreDataRequestWasm, opts ...grpc.CallOption) (*MsgStoreDataRequestWasmResponse, error)
+	// The StoreOverlayWasm method stores an overlay wasm in the wasm-storage
+	// module.
+	StoreOverlayWasm(ctx context.Context, in *MsgStoreOverlayWasm, opts ...grpc.CallOption) (*MsgStoreOverlayWasmResponse, error)
+	// The BeginWasmUpload method starts a chunked upload of a wasm that is
+	// too large to be stored in a single transaction.
+	BeginWasmUpload(ctx context.Context, in *MsgBeginWasmUpload, opts ...grpc.CallOption) (*MsgBeginWasmUploadResponse, error)
+	// The UploadWasmChunk method appends a chunk to a pending wasm upload.
+	UploadWasmChunk(ctx context.Context, in *MsgUploadWasmChunk, opts ...grpc.CallOption) (*MsgUploadWasmChunkResponse, error)
+	// The FinalizeWasmUpload method verifies a completed wasm upload and
+	// stores the wasm in the wasm-storage module.
+	FinalizeWasmUpload(ctx context.Context, in *MsgFinalizeWasmUpload, opts ...grpc.CallOption) (*MsgFinalizeWasmUploadResponse, error)
+	// The InstantiateAndRegisterProxyContract method instantiates the proxy
+	// contract and registers it's address.
+	InstantiateAndRegisterProxyContract(ctx context.Context, in *MsgInstantiateAndRegisterProxyContract, opts ...grpc.CallOption) (*MsgInstantiateAndRegisterProxyContractResponse, error)
+	// The RegisterProxyContract method adds a new named entry to the proxy
+	// contract registry.
+	RegisterProxyContract(ctx context.Context, in *MsgRegisterProxyContract, opts ...grpc.CallOption) (*MsgRegisterProxyContractResponse, error)
+	// The UpdateProxyContract method changes the address of an existing named
+	// entry of the proxy contract registry.
+	UpdateProxyContract(ctx context.Context, in *MsgUpdateProxyContract, opts ...grpc.CallOption) (*MsgUpdateProxyContractResponse, error)
+	// The RemoveProxyContract method removes a named entry from the proxy
+	// contract registry.
+	RemoveProxyContract(ctx context.Context, in *MsgRemoveProxyContract, opts ...grpc.CallOption) (*MsgRemoveProxyContractResponse, error)
+	// The RemoveDataRequestWasm method removes a dr wasm from the
+	// wasm-storage module.
+	RemoveDataRequestWasm(ctx context.Context, in *MsgRemoveDataRequestWasm, opts ...grpc.CallOption) (*MsgRemoveDataRequestWasmResponse, error)
+	// The RemoveOverlayWasm method removes an overlay wasm from the
+	// wasm-storage module.
+	RemoveOverlayWasm(ctx context.Context, in *MsgRemoveOverlayWasm, opts ...grpc.CallOption) (*MsgRemoveOverlayWasmResponse, error)
+	// The ActivateOverlayWasm method sets or schedules the active version of
+	// an overlay wasm type.
+	ActivateOverlayWasm(ctx context.Context, in *MsgActivateOverlayWasm, opts ...grpc.CallOption) (*MsgActivateOverlayWasmResponse, error)
+	// The RollbackOverlayWasm method restores the previously active version of
+	// an overlay wasm type.
+	RollbackOverlayWasm(ctx context.Context, in *MsgRollbackOverlayWasm, opts ...grpc.CallOption) (*MsgRollbackOverlayWasmResponse, error)
+	// The UpdateParams method updates the module's parameters.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) StoreDataRequestWasm(ctx context.Context, in *MsgStoreDataRequestWasm, opts ...grpc.CallOption) (*MsgStoreDataRequestWasmResponse, error) {
+	out := new(MsgStoreDataRequestWasmResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Msg/StoreDataRequestWasm", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.WasmType != 0 {
-		n += 1 + sovTx(uint64(m.WasmType))
+	return out, nil
+}
+
+func (c *msgClient) StoreOverlayWasm(ctx context.Context, in *MsgStoreOverlayWasm, opts ...grpc.CallOption) (*MsgStoreOverlayWasmResponse, error) {
+	out := new(MsgStoreOverlayWasmResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Msg/StoreOverlayWasm", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgStoreDataRequestWasmResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) BeginWasmUpload(ctx context.Context, in *MsgBeginWasmUpload, opts ...grpc.CallOption) (*MsgBeginWasmUploadResponse, error) {
+	out := new(MsgBeginWasmUploadResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Msg/BeginWasmUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UploadWasmChunk(ctx context.Context, in *MsgUploadWasmChunk, opts ...grpc.CallOption) (*MsgUploadWasmChunkResponse, error) {
+	out := new(MsgUploadWasmChunkResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Msg/UploadWasmChunk", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgStoreOverlayWasm) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) FinalizeWasmUpload(ctx context.Context, in *MsgFinalizeWasmUpload, opts ...grpc.CallOption) (*MsgFinalizeWasmUploadResponse, error) {
+	out := new(MsgFinalizeWasmUploadResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Msg/FinalizeWasmUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) InstantiateAndRegisterProxyContract(ctx context.Context, in *MsgInstantiateAndRegisterProxyContract, opts ...grpc.CallOption) (*MsgInstantiateAndRegisterProxyContractResponse, error) {
+	out := new(MsgInstantiateAndRegisterProxyContractResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Msg/InstantiateAndRegisterProxyContract", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Wasm)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) RegisterProxyContract(ctx context.Context, in *MsgRegisterProxyContract, opts ...grpc.CallOption) (*MsgRegisterProxyContractResponse, error) {
+	out := new(MsgRegisterProxyContractResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Msg/RegisterProxyContract", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.WasmType != 0 {
-		n += 1 + sovTx(uint64(m.WasmType))
+	return out, nil
+}
+
+func (c *msgClient) UpdateProxyContract(ctx context.Context, in *MsgUpdateProxyContract, opts ...grpc.CallOption) (*MsgUpdateProxyContractResponse, error) {
+	out := new(MsgUpdateProxyContractResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Msg/UpdateProxyContract", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgStoreOverlayWasmResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) RemoveProxyContract(ctx context.Context, in *MsgRemoveProxyContract, opts ...grpc.CallOption) (*MsgRemoveProxyContractResponse, error) {
+	out := new(MsgRemoveProxyContractResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Msg/RemoveProxyContract", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) RemoveDataRequestWasm(ctx context.Context, in *MsgRemoveDataRequestWasm, opts ...grpc.CallOption) (*MsgRemoveDataRequestWasmResponse, error) {
+	out := new(MsgRemoveDataRequestWasmResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Msg/RemoveDataRequestWasm", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgInstantiateAndRegisterProxyContract) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) RemoveOverlayWasm(ctx context.Context, in *MsgRemoveOverlayWasm, opts ...grpc.CallOption) (*MsgRemoveOverlayWasmResponse, error) {
+	out := new(MsgRemoveOverlayWasmResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Msg/RemoveOverlayWasm", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) ActivateOverlayWasm(ctx context.Context, in *MsgActivateOverlayWasm, opts ...grpc.CallOption) (*MsgActivateOverlayWasmResponse, error) {
+	out := new(MsgActivateOverlayWasmResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Msg/ActivateOverlayWasm", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) RollbackOverlayWasm(ctx context.Context, in *MsgRollbackOverlayWasm, opts ...grpc.CallOption) (*MsgRollbackOverlayWasmResponse, error) {
+	out := new(MsgRollbackOverlayWasmResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Msg/RollbackOverlayWasm", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.CodeID != 0 {
-		n += 1 + sovTx(uint64(m.CodeID))
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/sedachain.wasm_storage.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Label)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// The StoreDataRequestWasm method stores a dr wasm in the wasm-storage
+	// module.
+	StoreDataRequestWasm(context.Context, *MsgStoreDataRequestWasm) (*MsgStoreDataRequestWasmResponse, error)
+	// The StoreOverlayWasm method stores an overlay wasm in the wasm-storage
+	// module.
+	StoreOverlayWasm(context.Context, *MsgStoreOverlayWasm) (*MsgStoreOverlayWasmResponse, error)
+	// The BeginWasmUpload method starts a chunked upload of a wasm that is
+	// too large to be stored in a single transaction.
+	BeginWasmUpload(context.Context, *MsgBeginWasmUpload) (*MsgBeginWasmUploadResponse, error)
+	// The UploadWasmChunk method appends a chunk to a pending wasm upload.
+	UploadWasmChunk(context.Context, *MsgUploadWasmChunk) (*MsgUploadWasmChunkResponse, error)
+	// The FinalizeWasmUpload method verifies a completed wasm upload and
+	// stores the wasm in the wasm-storage module.
+	FinalizeWasmUpload(context.Context, *MsgFinalizeWasmUpload) (*MsgFinalizeWasmUploadResponse, error)
+	// The InstantiateAndRegisterProxyContract method instantiates the proxy
+	// contract and registers it's address.
+	InstantiateAndRegisterProxyContract(context.Context, *MsgInstantiateAndRegisterProxyContract) (*MsgInstantiateAndRegisterProxyContractResponse, error)
+	// The RegisterProxyContract method adds a new named entry to the proxy
+	// contract registry.
+	RegisterProxyContract(context.Context, *MsgRegisterProxyContract) (*MsgRegisterProxyContractResponse, error)
+	// The UpdateProxyContract method changes the address of an existing named
+	// entry of the proxy contract registry.
+	UpdateProxyContract(context.Context, *MsgUpdateProxyContract) (*MsgUpdateProxyContractResponse, error)
+	// The RemoveProxyContract method removes a named entry from the proxy
+	// contract registry.
+	RemoveProxyContract(context.Context, *MsgRemoveProxyContract) (*MsgRemoveProxyContractResponse, error)
+	// The RemoveDataRequestWasm method removes a dr wasm from the
+	// wasm-storage module.
+	RemoveDataRequestWasm(context.Context, *MsgRemoveDataRequestWasm) (*MsgRemoveDataRequestWasmResponse, error)
+	// The RemoveOverlayWasm method removes an overlay wasm from the
+	// wasm-storage module.
+	RemoveOverlayWasm(context.Context, *MsgRemoveOverlayWasm) (*MsgRemoveOverlayWasmResponse, error)
+	// The ActivateOverlayWasm method sets or schedules the active version of
+	// an overlay wasm type.
+	ActivateOverlayWasm(context.Context, *MsgActivateOverlayWasm) (*MsgActivateOverlayWasmResponse, error)
+	// The RollbackOverlayWasm method restores the previously active version of
+	// an overlay wasm type.
+	RollbackOverlayWasm(context.Context, *MsgRollbackOverlayWasm) (*MsgRollbackOverlayWasmResponse, error)
+	// The UpdateParams method updates the module's parameters.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) StoreDataRequestWasm(ctx context.Context, req *MsgStoreDataRequestWasm) (*MsgStoreDataRequestWasmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreDataRequestWasm not implemented")
+}
+func (*UnimplementedMsgServer) StoreOverlayWasm(ctx context.Context, req *MsgStoreOverlayWasm) (*MsgStoreOverlayWasmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreOverlayWasm not implemented")
+}
+func (*UnimplementedMsgServer) BeginWasmUpload(ctx context.Context, req *MsgBeginWasmUpload) (*MsgBeginWasmUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginWasmUpload not implemented")
+}
+func (*UnimplementedMsgServer) UploadWasmChunk(ctx context.Context, req *MsgUploadWasmChunk) (*MsgUploadWasmChunkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadWasmChunk not implemented")
+}
+func (*UnimplementedMsgServer) FinalizeWasmUpload(ctx context.Context, req *MsgFinalizeWasmUpload) (*MsgFinalizeWasmUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizeWasmUpload not implemented")
+}
+func (*UnimplementedMsgServer) InstantiateAndRegisterProxyContract(ctx context.Context, req *MsgInstantiateAndRegisterProxyContract) (*MsgInstantiateAndRegisterProxyContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstantiateAndRegisterProxyContract not implemented")
+}
+func (*UnimplementedMsgServer) RegisterProxyContract(ctx context.Context, req *MsgRegisterProxyContract) (*MsgRegisterProxyContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterProxyContract not implemented")
+}
+func (*UnimplementedMsgServer) UpdateProxyContract(ctx context.Context, req *MsgUpdateProxyContract) (*MsgUpdateProxyContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProxyContract not implemented")
+}
+func (*UnimplementedMsgServer) RemoveProxyContract(ctx context.Context, req *MsgRemoveProxyContract) (*MsgRemoveProxyContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveProxyContract not implemented")
+}
+func (*UnimplementedMsgServer) RemoveDataRequestWasm(ctx context.Context, req *MsgRemoveDataRequestWasm) (*MsgRemoveDataRequestWasmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDataRequestWasm not implemented")
+}
+func (*UnimplementedMsgServer) RemoveOverlayWasm(ctx context.Context, req *MsgRemoveOverlayWasm) (*MsgRemoveOverlayWasmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveOverlayWasm not implemented")
+}
+func (*UnimplementedMsgServer) ActivateOverlayWasm(ctx context.Context, req *MsgActivateOverlayWasm) (*MsgActivateOverlayWasmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateOverlayWasm not implemented")
+}
+func (*UnimplementedMsgServer) RollbackOverlayWasm(ctx context.Context, req *MsgRollbackOverlayWasm) (*MsgRollbackOverlayWasmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackOverlayWasm not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_StoreDataRequestWasm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStoreDataRequestWasm)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StoreDataRequestWasm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.wasm_storage.v1.Msg/StoreDataRequestWasm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StoreDataRequestWasm(ctx, req.(*MsgStoreDataRequestWasm))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_StoreOverlayWasm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStoreOverlayWasm)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StoreOverlayWasm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.wasm_storage.v1.Msg/StoreOverlayWasm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StoreOverlayWasm(ctx, req.(*MsgStoreOverlayWasm))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BeginWasmUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBeginWasmUpload)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BeginWasmUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.wasm_storage.v1.Msg/BeginWasmUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BeginWasmUpload(ctx, req.(*MsgBeginWasmUpload))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UploadWasmChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUploadWasmChunk)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UploadWasmChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.wasm_storage.v1.Msg/UploadWasmChunk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UploadWasmChunk(ctx, req.(*MsgUploadWasmChunk))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FinalizeWasmUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFinalizeWasmUpload)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FinalizeWasmUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.wasm_storage.v1.Msg/FinalizeWasmUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FinalizeWasmUpload(ctx, req.(*MsgFinalizeWasmUpload))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_InstantiateAndRegisterProxyContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInstantiateAndRegisterProxyContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InstantiateAndRegisterProxyContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.wasm_storage.v1.Msg/InstantiateAndRegisterProxyContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InstantiateAndRegisterProxyContract(ctx, req.(*MsgInstantiateAndRegisterProxyContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterProxyContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterProxyContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterProxyContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.wasm_storage.v1.Msg/RegisterProxyContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterProxyContract(ctx, req.(*MsgRegisterProxyContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateProxyContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateProxyContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateProxyContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.wasm_storage.v1.Msg/UpdateProxyContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateProxyContract(ctx, req.(*MsgUpdateProxyContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveProxyContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveProxyContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveProxyContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.wasm_storage.v1.Msg/RemoveProxyContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveProxyContract(ctx, req.(*MsgRemoveProxyContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveDataRequestWasm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveDataRequestWasm)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveDataRequestWasm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.wasm_storage.v1.Msg/RemoveDataRequestWasm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveDataRequestWasm(ctx, req.(*MsgRemoveDataRequestWasm))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveOverlayWasm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveOverlayWasm)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveOverlayWasm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.wasm_storage.v1.Msg/RemoveOverlayWasm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveOverlayWasm(ctx, req.(*MsgRemoveOverlayWasm))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ActivateOverlayWasm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgActivateOverlayWasm)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ActivateOverlayWasm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.wasm_storage.v1.Msg/ActivateOverlayWasm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ActivateOverlayWasm(ctx, req.(*MsgActivateOverlayWasm))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RollbackOverlayWasm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRollbackOverlayWasm)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RollbackOverlayWasm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.wasm_storage.v1.Msg/RollbackOverlayWasm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RollbackOverlayWasm(ctx, req.(*MsgRollbackOverlayWasm))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sedachain.wasm_storage.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sedachain.wasm_storage.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StoreDataRequestWasm",
+			Handler:    _Msg_StoreDataRequestWasm_Handler,
+		},
+		{
+			MethodName: "StoreOverlayWasm",
+			Handler:    _Msg_StoreOverlayWasm_Handler,
+		},
+		{
+			MethodName: "BeginWasmUpload",
+			Handler:    _Msg_BeginWasmUpload_Handler,
+		},
+		{
+			MethodName: "UploadWasmChunk",
+			Handler:    _Msg_UploadWasmChunk_Handler,
+		},
+		{
+			MethodName: "FinalizeWasmUpload",
+			Handler:    _Msg_FinalizeWasmUpload_Handler,
+		},
+		{
+			MethodName: "InstantiateAndRegisterProxyContract",
+			Handler:    _Msg_InstantiateAndRegisterProxyContract_Handler,
+		},
+		{
+			MethodName: "RegisterProxyContract",
+			Handler:    _Msg_RegisterProxyContract_Handler,
+		},
+		{
+			MethodName: "UpdateProxyContract",
+			Handler:    _Msg_UpdateProxyContract_Handler,
+		},
+		{
+			MethodName: "RemoveProxyContract",
+			Handler:    _Msg_RemoveProxyContract_Handler,
+		},
+		{
+			MethodName: "RemoveDataRequestWasm",
+			Handler:    _Msg_RemoveDataRequestWasm_Handler,
+		},
+		{
+			MethodName: "RemoveOverlayWasm",
+			Handler:    _Msg_RemoveOverlayWasm_Handler,
+		},
+		{
+			MethodName: "ActivateOverlayWasm",
+			Handler:    _Msg_ActivateOverlayWasm_Handler,
+		},
+		{
+			MethodName: "RollbackOverlayWasm",
+			Handler:    _Msg_RollbackOverlayWasm_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sedachain/wasm_storage/v1/tx.proto",
+}
+
+func (m *MsgStoreDataRequestWasm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStoreDataRequestWasm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStoreDataRequestWasm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WasmType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.WasmType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Wasm) > 0 {
+		i -= len(m.Wasm)
+		copy(dAtA[i:], m.Wasm)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Wasm)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStoreDataRequestWasmResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStoreDataRequestWasmResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStoreDataRequestWasmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Format != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Format))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStoreOverlayWasm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStoreOverlayWasm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStoreOverlayWasm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WasmType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.WasmType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Wasm) > 0 {
+		i -= len(m.Wasm)
+		copy(dAtA[i:], m.Wasm)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Wasm)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStoreOverlayWasmResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStoreOverlayWasmResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStoreOverlayWasmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Format != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Format))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBeginWasmUpload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBeginWasmUpload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBeginWasmUpload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WasmType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.WasmType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UploadSize != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UploadSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBeginWasmUploadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBeginWasmUploadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBeginWasmUploadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUploadWasmChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUploadWasmChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUploadWasmChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Chunk) > 0 {
+		i -= len(m.Chunk)
+		copy(dAtA[i:], m.Chunk)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Chunk)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Index != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUploadWasmChunkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUploadWasmChunkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUploadWasmChunkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Received != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Received))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFinalizeWasmUpload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFinalizeWasmUpload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFinalizeWasmUpload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFinalizeWasmUploadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFinalizeWasmUploadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFinalizeWasmUploadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Format != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Format))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInstantiateAndRegisterProxyContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInstantiateAndRegisterProxyContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstantiateAndRegisterProxyContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.FixMsg {
+		i--
+		if m.FixMsg {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Funds) > 0 {
+		for iNdEx := len(m.Funds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Funds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInstantiateAndRegisterProxyContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInstantiateAndRegisterProxyContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstantiateAndRegisterProxyContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterProxyContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterProxyContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterProxyContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterProxyContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterProxyContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterProxyContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateProxyContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateProxyContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateProxyContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateProxyContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateProxyContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateProxyContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveProxyContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveProxyContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveProxyContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveProxyContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveProxyContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveProxyContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveDataRequestWasm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveDataRequestWasm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveDataRequestWasm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveDataRequestWasmResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveDataRequestWasmResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveDataRequestWasmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveOverlayWasm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveOverlayWasm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveOverlayWasm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveOverlayWasmResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveOverlayWasmResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveOverlayWasmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgActivateOverlayWasm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgActivateOverlayWasm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgActivateOverlayWasm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgActivateOverlayWasmResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgActivateOverlayWasmResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgActivateOverlayWasmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRollbackOverlayWasm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRollbackOverlayWasm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRollbackOverlayWasm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WasmType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.WasmType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRollbackOverlayWasmResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRollbackOverlayWasmResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRollbackOverlayWasmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgStoreDataRequestWasm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Wasm)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.WasmType != 0 {
+		n += 1 + sovTx(uint64(m.WasmType))
+	}
+	return n
+}
+
+func (m *MsgStoreDataRequestWasmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Format != 0 {
+		n += 1 + sovTx(uint64(m.Format))
+	}
+	return n
+}
+
+func (m *MsgStoreOverlayWasm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Wasm)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.WasmType != 0 {
+		n += 1 + sovTx(uint64(m.WasmType))
+	}
+	return n
+}
+
+func (m *MsgStoreOverlayWasmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Format != 0 {
+		n += 1 + sovTx(uint64(m.Format))
+	}
+	return n
+}
+
+func (m *MsgBeginWasmUpload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UploadSize != 0 {
+		n += 1 + sovTx(uint64(m.UploadSize))
+	}
+	if m.WasmType != 0 {
+		n += 1 + sovTx(uint64(m.WasmType))
+	}
+	return n
+}
+
+func (m *MsgBeginWasmUploadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpirationHeight))
+	}
+	return n
+}
+
+func (m *MsgUploadWasmChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovTx(uint64(m.Index))
+	}
+	l = len(m.Chunk)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUploadWasmChunkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Received != 0 {
+		n += 1 + sovTx(uint64(m.Received))
+	}
+	return n
+}
+
+func (m *MsgFinalizeWasmUpload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFinalizeWasmUploadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Format != 0 {
+		n += 1 + sovTx(uint64(m.Format))
+	}
+	return n
+}
+
+func (m *MsgInstantiateAndRegisterProxyContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CodeID != 0 {
+		n += 1 + sovTx(uint64(m.CodeID))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
 	l = len(m.Msg)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if len(m.Funds) > 0 {
-		for _, e := range m.Funds {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.Funds) > 0 {
+		for _, e := range m.Funds {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FixMsg {
+		n += 2
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgInstantiateAndRegisterProxyContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterProxyContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterProxyContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateProxyContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateProxyContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveProxyContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveProxyContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveDataRequestWasm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveDataRequestWasmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveOverlayWasm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveOverlayWasmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgActivateOverlayWasm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovTx(uint64(m.ActivationHeight))
+	}
+	return n
+}
+
+func (m *MsgActivateOverlayWasmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		n += 1 + sovTx(uint64(m.ActivationHeight))
+	}
+	return n
+}
+
+func (m *MsgRollbackOverlayWasm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.WasmType != 0 {
+		n += 1 + sovTx(uint64(m.WasmType))
+	}
+	return n
+}
+
+func (m *MsgRollbackOverlayWasmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgStoreDataRequestWasm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStoreDataRequestWasm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStoreDataRequestWasm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wasm", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Wasm = append(m.Wasm[:0], dAtA[iNdEx:postIndex]...)
+			if m.Wasm == nil {
+				m.Wasm = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WasmType", wireType)
+			}
+			m.WasmType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WasmType |= WasmType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStoreDataRequestWasmResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStoreDataRequestWasmResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStoreDataRequestWasmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			m.Format = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Format |= WasmFormat(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStoreOverlayWasm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStoreOverlayWasm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStoreOverlayWasm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wasm", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Wasm = append(m.Wasm[:0], dAtA[iNdEx:postIndex]...)
+			if m.Wasm == nil {
+				m.Wasm = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WasmType", wireType)
+			}
+			m.WasmType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WasmType |= WasmType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStoreOverlayWasmResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStoreOverlayWasmResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStoreOverlayWasmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			m.Format = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Format |= WasmFormat(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBeginWasmUpload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBeginWasmUpload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBeginWasmUpload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadSize", wireType)
+			}
+			m.UploadSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WasmType", wireType)
+			}
+			m.WasmType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WasmType |= WasmType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBeginWasmUploadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBeginWasmUploadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBeginWasmUploadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+			}
+			m.ExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUploadWasmChunk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUploadWasmChunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUploadWasmChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chunk = append(m.Chunk[:0], dAtA[iNdEx:postIndex]...)
+			if m.Chunk == nil {
+				m.Chunk = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUploadWasmChunkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUploadWasmChunkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUploadWasmChunkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
+			}
+			m.Received = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Received |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFinalizeWasmUpload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFinalizeWasmUpload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFinalizeWasmUpload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFinalizeWasmUploadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFinalizeWasmUploadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFinalizeWasmUploadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			m.Format = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Format |= WasmFormat(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInstantiateAndRegisterProxyContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstantiateAndRegisterProxyContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstantiateAndRegisterProxyContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funds = append(m.Funds, types.Coin{})
+			if err := m.Funds[len(m.Funds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Salt == nil {
+				m.Salt = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixMsg", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FixMsg = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInstantiateAndRegisterProxyContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstantiateAndRegisterProxyContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstantiateAndRegisterProxyContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterProxyContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterProxyContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterProxyContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterProxyContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterProxyContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterProxyContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateProxyContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateProxyContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateProxyContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateProxyContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateProxyContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateProxyContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveProxyContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveProxyContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveProxyContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = len(m.Salt)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.FixMsg {
-		n += 2
-	}
-	return n
-}
 
-func (m *MsgInstantiateAndRegisterProxyContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgRemoveProxyContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveProxyContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveProxyContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgStoreDataRequestWasm) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveDataRequestWasm) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1267,15 +5894,15 @@ func (m *MsgStoreDataRequestWasm) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStoreDataRequestWasm: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveDataRequestWasm: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStoreDataRequestWasm: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveDataRequestWasm: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1303,13 +5930,13 @@ func (m *MsgStoreDataRequestWasm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wasm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1319,45 +5946,74 @@ func (m *MsgStoreDataRequestWasm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Wasm = append(m.Wasm[:0], dAtA[iNdEx:postIndex]...)
-			if m.Wasm == nil {
-				m.Wasm = []byte{}
-			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WasmType", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.WasmType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WasmType |= WasmType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveDataRequestWasmResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveDataRequestWasmResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveDataRequestWasmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1379,7 +6035,7 @@ func (m *MsgStoreDataRequestWasm) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStoreDataRequestWasmResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveOverlayWasm) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1402,13 +6058,45 @@ func (m *MsgStoreDataRequestWasmResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStoreDataRequestWasmResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveOverlayWasm: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStoreDataRequestWasmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveOverlayWasm: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
@@ -1461,7 +6149,57 @@ func (m *MsgStoreDataRequestWasmResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStoreOverlayWasm) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveOverlayWasmResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveOverlayWasmResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveOverlayWasmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgActivateOverlayWasm) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1484,15 +6222,15 @@ func (m *MsgStoreOverlayWasm) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStoreOverlayWasm: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgActivateOverlayWasm: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStoreOverlayWasm: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgActivateOverlayWasm: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1520,13 +6258,13 @@ func (m *MsgStoreOverlayWasm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wasm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1536,31 +6274,29 @@ func (m *MsgStoreOverlayWasm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Wasm = append(m.Wasm[:0], dAtA[iNdEx:postIndex]...)
-			if m.Wasm == nil {
-				m.Wasm = []byte{}
-			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WasmType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
 			}
-			m.WasmType = 0
+			m.ActivationHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1570,7 +6306,7 @@ func (m *MsgStoreOverlayWasm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WasmType |= WasmType(b&0x7F) << shift
+				m.ActivationHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1596,7 +6332,7 @@ func (m *MsgStoreOverlayWasm) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStoreOverlayWasmResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgActivateOverlayWasmResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1619,17 +6355,17 @@ func (m *MsgStoreOverlayWasmResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStoreOverlayWasmResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgActivateOverlayWasmResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStoreOverlayWasmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgActivateOverlayWasmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
 			}
-			var stringLen uint64
+			m.ActivationHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1639,24 +6375,11 @@ func (m *MsgStoreOverlayWasmResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ActivationHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1678,7 +6401,7 @@ func (m *MsgStoreOverlayWasmResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInstantiateAndRegisterProxyContract) Unmarshal(dAtA []byte) error {
+func (m *MsgRollbackOverlayWasm) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1701,15 +6424,15 @@ func (m *MsgInstantiateAndRegisterProxyContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInstantiateAndRegisterProxyContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRollbackOverlayWasm: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInstantiateAndRegisterProxyContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRollbackOverlayWasm: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1737,198 +6460,13 @@ func (m *MsgInstantiateAndRegisterProxyContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
-			}
-			m.CodeID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CodeID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Label = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
-			if m.Msg == nil {
-				m.Msg = []byte{}
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Funds = append(m.Funds, types.Coin{})
-			if err := m.Funds[len(m.Funds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
-			if m.Salt == nil {
-				m.Salt = []byte{}
-			}
-			iNdEx = postIndex
-		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FixMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WasmType", wireType)
 			}
-			var v int
+			m.WasmType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1938,12 +6476,11 @@ func (m *MsgInstantiateAndRegisterProxyContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.WasmType |= WasmType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.FixMsg = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1965,7 +6502,7 @@ func (m *MsgInstantiateAndRegisterProxyContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInstantiateAndRegisterProxyContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRollbackOverlayWasmResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1988,15 +6525,15 @@ func (m *MsgInstantiateAndRegisterProxyContractResponse) Unmarshal(dAtA []byte)
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInstantiateAndRegisterProxyContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRollbackOverlayWasmResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInstantiateAndRegisterProxyContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRollbackOverlayWasmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2024,7 +6561,7 @@ func (m *MsgInstantiateAndRegisterProxyContractResponse) Unmarshal(dAtA []byte)
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
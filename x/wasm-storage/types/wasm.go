@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/hex"
 	fmt "fmt"
 	"strings"
 	"time"
@@ -9,16 +10,40 @@ import (
 )
 
 const (
-	// MaxWasmSize is the maximum size of Wasm bytecode.
-	MaxWasmSize = 800 * 1024
+	// WasmHashLength is the length of keccak256 hash of Wasm bytecode.
+	WasmHashLength = 32
+
+	// MaxBytecodeChunkSize is the maximum number of bytecode bytes
+	// returned by a single WasmBytecode query.
+	MaxBytecodeChunkSize = 256 * 1024
+
+	// MaxWasmUploadChunkSize is the maximum size of a single chunk of a
+	// chunked upload.
+	MaxWasmUploadChunkSize = 128 * 1024
+
+	// WasmUploadExpiryBlocks is the number of blocks after which a
+	// chunked upload is discarded unless it has been finalized.
+	WasmUploadExpiryBlocks = 1000
 )
 
+// validateWasmCode checks that a given Wasm code is not empty. Its size
+// is checked against the max Wasm size parameter.
 func validateWasmCode(s []byte) error {
 	if len(s) == 0 {
 		return fmt.Errorf("empty Wasm code")
 	}
-	if len(s) > MaxWasmSize {
-		return fmt.Errorf("Wasm code cannot be longer than %d bytes", MaxWasmSize)
+	return nil
+}
+
+// validateWasmHash checks that a given string is a hex-encoded
+// keccak256 hash.
+func validateWasmHash(hash string) error {
+	bz, err := hex.DecodeString(hash)
+	if err != nil {
+		return fmt.Errorf("invalid hash: %s", err)
+	}
+	if len(bz) != WasmHashLength {
+		return fmt.Errorf("invalid hash length: expected %d bytes, got %d", WasmHashLength, len(bz))
 	}
 	return nil
 }
@@ -36,9 +61,20 @@ func NewWasm(bytecode []byte, wasmType WasmType, addedAt time.Time) *Wasm {
 		Bytecode: bytecode,
 		WasmType: wasmType,
 		AddedAt:  addedAt,
+		Size_:    uint64(len(bytecode)),
 	}
 }
 
+// IsDataRequest checks if the Wasm type is stored as a Data Request Wasm.
+func (t WasmType) IsDataRequest() bool {
+	return t == WasmTypeDataRequest || t == WasmTypeTally
+}
+
+// IsOverlay checks if the Wasm type is stored as an Overlay Wasm.
+func (t WasmType) IsOverlay() bool {
+	return t == WasmTypeDataRequestExecutor || t == WasmTypeRelayer
+}
+
 func WasmTypeFromString(s string) WasmType {
 	switch strings.ToUpper(s) {
 	case "DATA-REQUEST":
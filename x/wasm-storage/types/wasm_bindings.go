@@ -0,0 +1,65 @@
+package types
+
+// WasmStorageQuery is the custom query that CosmWasm contracts can send
+// to the wasm-storage module under the "wasm_storage" key of a custom
+// query. Exactly one of its fields must be set.
+type WasmStorageQuery struct {
+	DataRequestWasmExists *DataRequestWasmExistsQuery `json:"data_request_wasm_exists,omitempty"`
+	WasmInfo              *WasmInfoQuery              `json:"wasm_info,omitempty"`
+	ProxyContract         *ProxyContractQuery         `json:"proxy_contract,omitempty"`
+}
+
+// DataRequestWasmExistsQuery checks if a Data Request Wasm with a given
+// hex-encoded hash exists.
+type DataRequestWasmExistsQuery struct {
+	Hash string `json:"hash"`
+}
+
+// DataRequestWasmExistsResponse is the response to a
+// DataRequestWasmExistsQuery. The Wasm type is only set if the Data
+// Request Wasm exists.
+type DataRequestWasmExistsResponse struct {
+	Exists   bool   `json:"exists"`
+	WasmType string `json:"wasm_type,omitempty"`
+}
+
+// WasmInfoQuery requests information about a Data Request Wasm or an
+// Overlay Wasm with a given hex-encoded hash.
+type WasmInfoQuery struct {
+	Hash string `json:"hash"`
+}
+
+// WasmInfoResponse is the response to a WasmInfoQuery. The bytecode
+// itself is left out to keep the response small.
+type WasmInfoResponse struct {
+	Hash     string `json:"hash"`
+	WasmType string `json:"wasm_type"`
+	Size     uint64 `json:"size"`
+	// AddedAt is the Unix time in seconds at which the Wasm was stored.
+	AddedAt int64 `json:"added_at"`
+}
+
+// ProxyContractQuery requests the address of a named Proxy Contract. An
+// empty name refers to the data request Proxy Contract.
+type ProxyContractQuery struct {
+	Name string `json:"name,omitempty"`
+}
+
+// ProxyContractResponse is the response to a ProxyContractQuery.
+type ProxyContractResponse struct {
+	Address string `json:"address"`
+}
+
+// WasmStorageMsg is the custom message that CosmWasm contracts can send
+// to the wasm-storage module under the "wasm_storage" key of a custom
+// query. Exactly one of its fields must be set.
+type WasmStorageMsg struct {
+	StoreDataRequestWasm *StoreDataRequestWasmMsg `json:"store_data_request_wasm,omitempty"`
+}
+
+// StoreDataRequestWasmMsg stores a Data Request Wasm on behalf of the
+// contract. The Wasm type is either data-request or tally.
+type StoreDataRequestWasmMsg struct {
+	Wasm     []byte `json:"wasm"`
+	WasmType string `json:"wasm_type"`
+}
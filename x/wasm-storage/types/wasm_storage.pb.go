@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
@@ -67,12 +68,51 @@ func (WasmType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_9a4bda463450c942, []int{0}
 }
 
+// WasmFormat is an enum for the encoding of an uploaded wasm.
+type WasmFormat int32
+
+const (
+	// An unspecified wasm encoding.
+	WasmFormatNil WasmFormat = 0
+	// An uncompressed wasm.
+	WasmFormatRaw WasmFormat = 1
+	// A gzip-compressed wasm.
+	WasmFormatGzip WasmFormat = 2
+	// A zstd-compressed wasm.
+	WasmFormatZstd WasmFormat = 3
+)
+
+var WasmFormat_name = map[int32]string{
+	0: "WASM_FORMAT_UNSPECIFIED",
+	1: "WASM_FORMAT_RAW",
+	2: "WASM_FORMAT_GZIP",
+	3: "WASM_FORMAT_ZSTD",
+}
+
+var WasmFormat_value = map[string]int32{
+	"WASM_FORMAT_UNSPECIFIED": 0,
+	"WASM_FORMAT_RAW":         1,
+	"WASM_FORMAT_GZIP":        2,
+	"WASM_FORMAT_ZSTD":        3,
+}
+
+func (x WasmFormat) String() string {
+	return proto.EnumName(WasmFormat_name, int32(x))
+}
+
+func (WasmFormat) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9a4bda463450c942, []int{1}
+}
+
 // A Wasm msg.
 type Wasm struct {
 	Hash     []byte    `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 	Bytecode []byte    `protobuf:"bytes,2,opt,name=bytecode,proto3" json:"bytecode,omitempty"`
 	WasmType WasmType  `protobuf:"varint,3,opt,name=wasm_type,json=wasmType,proto3,enum=sedachain.wasm_storage.v1.WasmType" json:"wasm_type,omitempty"`
 	AddedAt  time.Time `protobuf:"bytes,4,opt,name=added_at,json=addedAt,proto3,stdtime" json:"added_at"`
+	// size is the length of the bytecode in bytes, stored alongside the
+	// metadata so that it can be read without loading the bytecode.
+	Size_ uint64 `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
 }
 
 func (m *Wasm) Reset()         { *m = Wasm{} }
@@ -136,16 +176,308 @@ func (m *Wasm) GetAddedAt() time.Time {
 	return time.Time{}
 }
 
-// Params to define the max wasm size allowed.
+func (m *Wasm) GetSize_() uint64 {
+	if m != nil {
+		return m.Size_
+	}
+	return 0
+}
+
+// OverlayVersion points to the Overlay Wasm that overlay nodes of a given
+// type should run starting from a given block height.
+type OverlayVersion struct {
+	Hash             []byte   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	WasmType         WasmType `protobuf:"varint,2,opt,name=wasm_type,json=wasmType,proto3,enum=sedachain.wasm_storage.v1.WasmType" json:"wasm_type,omitempty"`
+	ActivationHeight int64    `protobuf:"varint,3,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+}
+
+func (m *OverlayVersion) Reset()         { *m = OverlayVersion{} }
+func (m *OverlayVersion) String() string { return proto.CompactTextString(m) }
+func (*OverlayVersion) ProtoMessage()    {}
+func (*OverlayVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a4bda463450c942, []int{1}
+}
+func (m *OverlayVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OverlayVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OverlayVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OverlayVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OverlayVersion.Merge(m, src)
+}
+func (m *OverlayVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *OverlayVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_OverlayVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OverlayVersion proto.InternalMessageInfo
+
+func (m *OverlayVersion) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *OverlayVersion) GetWasmType() WasmType {
+	if m != nil {
+		return m.WasmType
+	}
+	return WasmTypeNil
+}
+
+func (m *OverlayVersion) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+// ProxyContract is a named entry of the Proxy Contract registry.
+type ProxyContract struct {
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *ProxyContract) Reset()         { *m = ProxyContract{} }
+func (m *ProxyContract) String() string { return proto.CompactTextString(m) }
+func (*ProxyContract) ProtoMessage()    {}
+func (*ProxyContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a4bda463450c942, []int{2}
+}
+func (m *ProxyContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProxyContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProxyContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProxyContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProxyContract.Merge(m, src)
+}
+func (m *ProxyContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProxyContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProxyContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProxyContract proto.InternalMessageInfo
+
+func (m *ProxyContract) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ProxyContract) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// ProxyContractChange records a change to a named entry of the Proxy
+// Contract registry. An empty address denotes the removal of the entry.
+type ProxyContractChange struct {
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Height  int64  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ProxyContractChange) Reset()         { *m = ProxyContractChange{} }
+func (m *ProxyContractChange) String() string { return proto.CompactTextString(m) }
+func (*ProxyContractChange) ProtoMessage()    {}
+func (*ProxyContractChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a4bda463450c942, []int{3}
+}
+func (m *ProxyContractChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProxyContractChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProxyContractChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProxyContractChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProxyContractChange.Merge(m, src)
+}
+func (m *ProxyContractChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProxyContractChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProxyContractChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProxyContractChange proto.InternalMessageInfo
+
+func (m *ProxyContractChange) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ProxyContractChange) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ProxyContractChange) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// WasmUpload is a pending chunked upload of a Wasm whose chunks are
+// stored separately until the upload is finalized or expires.
+type WasmUpload struct {
+	// hash is the keccak256 hash of the uncompressed bytecode.
+	Hash     []byte   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Uploader string   `protobuf:"bytes,2,opt,name=uploader,proto3" json:"uploader,omitempty"`
+	WasmType WasmType `protobuf:"varint,3,opt,name=wasm_type,json=wasmType,proto3,enum=sedachain.wasm_storage.v1.WasmType" json:"wasm_type,omitempty"`
+	// upload_size is the expected total size of the uploaded chunks in bytes.
+	UploadSize uint64 `protobuf:"varint,4,opt,name=upload_size,json=uploadSize,proto3" json:"upload_size,omitempty"`
+	// received is the total size of the chunks received so far in bytes.
+	Received uint64 `protobuf:"varint,5,opt,name=received,proto3" json:"received,omitempty"`
+	// chunks is the number of chunks received so far.
+	Chunks uint64 `protobuf:"varint,6,opt,name=chunks,proto3" json:"chunks,omitempty"`
+	// expiration_height is the block height at which the upload is
+	// discarded unless it has been finalized.
+	ExpirationHeight int64 `protobuf:"varint,7,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+}
+
+func (m *WasmUpload) Reset()         { *m = WasmUpload{} }
+func (m *WasmUpload) String() string { return proto.CompactTextString(m) }
+func (*WasmUpload) ProtoMessage()    {}
+func (*WasmUpload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a4bda463450c942, []int{4}
+}
+func (m *WasmUpload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WasmUpload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WasmUpload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WasmUpload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WasmUpload.Merge(m, src)
+}
+func (m *WasmUpload) XXX_Size() int {
+	return m.Size()
+}
+func (m *WasmUpload) XXX_DiscardUnknown() {
+	xxx_messageInfo_WasmUpload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WasmUpload proto.InternalMessageInfo
+
+func (m *WasmUpload) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *WasmUpload) GetUploader() string {
+	if m != nil {
+		return m.Uploader
+	}
+	return ""
+}
+
+func (m *WasmUpload) GetWasmType() WasmType {
+	if m != nil {
+		return m.WasmType
+	}
+	return WasmTypeNil
+}
+
+func (m *WasmUpload) GetUploadSize() uint64 {
+	if m != nil {
+		return m.UploadSize
+	}
+	return 0
+}
+
+func (m *WasmUpload) GetReceived() uint64 {
+	if m != nil {
+		return m.Received
+	}
+	return 0
+}
+
+func (m *WasmUpload) GetChunks() uint64 {
+	if m != nil {
+		return m.Chunks
+	}
+	return 0
+}
+
+func (m *WasmUpload) GetExpirationHeight() int64 {
+	if m != nil {
+		return m.ExpirationHeight
+	}
+	return 0
+}
+
+// Params to define the max wasm size allowed and the constraints on proxy
+// contracts.
 type Params struct {
 	MaxWasmSize uint64 `protobuf:"varint,1,opt,name=max_wasm_size,json=maxWasmSize,proto3" json:"max_wasm_size,omitempty"`
+	// proxy_contract_code_ids lists the CosmWasm code IDs of contracts that
+	// may be registered as proxy contracts. An empty list allows any code ID.
+	ProxyContractCodeIDs []uint64 `protobuf:"varint,2,rep,packed,name=proxy_contract_code_ids,json=proxyContractCodeIds,proto3" json:"proxy_contract_code_ids,omitempty"`
+	// proxy_contract_admin is the address that must be the CosmWasm admin of
+	// every proxy contract, typically the gov module account or a multisig.
+	// An empty address allows any admin.
+	ProxyContractAdmin string `protobuf:"bytes,3,opt,name=proxy_contract_admin,json=proxyContractAdmin,proto3" json:"proxy_contract_admin,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a4bda463450c942, []int{1}
+	return fileDescriptor_9a4bda463450c942, []int{5}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -181,9 +513,28 @@ func (m *Params) GetMaxWasmSize() uint64 {
 	return 0
 }
 
+func (m *Params) GetProxyContractCodeIDs() []uint64 {
+	if m != nil {
+		return m.ProxyContractCodeIDs
+	}
+	return nil
+}
+
+func (m *Params) GetProxyContractAdmin() string {
+	if m != nil {
+		return m.ProxyContractAdmin
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("sedachain.wasm_storage.v1.WasmType", WasmType_name, WasmType_value)
+	proto.RegisterEnum("sedachain.wasm_storage.v1.WasmFormat", WasmFormat_name, WasmFormat_value)
 	proto.RegisterType((*Wasm)(nil), "sedachain.wasm_storage.v1.Wasm")
+	proto.RegisterType((*OverlayVersion)(nil), "sedachain.wasm_storage.v1.OverlayVersion")
+	proto.RegisterType((*ProxyContract)(nil), "sedachain.wasm_storage.v1.ProxyContract")
+	proto.RegisterType((*ProxyContractChange)(nil), "sedachain.wasm_storage.v1.ProxyContractChange")
+	proto.RegisterType((*WasmUpload)(nil), "sedachain.wasm_storage.v1.WasmUpload")
 	proto.RegisterType((*Params)(nil), "sedachain.wasm_storage.v1.Params")
 }
 
@@ -192,39 +543,62 @@ func init() {
 }
 
 var fileDescriptor_9a4bda463450c942 = []byte{
-	// 513 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x4f, 0x6e, 0xd3, 0x40,
-	0x18, 0xc5, 0x3d, 0xad, 0x55, 0xc2, 0x84, 0x92, 0x74, 0xca, 0x9f, 0x30, 0x48, 0xb6, 0x15, 0x24,
-	0x14, 0x45, 0xd4, 0x56, 0xd3, 0x05, 0x12, 0x1b, 0x70, 0x9b, 0x41, 0xaa, 0x14, 0x4a, 0xea, 0x38,
-	0x2a, 0x61, 0x63, 0x4d, 0x92, 0xc1, 0xb1, 0x64, 0x33, 0x21, 0x9e, 0xb4, 0x49, 0x4f, 0x80, 0xc2,
-	0xa6, 0x17, 0x88, 0x84, 0xc4, 0x29, 0xb8, 0x41, 0x97, 0x5d, 0xb2, 0x02, 0x94, 0x6c, 0x38, 0x06,
-	0xca, 0x18, 0x27, 0xaa, 0x54, 0x76, 0xdf, 0xb3, 0x7f, 0xdf, 0xbc, 0x67, 0xcf, 0x83, 0xcf, 0x62,
-	0xd6, 0xa5, 0x9d, 0x1e, 0x0d, 0x3e, 0x5a, 0x67, 0x34, 0x8e, 0xbc, 0x58, 0xf0, 0x01, 0xf5, 0x99,
-	0x75, 0xba, 0x7b, 0x4d, 0x9b, 0xfd, 0x01, 0x17, 0x1c, 0x3d, 0x5a, 0xd2, 0xe6, 0xb5, 0xb7, 0xa7,
-	0xbb, 0xf8, 0x9e, 0xcf, 0x7d, 0x2e, 0x29, 0x6b, 0x31, 0x25, 0x0b, 0x58, 0xf7, 0x39, 0xf7, 0x43,
-	0x66, 0x49, 0xd5, 0x1e, 0x7e, 0xb0, 0x44, 0x10, 0xb1, 0x58, 0xd0, 0xa8, 0x9f, 0x00, 0xc5, 0xef,
-	0x00, 0xaa, 0x27, 0x34, 0x8e, 0x10, 0x82, 0x6a, 0x8f, 0xc6, 0xbd, 0x02, 0x30, 0x40, 0xe9, 0x8e,
-	0x23, 0x67, 0x84, 0x61, 0xa6, 0x3d, 0x16, 0xac, 0xc3, 0xbb, 0xac, 0xb0, 0x26, 0x9f, 0x2f, 0x35,
-	0x7a, 0x05, 0x6f, 0xcb, 0x08, 0x62, 0xdc, 0x67, 0x85, 0x75, 0x03, 0x94, 0xee, 0x56, 0x9e, 0x98,
-	0xff, 0x8d, 0x67, 0x2e, 0x3c, 0xdc, 0x71, 0x9f, 0x39, 0x99, 0xb3, 0x7f, 0x13, 0x7a, 0x09, 0x33,
-	0xb4, 0xdb, 0x65, 0x5d, 0x8f, 0x8a, 0x82, 0x6a, 0x80, 0x52, 0xb6, 0x82, 0xcd, 0x24, 0xae, 0x99,
-	0xc6, 0x35, 0xdd, 0x34, 0xee, 0x7e, 0xe6, 0xf2, 0xa7, 0xae, 0x5c, 0xfc, 0xd2, 0x81, 0x73, 0x4b,
-	0x6e, 0xd9, 0xa2, 0x58, 0x81, 0x1b, 0x75, 0x3a, 0xa0, 0x51, 0x8c, 0x8a, 0x70, 0x33, 0xa2, 0x23,
-	0x2f, 0x31, 0x0d, 0xce, 0x99, 0xfc, 0x0a, 0xd5, 0xc9, 0x46, 0x74, 0xb4, 0x30, 0x6e, 0x04, 0xe7,
-	0xec, 0x85, 0xfa, 0xe7, 0xab, 0x0e, 0xca, 0x5f, 0xd6, 0x60, 0x26, 0xcd, 0x82, 0xca, 0xf0, 0xfe,
-	0x89, 0xdd, 0x78, 0xe3, 0xb9, 0xad, 0x3a, 0xf1, 0x9a, 0x47, 0x8d, 0x3a, 0x39, 0x38, 0x7c, 0x7d,
-	0x48, 0xaa, 0x79, 0x05, 0xe7, 0x26, 0x53, 0x23, 0x9b, 0x82, 0x47, 0x41, 0x88, 0xf6, 0xe0, 0x83,
-	0x15, 0x5b, 0xb5, 0x5d, 0xdb, 0x73, 0xc8, 0x71, 0x93, 0x34, 0xdc, 0x3c, 0xc0, 0x0f, 0x27, 0x53,
-	0x63, 0x3b, 0x85, 0xab, 0x54, 0x50, 0x87, 0x7d, 0x1a, 0xb2, 0x58, 0xa0, 0xa7, 0x30, 0xb7, 0x5a,
-	0x72, 0xed, 0x5a, 0xad, 0x95, 0x5f, 0xc3, 0x5b, 0x93, 0xa9, 0xb1, 0x99, 0xd2, 0x2e, 0x0d, 0xc3,
-	0x31, 0xaa, 0x42, 0xfd, 0xe6, 0xc3, 0x3d, 0xf2, 0x8e, 0x1c, 0x34, 0xdd, 0xb7, 0x4e, 0x7e, 0x1d,
-	0xeb, 0x93, 0xa9, 0xf1, 0xf8, 0x06, 0x17, 0x32, 0x62, 0x9d, 0xa1, 0xe0, 0x03, 0x54, 0x86, 0x5b,
-	0xab, 0x53, 0x1c, 0x52, 0xb3, 0x5b, 0xc4, 0xc9, 0xab, 0x78, 0x7b, 0x32, 0x35, 0x72, 0xcb, 0xff,
-	0xcf, 0x42, 0x3a, 0x66, 0x03, 0xac, 0x7e, 0xfe, 0xa6, 0x29, 0xfb, 0xc7, 0x97, 0x33, 0x0d, 0x5c,
-	0xcd, 0x34, 0xf0, 0x7b, 0xa6, 0x81, 0x8b, 0xb9, 0xa6, 0x5c, 0xcd, 0x35, 0xe5, 0xc7, 0x5c, 0x53,
-	0xde, 0x3f, 0xf7, 0x03, 0xd1, 0x1b, 0xb6, 0xcd, 0x0e, 0x8f, 0xac, 0xc5, 0xad, 0xca, 0x1b, 0xe9,
-	0xf0, 0x50, 0x8a, 0x9d, 0xa4, 0xb0, 0x23, 0x59, 0xd1, 0x9d, 0xb4, 0xb2, 0x8b, 0x26, 0xc4, 0xed,
-	0x0d, 0x49, 0xee, 0xfd, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x99, 0xad, 0x8f, 0x9a, 0xd9, 0x02, 0x00,
+	// 881 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0x13, 0xd3, 0x4d, 0xa7, 0xb4, 0x75, 0xa7, 0x61, 0xeb, 0x35, 0x52, 0x62, 0x05, 0x09,
+	0x45, 0x85, 0x3a, 0xda, 0x2e, 0x12, 0x12, 0x17, 0x70, 0x1b, 0x77, 0x29, 0xea, 0x6e, 0xb3, 0x8e,
+	0x4b, 0x69, 0x2f, 0xd6, 0xd4, 0x1e, 0x1c, 0x8b, 0xd8, 0x63, 0x3c, 0x93, 0x34, 0xe9, 0x27, 0x40,
+	0xe1, 0xb2, 0x12, 0x37, 0xa4, 0x48, 0x48, 0x7c, 0x05, 0xce, 0x9c, 0xf7, 0x82, 0x58, 0x71, 0xe2,
+	0xb4, 0xa0, 0xf6, 0xc2, 0xc7, 0x40, 0x1e, 0xdb, 0x09, 0x09, 0x45, 0x2b, 0xa1, 0xde, 0xde, 0x9f,
+	0xdf, 0x3c, 0xff, 0xde, 0xfb, 0xbd, 0xf1, 0x80, 0xf7, 0x29, 0x76, 0x91, 0xd3, 0x45, 0x7e, 0xd8,
+	0xbc, 0x44, 0x34, 0xb0, 0x29, 0x23, 0x31, 0xf2, 0x70, 0x73, 0xf0, 0x70, 0xce, 0xd7, 0xa2, 0x98,
+	0x30, 0x02, 0x1f, 0x4c, 0xd1, 0xda, 0x5c, 0x76, 0xf0, 0x50, 0x79, 0xe0, 0x10, 0x1a, 0x10, 0x6a,
+	0x73, 0x60, 0x33, 0x75, 0xd2, 0x53, 0x4a, 0xc5, 0x23, 0x1e, 0x49, 0xe3, 0x89, 0x95, 0x45, 0x6b,
+	0x1e, 0x21, 0x5e, 0x0f, 0x37, 0xb9, 0x77, 0xd1, 0xff, 0xb2, 0xc9, 0xfc, 0x00, 0x53, 0x86, 0x82,
+	0x28, 0x05, 0xd4, 0x7f, 0x11, 0x80, 0x78, 0x8a, 0x68, 0x00, 0x21, 0x10, 0xbb, 0x88, 0x76, 0x65,
+	0x41, 0x15, 0x1a, 0x6f, 0x9a, 0xdc, 0x86, 0x0a, 0x28, 0x5f, 0x8c, 0x18, 0x76, 0x88, 0x8b, 0xe5,
+	0x22, 0x8f, 0x4f, 0x7d, 0xf8, 0x09, 0x58, 0xe6, 0xec, 0xd8, 0x28, 0xc2, 0x72, 0x49, 0x15, 0x1a,
+	0x6b, 0xbb, 0xef, 0x68, 0xff, 0xc9, 0x5c, 0x4b, 0xbe, 0x61, 0x8d, 0x22, 0x6c, 0x96, 0x2f, 0x33,
+	0x0b, 0x7e, 0x0c, 0xca, 0xc8, 0x75, 0xb1, 0x6b, 0x23, 0x26, 0x8b, 0xaa, 0xd0, 0x58, 0xd9, 0x55,
+	0xb4, 0x94, 0xae, 0x96, 0xd3, 0xd5, 0xac, 0x9c, 0xee, 0x5e, 0xf9, 0xc5, 0xab, 0x5a, 0xe1, 0xf9,
+	0x1f, 0x35, 0xc1, 0xbc, 0xc7, 0x4f, 0xe9, 0x2c, 0xa1, 0x4c, 0xfd, 0x2b, 0x2c, 0xbf, 0xa1, 0x0a,
+	0x0d, 0xd1, 0xe4, 0x76, 0xfd, 0x3b, 0x01, 0xac, 0x1d, 0x0f, 0x70, 0xdc, 0x43, 0xa3, 0xcf, 0x71,
+	0x4c, 0x7d, 0x12, 0xde, 0xda, 0xd9, 0x1c, 0xfb, 0xe2, 0xff, 0x61, 0xff, 0x1e, 0xd8, 0x40, 0x0e,
+	0xf3, 0x07, 0x88, 0xf9, 0x24, 0xb4, 0xbb, 0xd8, 0xf7, 0xba, 0x8c, 0xcf, 0xa1, 0x64, 0x4a, 0xb3,
+	0xc4, 0xa7, 0x3c, 0x5e, 0x3f, 0x05, 0xab, 0xed, 0x98, 0x0c, 0x47, 0xfb, 0x24, 0x64, 0x31, 0x72,
+	0x38, 0xf5, 0x10, 0x05, 0x98, 0x73, 0x5a, 0x36, 0xb9, 0x0d, 0x77, 0x41, 0xd2, 0x59, 0x8c, 0x29,
+	0xe5, 0x8c, 0x96, 0xf7, 0xe4, 0xdf, 0x7e, 0xda, 0xa9, 0x64, 0x22, 0xeb, 0x69, 0xa6, 0xc3, 0x62,
+	0x3f, 0xf4, 0xcc, 0x1c, 0x58, 0xef, 0x83, 0xcd, 0xb9, 0xc2, 0xfb, 0x5d, 0x14, 0x7a, 0xf8, 0xae,
+	0xca, 0xc3, 0xfb, 0x60, 0x69, 0xae, 0xb3, 0xcc, 0xab, 0x7f, 0x5f, 0x04, 0x20, 0x99, 0xc9, 0x49,
+	0xd4, 0x23, 0xc8, 0xbd, 0x75, 0xc2, 0x1f, 0x80, 0x72, 0x9f, 0x67, 0x71, 0xfc, 0xda, 0xef, 0x4d,
+	0x91, 0x77, 0xb0, 0x55, 0x35, 0xb0, 0x92, 0x56, 0xb3, 0xf9, 0x6e, 0x88, 0x7c, 0x37, 0x40, 0x1a,
+	0xea, 0xf8, 0x57, 0x38, 0x59, 0xea, 0x18, 0x3b, 0xd8, 0x1f, 0x60, 0x37, 0xdb, 0x9c, 0xa9, 0x9f,
+	0xf4, 0xeb, 0x74, 0xfb, 0xe1, 0x57, 0x54, 0x5e, 0xe2, 0x99, 0xcc, 0x4b, 0xc4, 0xc6, 0xc3, 0xc8,
+	0x8f, 0xe7, 0xc4, 0xbe, 0x97, 0x8a, 0x3d, 0x4b, 0x64, 0x62, 0xff, 0x2a, 0x80, 0xa5, 0x36, 0x8a,
+	0x51, 0x40, 0x61, 0x1d, 0xac, 0x06, 0x68, 0x68, 0xa7, 0xb4, 0x13, 0x3a, 0x02, 0x2f, 0xbb, 0x12,
+	0xa0, 0x61, 0x42, 0x9d, 0xf3, 0x39, 0x06, 0x5b, 0x51, 0x22, 0xa1, 0xed, 0x64, 0x1a, 0xda, 0xc9,
+	0xfd, 0xb2, 0x7d, 0x37, 0xd1, 0xa9, 0xd4, 0x10, 0xf7, 0xe4, 0xeb, 0x57, 0xb5, 0xca, 0xbc, 0xca,
+	0xc4, 0xc5, 0x87, 0x2d, 0x6a, 0x56, 0xa2, 0x7f, 0x45, 0x5d, 0x0a, 0x3f, 0x03, 0x95, 0x85, 0x82,
+	0xc8, 0x0d, 0xfc, 0x50, 0x2e, 0xbd, 0x46, 0x05, 0x38, 0x57, 0x4d, 0x4f, 0xce, 0x7c, 0x24, 0xfe,
+	0xf5, 0x43, 0x4d, 0xd8, 0xfe, 0xb6, 0x08, 0xca, 0xf9, 0xa8, 0xe1, 0x36, 0x78, 0xeb, 0x54, 0xef,
+	0x3c, 0xb1, 0xad, 0xb3, 0xb6, 0x61, 0x9f, 0x3c, 0xed, 0xb4, 0x8d, 0xfd, 0xc3, 0x83, 0x43, 0xa3,
+	0x25, 0x15, 0x94, 0xf5, 0xf1, 0x44, 0x5d, 0xc9, 0x81, 0x4f, 0xfd, 0x1e, 0x7c, 0x04, 0xee, 0xcf,
+	0xb0, 0x2d, 0xdd, 0xd2, 0x6d, 0xd3, 0x78, 0x76, 0x62, 0x74, 0x2c, 0x49, 0x50, 0xb6, 0xc6, 0x13,
+	0x75, 0x33, 0x07, 0xb7, 0x10, 0x43, 0x26, 0xfe, 0xba, 0x8f, 0x29, 0x83, 0xef, 0x82, 0xf5, 0xd9,
+	0x21, 0x4b, 0x3f, 0x3a, 0x3a, 0x93, 0x8a, 0xca, 0xc6, 0x78, 0xa2, 0xae, 0xe6, 0x68, 0x0b, 0xf5,
+	0x7a, 0x23, 0xd8, 0x02, 0xb5, 0xdb, 0x8b, 0xdb, 0xc6, 0x17, 0xc6, 0xfe, 0x89, 0x75, 0x6c, 0x4a,
+	0x25, 0xa5, 0x36, 0x9e, 0xa8, 0x6f, 0xdf, 0xf2, 0x15, 0x63, 0x88, 0x9d, 0x3e, 0x23, 0x31, 0xdc,
+	0x06, 0x1b, 0xb3, 0x2a, 0xa6, 0x71, 0xa4, 0x9f, 0x19, 0xa6, 0x24, 0x2a, 0x9b, 0xe3, 0x89, 0xba,
+	0x3e, 0x5d, 0x2f, 0xdc, 0x43, 0x23, 0x1c, 0x2b, 0xe2, 0x37, 0x3f, 0x56, 0x0b, 0xdb, 0x3f, 0x0b,
+	0xe9, 0xf2, 0x1f, 0x90, 0x38, 0x40, 0x0c, 0x6a, 0x60, 0x8b, 0x17, 0x38, 0x38, 0x36, 0x9f, 0xe8,
+	0xd6, 0xc2, 0x44, 0xa6, 0xb4, 0x53, 0x70, 0x32, 0x93, 0xbc, 0xbd, 0x0c, 0x6f, 0xea, 0xa7, 0x92,
+	0xb0, 0x88, 0x33, 0xd1, 0x25, 0x6c, 0x00, 0xe9, 0x9f, 0xb8, 0xc7, 0xe7, 0x87, 0x6d, 0xa9, 0xa8,
+	0xc0, 0xf1, 0x44, 0x5d, 0x9b, 0x01, 0x1f, 0x5f, 0xf9, 0xd1, 0x22, 0xf2, 0xbc, 0x63, 0xb5, 0xa4,
+	0xd2, 0x22, 0xf2, 0x9c, 0x32, 0x37, 0x6d, 0x60, 0xef, 0xd9, 0x8b, 0xeb, 0xaa, 0xf0, 0xf2, 0xba,
+	0x2a, 0xfc, 0x79, 0x5d, 0x15, 0x9e, 0xdf, 0x54, 0x0b, 0x2f, 0x6f, 0xaa, 0x85, 0xdf, 0x6f, 0xaa,
+	0x85, 0xf3, 0x0f, 0x3d, 0x9f, 0x75, 0xfb, 0x17, 0x9a, 0x43, 0x82, 0x66, 0x72, 0xeb, 0xf8, 0x7f,
+	0xd8, 0x21, 0x3d, 0xee, 0xec, 0xa4, 0x2f, 0xd8, 0x90, 0xbf, 0x59, 0x3b, 0xf9, 0x1b, 0x96, 0xdc,
+	0x54, 0x7a, 0xb1, 0xc4, 0x91, 0x8f, 0xfe, 0x1e, 0x00, 0x63, 0x83, 0xb1, 0xb2, 0xea, 0x06, 0x00,
 	0x00,
 }
 
@@ -250,6 +624,17 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.MaxWasmSize != that1.MaxWasmSize {
 		return false
 	}
+	if len(this.ProxyContractCodeIDs) != len(that1.ProxyContractCodeIDs) {
+		return false
+	}
+	for i := range this.ProxyContractCodeIDs {
+		if this.ProxyContractCodeIDs[i] != that1.ProxyContractCodeIDs[i] {
+			return false
+		}
+	}
+	if this.ProxyContractAdmin != that1.ProxyContractAdmin {
+		return false
+	}
 	return true
 }
 func (m *Wasm) Marshal() (dAtA []byte, err error) {
@@ -272,6 +657,11 @@ func (m *Wasm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Size_ != 0 {
+		i = encodeVarintWasmStorage(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x28
+	}
 	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.AddedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AddedAt):])
 	if err1 != nil {
 		return 0, err1
@@ -302,7 +692,7 @@ func (m *Wasm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
+func (m *OverlayVersion) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -312,55 +702,352 @@ func (m *Params) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+func (m *OverlayVersion) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OverlayVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MaxWasmSize != 0 {
-		i = encodeVarintWasmStorage(dAtA, i, uint64(m.MaxWasmSize))
+	if m.ActivationHeight != 0 {
+		i = encodeVarintWasmStorage(dAtA, i, uint64(m.ActivationHeight))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x18
+	}
+	if m.WasmType != 0 {
+		i = encodeVarintWasmStorage(dAtA, i, uint64(m.WasmType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintWasmStorage(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintWasmStorage(dAtA []byte, offset int, v uint64) int {
-	offset -= sovWasmStorage(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ProxyContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Wasm) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ProxyContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProxyContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovWasmStorage(uint64(l))
-	}
-	l = len(m.Bytecode)
-	if l > 0 {
-		n += 1 + l + sovWasmStorage(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintWasmStorage(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.WasmType != 0 {
-		n += 1 + sovWasmStorage(uint64(m.WasmType))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintWasmStorage(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AddedAt)
-	n += 1 + l + sovWasmStorage(uint64(l))
-	return n
+	return len(dAtA) - i, nil
+}
+
+func (m *ProxyContractChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProxyContractChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProxyContractChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintWasmStorage(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintWasmStorage(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintWasmStorage(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WasmUpload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WasmUpload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WasmUpload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintWasmStorage(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Chunks != 0 {
+		i = encodeVarintWasmStorage(dAtA, i, uint64(m.Chunks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Received != 0 {
+		i = encodeVarintWasmStorage(dAtA, i, uint64(m.Received))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UploadSize != 0 {
+		i = encodeVarintWasmStorage(dAtA, i, uint64(m.UploadSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WasmType != 0 {
+		i = encodeVarintWasmStorage(dAtA, i, uint64(m.WasmType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Uploader) > 0 {
+		i -= len(m.Uploader)
+		copy(dAtA[i:], m.Uploader)
+		i = encodeVarintWasmStorage(dAtA, i, uint64(len(m.Uploader)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintWasmStorage(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProxyContractAdmin) > 0 {
+		i -= len(m.ProxyContractAdmin)
+		copy(dAtA[i:], m.ProxyContractAdmin)
+		i = encodeVarintWasmStorage(dAtA, i, uint64(len(m.ProxyContractAdmin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ProxyContractCodeIDs) > 0 {
+		dAtA3 := make([]byte, len(m.ProxyContractCodeIDs)*10)
+		var j2 int
+		for _, num := range m.ProxyContractCodeIDs {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintWasmStorage(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MaxWasmSize != 0 {
+		i = encodeVarintWasmStorage(dAtA, i, uint64(m.MaxWasmSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintWasmStorage(dAtA []byte, offset int, v uint64) int {
+	offset -= sovWasmStorage(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Wasm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovWasmStorage(uint64(l))
+	}
+	l = len(m.Bytecode)
+	if l > 0 {
+		n += 1 + l + sovWasmStorage(uint64(l))
+	}
+	if m.WasmType != 0 {
+		n += 1 + sovWasmStorage(uint64(m.WasmType))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AddedAt)
+	n += 1 + l + sovWasmStorage(uint64(l))
+	if m.Size_ != 0 {
+		n += 1 + sovWasmStorage(uint64(m.Size_))
+	}
+	return n
+}
+
+func (m *OverlayVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovWasmStorage(uint64(l))
+	}
+	if m.WasmType != 0 {
+		n += 1 + sovWasmStorage(uint64(m.WasmType))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovWasmStorage(uint64(m.ActivationHeight))
+	}
+	return n
+}
+
+func (m *ProxyContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWasmStorage(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovWasmStorage(uint64(l))
+	}
+	return n
+}
+
+func (m *ProxyContractChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWasmStorage(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovWasmStorage(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovWasmStorage(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *WasmUpload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovWasmStorage(uint64(l))
+	}
+	l = len(m.Uploader)
+	if l > 0 {
+		n += 1 + l + sovWasmStorage(uint64(l))
+	}
+	if m.WasmType != 0 {
+		n += 1 + sovWasmStorage(uint64(m.WasmType))
+	}
+	if m.UploadSize != 0 {
+		n += 1 + sovWasmStorage(uint64(m.UploadSize))
+	}
+	if m.Received != 0 {
+		n += 1 + sovWasmStorage(uint64(m.Received))
+	}
+	if m.Chunks != 0 {
+		n += 1 + sovWasmStorage(uint64(m.Chunks))
+	}
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovWasmStorage(uint64(m.ExpirationHeight))
+	}
+	return n
 }
 
 func (m *Params) Size() (n int) {
@@ -372,6 +1059,17 @@ func (m *Params) Size() (n int) {
 	if m.MaxWasmSize != 0 {
 		n += 1 + sovWasmStorage(uint64(m.MaxWasmSize))
 	}
+	if len(m.ProxyContractCodeIDs) > 0 {
+		l = 0
+		for _, e := range m.ProxyContractCodeIDs {
+			l += sovWasmStorage(uint64(e))
+		}
+		n += 1 + sovWasmStorage(uint64(l)) + l
+	}
+	l = len(m.ProxyContractAdmin)
+	if l > 0 {
+		n += 1 + l + sovWasmStorage(uint64(l))
+	}
 	return n
 }
 
@@ -530,6 +1228,25 @@ func (m *Wasm) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWasmStorage(dAtA[iNdEx:])
@@ -551,7 +1268,7 @@ func (m *Wasm) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *OverlayVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -574,17 +1291,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: OverlayVersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OverlayVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxWasmSize", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			m.MaxWasmSize = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWasmStorage
@@ -594,11 +1311,699 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxWasmSize |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WasmType", wireType)
+			}
+			m.WasmType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WasmType |= WasmType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWasmStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProxyContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWasmStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProxyContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProxyContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWasmStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProxyContractChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWasmStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProxyContractChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProxyContractChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWasmStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WasmUpload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWasmStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WasmUpload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WasmUpload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uploader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uploader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WasmType", wireType)
+			}
+			m.WasmType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WasmType |= WasmType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadSize", wireType)
+			}
+			m.UploadSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
+			}
+			m.Received = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Received |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
+			}
+			m.Chunks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Chunks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+			}
+			m.ExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWasmStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWasmStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWasmSize", wireType)
+			}
+			m.MaxWasmSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxWasmSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowWasmStorage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ProxyContractCodeIDs = append(m.ProxyContractCodeIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowWasmStorage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthWasmStorage
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthWasmStorage
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ProxyContractCodeIDs) == 0 {
+					m.ProxyContractCodeIDs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowWasmStorage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ProxyContractCodeIDs = append(m.ProxyContractCodeIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyContractCodeIDs", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyContractAdmin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasmStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProxyContractAdmin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWasmStorage(dAtA[iNdEx:])
@@ -0,0 +1,509 @@
+package types
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/tetratelabs/wazero"
+)
+
+const (
+	// WasiModuleName is the import module of the WASI host functions.
+	WasiModuleName = "wasi_snapshot_preview1"
+	// WasiUnstableModuleName is the import module of the legacy WASI
+	// host functions.
+	WasiUnstableModuleName = "wasi_unstable"
+	// SedaModuleName is the import module of the SEDA host functions.
+	SedaModuleName = "env"
+
+	// WasmEntryPoint is the function that runs a Data Request or Tally Wasm.
+	WasmEntryPoint = "_start"
+	// WasmMemoryExport is the memory export that the host reads from and
+	// writes to.
+	WasmMemoryExport = "memory"
+
+	// WasmValidationCostPerByte is the gas charged per byte of uncompressed
+	// Wasm before it is compiled by ValidateWasm. It matches the cost that
+	// wasmd charges per byte to compile a CosmWasm contract.
+	WasmValidationCostPerByte uint64 = 3
+)
+
+// wasmMagic is the magic number and version every Wasm binary must start with.
+var wasmMagic = []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+
+// wasmRequirements defines the structural constraints a Wasm binary of a
+// given type must satisfy.
+type wasmRequirements struct {
+	// functionExports lists the functions that must be exported.
+	functionExports []string
+	// memoryExports lists the memories that must be exported.
+	memoryExports []string
+	// requiredImportModules lists host modules, at least one of which
+	// must be imported from.
+	requiredImportModules []string
+	// allowedImportModules lists the host modules that may be imported from.
+	allowedImportModules []string
+	// forbiddenImports lists host functions, per module, that must not be
+	// imported.
+	forbiddenImports map[string][]string
+	// deterministic rejects features whose results may differ between
+	// machines, namely floating-point and SIMD instructions.
+	deterministic bool
+}
+
+var wasmTypeRequirements = map[WasmType]wasmRequirements{
+	WasmTypeDataRequest: {
+		functionExports:      []string{WasmEntryPoint},
+		memoryExports:        []string{WasmMemoryExport},
+		allowedImportModules: []string{WasiModuleName, WasiUnstableModuleName, SedaModuleName},
+		deterministic:        true,
+	},
+	WasmTypeTally: {
+		functionExports:      []string{WasmEntryPoint},
+		memoryExports:        []string{WasmMemoryExport},
+		allowedImportModules: []string{WasiModuleName, WasiUnstableModuleName, SedaModuleName},
+		// Tally runs on every validator, so it cannot reach the network.
+		forbiddenImports: map[string][]string{
+			SedaModuleName: {"http_fetch"},
+		},
+		deterministic: true,
+	},
+	WasmTypeDataRequestExecutor: {
+		functionExports:       []string{WasmEntryPoint},
+		memoryExports:         []string{WasmMemoryExport},
+		requiredImportModules: []string{WasiModuleName, WasiUnstableModuleName},
+		allowedImportModules:  []string{WasiModuleName, WasiUnstableModuleName, SedaModuleName},
+	},
+	WasmTypeRelayer: {
+		functionExports:       []string{WasmEntryPoint},
+		memoryExports:         []string{WasmMemoryExport},
+		requiredImportModules: []string{WasiModuleName, WasiUnstableModuleName},
+		allowedImportModules:  []string{WasiModuleName, WasiUnstableModuleName, SedaModuleName},
+	},
+}
+
+// ValidateWasm parses a given uncompressed Wasm binary and checks that it
+// is a valid Wasm module satisfying the requirements of the given type.
+func ValidateWasm(ctx context.Context, bytecode []byte, wasmType WasmType) error {
+	reqs, ok := wasmTypeRequirements[wasmType]
+	if !ok {
+		return fmt.Errorf("unknown Wasm type %s", wasmType)
+	}
+	if !bytes.HasPrefix(bytecode, wasmMagic) {
+		return fmt.Errorf("invalid Wasm: bad magic number or version")
+	}
+
+	rt := wazero.NewRuntimeWithConfig(ctx, wazero.NewRuntimeConfigInterpreter())
+	defer rt.Close(ctx)
+
+	module, err := rt.CompileModule(ctx, bytecode)
+	if err != nil {
+		return fmt.Errorf("invalid Wasm: %s", err)
+	}
+	defer module.Close(ctx)
+
+	if err := checkExports(module, reqs); err != nil {
+		return err
+	}
+	if err := checkImports(module, reqs); err != nil {
+		return err
+	}
+	if reqs.deterministic {
+		if err := checkDeterminism(bytecode); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkExports(module wazero.CompiledModule, reqs wasmRequirements) error {
+	functions := module.ExportedFunctions()
+	for _, name := range reqs.functionExports {
+		fn, ok := functions[name]
+		if !ok {
+			return fmt.Errorf("invalid Wasm: missing function export %s", name)
+		}
+		if len(fn.ParamTypes()) != 0 || len(fn.ResultTypes()) != 0 {
+			return fmt.Errorf("invalid Wasm: function export %s must take no parameters and return no results", name)
+		}
+	}
+	memories := module.ExportedMemories()
+	for _, name := range reqs.memoryExports {
+		if _, ok := memories[name]; !ok {
+			return fmt.Errorf("invalid Wasm: missing memory export %s", name)
+		}
+	}
+	return nil
+}
+
+func checkImports(module wazero.CompiledModule, reqs wasmRequirements) error {
+	imported := make(map[string]bool)
+	for _, fn := range module.ImportedFunctions() {
+		moduleName, name, _ := fn.Import()
+		if !contains(reqs.allowedImportModules, moduleName) {
+			return fmt.Errorf("invalid Wasm: import from forbidden module %s", moduleName)
+		}
+		if contains(reqs.forbiddenImports[moduleName], name) {
+			return fmt.Errorf("invalid Wasm: forbidden import %s.%s", moduleName, name)
+		}
+		imported[moduleName] = true
+	}
+	if mems := module.ImportedMemories(); len(mems) > 0 {
+		moduleName, name, _ := mems[0].Import()
+		return fmt.Errorf("invalid Wasm: forbidden memory import %s.%s", moduleName, name)
+	}
+	if len(reqs.requiredImportModules) == 0 {
+		return nil
+	}
+	for _, moduleName := range reqs.requiredImportModules {
+		if imported[moduleName] {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid Wasm: missing imports from host modules %v", reqs.requiredImportModules)
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// Wasm binary format constants used by checkDeterminism.
+const (
+	sectionType   = 1
+	sectionImport = 2
+	sectionGlobal = 6
+	sectionCode   = 10
+
+	importKindFunc   = 0x00
+	importKindTable  = 0x01
+	importKindMemory = 0x02
+	importKindGlobal = 0x03
+
+	valTypeI32       = 0x7f
+	valTypeF32       = 0x7d
+	valTypeF64       = 0x7c
+	valTypeV128      = 0x7b
+	valTypeExternRef = 0x6f
+
+	opBlock        = 0x02
+	opLoop         = 0x03
+	opIf           = 0x04
+	opBr           = 0x0c
+	opBrIf         = 0x0d
+	opBrTable      = 0x0e
+	opCall         = 0x10
+	opCallIndirect = 0x11
+	opSelectTyped  = 0x1c
+	opLocalGet     = 0x20
+	opTableSet     = 0x26
+	opI32Load      = 0x28
+	opI64Store32   = 0x3e
+	opMemorySize   = 0x3f
+	opMemoryGrow   = 0x40
+	opI32Const     = 0x41
+	opI64Const     = 0x42
+	opRefNull      = 0xd0
+	opRefFunc      = 0xd2
+	opMiscPrefix   = 0xfc
+	opVecPrefix    = 0xfd
+	blockTypeEmpty = 0x40
+)
+
+var errNonDeterministic = errors.New("invalid Wasm: floating-point and SIMD features are not allowed")
+
+// checkDeterminism scans the type, global, and code sections of a Wasm
+// binary for floating-point or SIMD value types and instructions. The
+// binary is assumed to have been validated beforehand.
+func checkDeterminism(bytecode []byte) error {
+	r := &wasmReader{buf: bytecode, pos: len(wasmMagic)}
+	for !r.done() {
+		id := r.byte()
+		size := r.u32()
+		end := r.pos + int(size)
+		if r.err != nil || end > len(r.buf) {
+			return fmt.Errorf("invalid Wasm: malformed section")
+		}
+		section := &wasmReader{buf: r.buf[:end], pos: r.pos}
+
+		var err error
+		switch id {
+		case sectionType, sectionGlobal:
+			err = section.checkValueTypes(id)
+		case sectionImport:
+			err = section.checkImportedGlobals()
+		case sectionCode:
+			err = section.checkCode()
+		}
+		if err != nil {
+			return err
+		}
+		r.pos = end
+	}
+	return r.err
+}
+
+type wasmReader struct {
+	buf []byte
+	pos int
+	err error
+}
+
+func (r *wasmReader) done() bool {
+	return r.err != nil || r.pos >= len(r.buf)
+}
+
+func (r *wasmReader) byte() byte {
+	if r.done() {
+		r.err = errors.New("invalid Wasm: unexpected end of binary")
+		return 0
+	}
+	b := r.buf[r.pos]
+	r.pos++
+	return b
+}
+
+func (r *wasmReader) peek() byte {
+	if r.done() {
+		return 0
+	}
+	return r.buf[r.pos]
+}
+
+func (r *wasmReader) u32() uint32 {
+	if r.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(r.buf[r.pos:])
+	if n <= 0 || v > 1<<32-1 {
+		r.err = errors.New("invalid Wasm: malformed integer")
+		return 0
+	}
+	r.pos += n
+	return uint32(v)
+}
+
+func (r *wasmReader) skip(n uint32) {
+	if r.err != nil {
+		return
+	}
+	if uint64(r.pos)+uint64(n) > uint64(len(r.buf)) {
+		r.err = errors.New("invalid Wasm: unexpected end of binary")
+		return
+	}
+	r.pos += int(n)
+}
+
+// limits skips the limits of a table or memory type.
+func (r *wasmReader) limits() {
+	flags := r.byte()
+	r.u32()
+	if flags&0x01 != 0 {
+		r.u32()
+	}
+}
+
+// leb skips a signed or unsigned LEB128 integer.
+func (r *wasmReader) leb() {
+	for {
+		if r.byte()&0x80 == 0 || r.err != nil {
+			return
+		}
+	}
+}
+
+func isNonDeterministicType(t byte) bool {
+	return t == valTypeF32 || t == valTypeF64 || t == valTypeV128
+}
+
+func (r *wasmReader) checkValueTypes(section byte) error {
+	count := r.u32()
+	for i := uint32(0); i < count && r.err == nil; i++ {
+		if section == sectionType {
+			// func type marker, followed by params and results
+			r.byte()
+			for j := 0; j < 2; j++ {
+				n := r.u32()
+				for k := uint32(0); k < n && r.err == nil; k++ {
+					if isNonDeterministicType(r.byte()) {
+						return errNonDeterministic
+					}
+				}
+			}
+			continue
+		}
+		// global: value type, mutability, init expression
+		if isNonDeterministicType(r.byte()) {
+			return errNonDeterministic
+		}
+		r.byte()
+		if err := r.checkInstructions(); err != nil {
+			return err
+		}
+	}
+	return r.err
+}
+
+func (r *wasmReader) checkImportedGlobals() error {
+	count := r.u32()
+	for i := uint32(0); i < count && r.err == nil; i++ {
+		r.skip(r.u32()) // module name
+		r.skip(r.u32()) // field name
+		switch r.byte() {
+		case importKindFunc:
+			r.u32()
+		case importKindTable:
+			r.byte()
+			r.limits()
+		case importKindMemory:
+			r.limits()
+		case importKindGlobal:
+			if isNonDeterministicType(r.byte()) {
+				return errNonDeterministic
+			}
+			r.byte()
+		}
+	}
+	return r.err
+}
+
+func (r *wasmReader) checkCode() error {
+	count := r.u32()
+	for i := uint32(0); i < count && r.err == nil; i++ {
+		size := r.u32()
+		end := r.pos + int(size)
+		if r.err != nil || end > len(r.buf) {
+			return errors.New("invalid Wasm: malformed function body")
+		}
+		body := &wasmReader{buf: r.buf[:end], pos: r.pos}
+		localGroups := body.u32()
+		for j := uint32(0); j < localGroups && body.err == nil; j++ {
+			body.u32()
+			if isNonDeterministicType(body.byte()) {
+				return errNonDeterministic
+			}
+		}
+		if err := body.checkInstructions(); err != nil {
+			return err
+		}
+		if body.err != nil {
+			return body.err
+		}
+		r.pos = end
+	}
+	return r.err
+}
+
+// checkInstructions walks an expression until its final end opcode and
+// returns an error upon encountering a floating-point or SIMD instruction.
+func (r *wasmReader) checkInstructions() error {
+	depth := 0
+	for r.err == nil {
+		op := r.byte()
+		switch {
+		case op == 0x0b: // end
+			if depth == 0 {
+				return r.err
+			}
+			depth--
+		case op == opBlock || op == opLoop || op == opIf:
+			depth++
+			switch t := r.peek(); {
+			case t == blockTypeEmpty:
+				r.byte()
+			case isNonDeterministicType(t):
+				return errNonDeterministic
+			case t >= valTypeExternRef && t <= valTypeI32:
+				r.byte()
+			default: // type index
+				r.leb()
+			}
+		case op == opBr || op == opBrIf || op == opCall || op == opRefFunc ||
+			(op >= opLocalGet && op <= opTableSet):
+			r.u32()
+		case op == opBrTable:
+			n := r.u32()
+			for i := uint32(0); i <= n && r.err == nil; i++ {
+				r.u32()
+			}
+		case op == opCallIndirect:
+			r.u32()
+			r.u32()
+		case op == opSelectTyped:
+			n := r.u32()
+			for i := uint32(0); i < n && r.err == nil; i++ {
+				if isNonDeterministicType(r.byte()) {
+					return errNonDeterministic
+				}
+			}
+		case isFloatOpcode(op):
+			return errNonDeterministic
+		case op >= opI32Load && op <= opI64Store32:
+			r.u32() // align
+			r.u32() // offset
+		case op == opMemorySize || op == opMemoryGrow || op == opRefNull:
+			r.byte()
+		case op == opI32Const || op == opI64Const:
+			r.leb()
+		case op == opMiscPrefix:
+			if err := r.checkMiscInstruction(); err != nil {
+				return err
+			}
+		case op == opVecPrefix:
+			return errNonDeterministic
+		}
+	}
+	return r.err
+}
+
+// isFloatOpcode reports whether a single-byte opcode loads, stores,
+// produces, or consumes a floating-point value.
+func isFloatOpcode(op byte) bool {
+	switch {
+	case op == 0x2a || op == 0x2b: // f32.load, f64.load
+		return true
+	case op == 0x38 || op == 0x39: // f32.store, f64.store
+		return true
+	case op == 0x43 || op == 0x44: // f32.const, f64.const
+		return true
+	case op >= 0x5b && op <= 0x66: // float comparisons
+		return true
+	case op >= 0x8b && op <= 0xa6: // float arithmetic
+		return true
+	case op >= 0xa8 && op <= 0xab: // i32.trunc_f*
+		return true
+	case op >= 0xae && op <= 0xbf: // i64.trunc_f*, conversions, reinterpretations
+		return true
+	}
+	return false
+}
+
+// checkMiscInstruction handles instructions with the 0xfc prefix.
+func (r *wasmReader) checkMiscInstruction() error {
+	sub := r.u32()
+	switch {
+	case sub <= 7: // saturating float-to-int truncations
+		return errNonDeterministic
+	case sub == 8: // memory.init
+		r.u32()
+		r.byte()
+	case sub == 9 || sub == 13 || sub >= 15: // data.drop, elem.drop, table.grow/size/fill
+		r.u32()
+	case sub == 10: // memory.copy
+		r.byte()
+		r.byte()
+	case sub == 11: // memory.fill
+		r.byte()
+	case sub == 12 || sub == 14: // table.init, table.copy
+		r.u32()
+		r.u32()
+	}
+	return r.err
+}
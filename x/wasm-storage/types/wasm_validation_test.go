@@ -0,0 +1,287 @@
+package types_test
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sedaprotocol/seda-chain/x/wasm-storage/types"
+)
+
+// wasmImport is a function or memory import of a test module.
+type wasmImport struct {
+	module string
+	name   string
+	memory bool
+}
+
+// wasmModule describes a test module with a single local function.
+type wasmModule struct {
+	imports []wasmImport
+	// params lists the parameter types of the local function.
+	params []byte
+	// locals lists the types of the locals of the local function.
+	locals []byte
+	// body holds the instructions of the local function without the
+	// final end opcode.
+	body          []byte
+	exportFunc    string
+	exportMemory  string
+	withoutMemory bool
+}
+
+func uleb(n int) []byte {
+	return binary.AppendUvarint(nil, uint64(n))
+}
+
+func wasmName(s string) []byte {
+	return append(uleb(len(s)), s...)
+}
+
+func wasmVec(items ...[]byte) []byte {
+	bz := uleb(len(items))
+	for _, item := range items {
+		bz = append(bz, item...)
+	}
+	return bz
+}
+
+func wasmSection(id byte, contents []byte) []byte {
+	return append(append([]byte{id}, uleb(len(contents))...), contents...)
+}
+
+// build assembles the binary of the module. Imported functions take no
+// parameters and return no results.
+func (m wasmModule) build() []byte {
+	bz := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+
+	funcType := append(append([]byte{0x60}, wasmVec(splitBytes(m.params)...)...), 0x00)
+	bz = append(bz, wasmSection(1, wasmVec([]byte{0x60, 0x00, 0x00}, funcType))...)
+
+	var imports [][]byte
+	funcImports := 0
+	for _, imp := range m.imports {
+		entry := append(wasmName(imp.module), wasmName(imp.name)...)
+		if imp.memory {
+			entry = append(entry, 0x02, 0x00, 0x01)
+		} else {
+			entry = append(entry, 0x00, 0x00)
+			funcImports++
+		}
+		imports = append(imports, entry)
+	}
+	if len(imports) > 0 {
+		bz = append(bz, wasmSection(2, wasmVec(imports...))...)
+	}
+
+	bz = append(bz, wasmSection(3, wasmVec([]byte{0x01}))...)
+	if !m.withoutMemory {
+		bz = append(bz, wasmSection(5, wasmVec([]byte{0x00, 0x01}))...)
+	}
+
+	var exports [][]byte
+	if m.exportFunc != "" {
+		exports = append(exports, append(wasmName(m.exportFunc), 0x00, byte(funcImports)))
+	}
+	if m.exportMemory != "" {
+		exports = append(exports, append(wasmName(m.exportMemory), 0x02, 0x00))
+	}
+	bz = append(bz, wasmSection(7, wasmVec(exports...))...)
+
+	var locals [][]byte
+	for _, t := range m.locals {
+		locals = append(locals, []byte{0x01, t})
+	}
+	body := append(append(wasmVec(locals...), m.body...), 0x0b)
+	return append(bz, wasmSection(10, wasmVec(append(uleb(len(body)), body...)))...)
+}
+
+func (m wasmModule) withImports(imports ...wasmImport) wasmModule {
+	m.imports = imports
+	return m
+}
+
+func (m wasmModule) withLocals(locals ...byte) wasmModule {
+	m.locals = locals
+	return m
+}
+
+func (m wasmModule) withBody(body ...byte) wasmModule {
+	m.body = body
+	return m
+}
+
+func (m wasmModule) withoutOwnMemory() wasmModule {
+	m.withoutMemory = true
+	return m
+}
+
+func splitBytes(bz []byte) [][]byte {
+	items := make([][]byte, len(bz))
+	for i := range bz {
+		items[i] = bz[i : i+1]
+	}
+	return items
+}
+
+func TestValidateWasm(t *testing.T) {
+	valid := wasmModule{
+		imports:      []wasmImport{{module: types.SedaModuleName, name: "call_result_write"}},
+		exportFunc:   types.WasmEntryPoint,
+		exportMemory: types.WasmMemoryExport,
+	}
+	wasiImport := wasmImport{module: types.WasiModuleName, name: "fd_write"}
+	httpFetch := wasmImport{module: types.SedaModuleName, name: "http_fetch"}
+	f32Add := []byte{
+		0x43, 0x00, 0x00, 0x00, 0x00, // f32.const 0
+		0x43, 0x00, 0x00, 0x00, 0x00, // f32.const 0
+		0x92, // f32.add
+		0x1a, // drop
+	}
+	f64Const := []byte{0x44, 0, 0, 0, 0, 0, 0, 0, 0, 0x1a}
+	truncSat := []byte{0x43, 0x00, 0x00, 0x00, 0x00, 0xfc, 0x00, 0x1a}
+	v128Const := append(append([]byte{0xfd, 0x0c}, make([]byte, 16)...), 0x1a)
+	intOps := []byte{
+		0x41, 0x01, // i32.const 1
+		0x04, 0x40, // if
+		0x41, 0x00, // i32.const 0
+		0x28, 0x02, 0x00, // i32.load
+		0x1a, // drop
+		0x0b, // end
+	}
+
+	testCases := []struct {
+		name     string
+		bytecode []byte
+		wasmType types.WasmType
+		expErr   string
+	}{
+		{
+			name:     "valid data request",
+			bytecode: valid.build(),
+			wasmType: types.WasmTypeDataRequest,
+		},
+		{
+			name:     "valid tally with integer instructions",
+			bytecode: valid.withBody(intOps...).build(),
+			wasmType: types.WasmTypeTally,
+		},
+		{
+			name:     "unknown type",
+			bytecode: valid.build(),
+			wasmType: types.WasmTypeNil,
+			expErr:   "unknown Wasm type",
+		},
+		{
+			name:     "bad magic number",
+			bytecode: append([]byte{0x00, 0x61, 0x73, 0x6d, 0x02, 0x00, 0x00, 0x00}, valid.build()[8:]...),
+			wasmType: types.WasmTypeDataRequest,
+			expErr:   "bad magic number or version",
+		},
+		{
+			name:     "malformed binary",
+			bytecode: valid.build()[:20],
+			wasmType: types.WasmTypeDataRequest,
+			expErr:   "invalid Wasm",
+		},
+		{
+			name:     "float instructions in data request",
+			bytecode: valid.withBody(f32Add...).build(),
+			wasmType: types.WasmTypeDataRequest,
+			expErr:   "floating-point and SIMD features are not allowed",
+		},
+		{
+			name:     "float constant in tally",
+			bytecode: valid.withBody(f64Const...).build(),
+			wasmType: types.WasmTypeTally,
+			expErr:   "floating-point and SIMD features are not allowed",
+		},
+		{
+			name:     "saturating truncation in tally",
+			bytecode: valid.withBody(truncSat...).build(),
+			wasmType: types.WasmTypeTally,
+			expErr:   "floating-point and SIMD features are not allowed",
+		},
+		{
+			name:     "float local in tally",
+			bytecode: valid.withLocals(0x7c).build(),
+			wasmType: types.WasmTypeTally,
+			expErr:   "floating-point and SIMD features are not allowed",
+		},
+		{
+			name:     "SIMD instructions in data request",
+			bytecode: valid.withBody(v128Const...).build(),
+			wasmType: types.WasmTypeDataRequest,
+			expErr:   "floating-point and SIMD features are not allowed",
+		},
+		{
+			name:     "float instructions in relayer",
+			bytecode: valid.withImports(wasiImport).withBody(f32Add...).build(),
+			wasmType: types.WasmTypeRelayer,
+		},
+		{
+			name:     "http_fetch in data request",
+			bytecode: valid.withImports(httpFetch).build(),
+			wasmType: types.WasmTypeDataRequest,
+		},
+		{
+			name:     "http_fetch in tally",
+			bytecode: valid.withImports(httpFetch).build(),
+			wasmType: types.WasmTypeTally,
+			expErr:   "forbidden import env.http_fetch",
+		},
+		{
+			name:     "import from unknown module",
+			bytecode: valid.withImports(wasmImport{module: "host", name: "call"}).build(),
+			wasmType: types.WasmTypeDataRequest,
+			expErr:   "import from forbidden module host",
+		},
+		{
+			name:     "memory import",
+			bytecode: valid.withImports(wasmImport{module: types.SedaModuleName, name: "memory", memory: true}).withoutOwnMemory().build(),
+			wasmType: types.WasmTypeDataRequest,
+			expErr:   "forbidden memory import env.memory",
+		},
+		{
+			name:     "valid data request executor",
+			bytecode: valid.withImports(wasiImport, httpFetch).build(),
+			wasmType: types.WasmTypeDataRequestExecutor,
+		},
+		{
+			name:     "relayer without WASI imports",
+			bytecode: valid.build(),
+			wasmType: types.WasmTypeRelayer,
+			expErr:   "missing imports from host modules",
+		},
+		{
+			name:     "missing _start export",
+			bytecode: wasmModule{exportMemory: types.WasmMemoryExport}.build(),
+			wasmType: types.WasmTypeDataRequest,
+			expErr:   "missing function export _start",
+		},
+		{
+			name:     "_start with parameters",
+			bytecode: wasmModule{params: []byte{0x7f}, exportFunc: types.WasmEntryPoint, exportMemory: types.WasmMemoryExport}.build(),
+			wasmType: types.WasmTypeDataRequest,
+			expErr:   "function export _start must take no parameters and return no results",
+		},
+		{
+			name:     "missing memory export",
+			bytecode: wasmModule{exportFunc: types.WasmEntryPoint}.build(),
+			wasmType: types.WasmTypeTally,
+			expErr:   "missing memory export memory",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateWasm(context.Background(), tc.bytecode, tc.wasmType)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}